@@ -5,6 +5,7 @@ package catalog
 import (
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/simbiont-runtime/graphengine/meta"
 	"github.com/simbiont-runtime/graphengine/storage/kv"
@@ -12,13 +13,22 @@ import (
 
 // Catalog maintains the catalog of graphs and label information.
 type Catalog struct {
-	// mdl prevent executing DDL concurrently.
-	mdl sync.Mutex
+	// mdl prevent executing DDL concurrently, and prevent committing the
+	// transactions while executing DDL.
+	mdl sync.RWMutex
 
 	// mu protect the catalog fields.
 	mu     sync.RWMutex
 	byName map[string]*Graph
 	byID   map[int64]*Graph
+
+	// schemaVersion is increased after every patch applied, which is used to
+	// invalidate the cached plans resolved against the previous catalog.
+	schemaVersion atomic.Int64
+	// indexVersion is increased after an index is created or dropped, which is
+	// used to reject the transactions whose index keys are written against the
+	// previous indexes.
+	indexVersion atomic.Int64
 }
 
 // Load loads the catalog from a kv snapshot.
@@ -48,8 +58,15 @@ func Load(snapshot kv.Snapshot) (*Catalog, error) {
 		}
 		g.Properties = properties
 
+		// Load statistics
+		stats, err := meta.GetGraphStats(g.ID)
+		if err != nil {
+			return nil, err
+		}
+
 		// Build graph instance.
 		graph := NewGraph(g)
+		graph.SetStats(stats)
 		c.byName[g.Name.L] = graph
 		c.byID[g.ID] = graph
 	}
@@ -57,6 +74,18 @@ func Load(snapshot kv.Snapshot) (*Catalog, error) {
 	return c, nil
 }
 
+// SchemaVersion returns the version of the catalog, which is changed whenever
+// a patch is applied.
+func (c *Catalog) SchemaVersion() int64 {
+	return c.schemaVersion.Load()
+}
+
+// IndexVersion returns the version of the indexes in the catalog, which is
+// changed whenever an index is created or dropped.
+func (c *Catalog) IndexVersion() int64 {
+	return c.indexVersion.Load()
+}
+
 // Graph returns the graph of specified name.
 func (c *Catalog) Graph(name string) *Graph {
 	c.mu.RLock()
@@ -125,3 +154,14 @@ func (c *Catalog) MDLock() {
 func (c *Catalog) MDUnlock() {
 	c.mdl.Unlock()
 }
+
+// MDRLock locks the catalog in shared mode to prevent executing DDL while
+// committing a transaction.
+func (c *Catalog) MDRLock() {
+	c.mdl.RLock()
+}
+
+// MDRUnlock unlocks the catalog locked in shared mode.
+func (c *Catalog) MDRUnlock() {
+	c.mdl.RUnlock()
+}
@@ -61,6 +61,9 @@ func Test_Load(t *testing.T) {
 		},
 	}
 
+	// Only the second graph has been analyzed.
+	stats := &model.GraphStats{VertexCount: 10, EdgeCount: 20}
+
 	// Create mock data.
 	err = kv.Txn(store, func(txn kv.Transaction) error {
 		meta := meta.New(txn)
@@ -76,7 +79,7 @@ func Test_Load(t *testing.T) {
 				assert.Nil(err)
 			}
 		}
-		return nil
+		return meta.UpdateGraphStats(cases[1].ID, stats)
 	})
 	assert.Nil(err)
 
@@ -107,4 +110,6 @@ func Test_Load(t *testing.T) {
 			assert.Equal(property, graph.PropertyByID(p.ID))
 		}
 	}
+	assert.Nil(catalog.Graph("graph1").Stats())
+	assert.Equal(stats, catalog.Graph("graph2").Stats())
 }
@@ -19,6 +19,9 @@ type Graph struct {
 	// The information object will be used by multiple package, and we need to clone a
 	// new object if we want to modify it and keep the original one immutable.
 	meta atomic.Pointer[model.GraphInfo]
+	// stats is the statistics collected by the latest ANALYZE GRAPH, which is nil
+	// if the graph has not been analyzed. It is immutable as the meta.
+	stats atomic.Pointer[model.GraphStats]
 
 	labels struct {
 		sync.RWMutex
@@ -76,6 +79,17 @@ func (g *Graph) Meta() *model.GraphInfo {
 	return g.meta.Load()
 }
 
+// Stats returns the statistics of this graph, or nil if the graph has not been
+// analyzed.
+func (g *Graph) Stats() *model.GraphStats {
+	return g.stats.Load()
+}
+
+// SetStats replaces the statistics of this graph.
+func (g *Graph) SetStats(stats *model.GraphStats) {
+	g.stats.Store(stats)
+}
+
 // Label returns the label of specified name.
 func (g *Graph) Label(name string) *Label {
 	g.labels.RLock()
@@ -191,7 +205,7 @@ func (g *Graph) DropProperty(propertyInfo *model.PropertyInfo) {
 	g.meta.Store(&meta)
 }
 
-// Index returns the label of specified name.
+// Index returns the index of specified name.
 func (g *Graph) Index(name string) *Index {
 	g.indexes.RLock()
 	defer g.indexes.RUnlock()
@@ -199,7 +213,7 @@ func (g *Graph) Index(name string) *Index {
 	return g.indexes.byName[strings.ToLower(name)]
 }
 
-// IndexByID returns the label of specified ID.
+// IndexByID returns the index of specified ID.
 func (g *Graph) IndexByID(id int64) *Index {
 	g.indexes.RLock()
 	defer g.indexes.RUnlock()
@@ -222,6 +236,39 @@ func (g *Graph) Indexes() []*Index {
 	return indexes
 }
 
+// CreateIndex create a new index and append to the graph indexes list.
+func (g *Graph) CreateIndex(indexInfo *model.IndexInfo) {
+	g.indexes.Lock()
+	defer g.indexes.Unlock()
+
+	index := NewIndex(indexInfo)
+	g.indexes.byName[indexInfo.Name.L] = index
+	g.indexes.byID[indexInfo.ID] = index
+
+	meta := *g.meta.Load()
+	meta.Indexes = append(meta.Indexes, indexInfo)
+	g.meta.Store(&meta)
+}
+
+// DropIndex removes specified index from graph.
+func (g *Graph) DropIndex(indexInfo *model.IndexInfo) {
+	g.indexes.Lock()
+	defer g.indexes.Unlock()
+
+	delete(g.indexes.byName, indexInfo.Name.L)
+	delete(g.indexes.byID, indexInfo.ID)
+
+	meta := *g.meta.Load()
+	indexes := make([]*model.IndexInfo, 0, len(meta.Indexes))
+	for _, idx := range meta.Indexes {
+		if idx.ID != indexInfo.ID {
+			indexes = append(indexes, idx)
+		}
+	}
+	meta.Indexes = indexes
+	g.meta.Store(&meta)
+}
+
 // SetNextPropID sets the next property id.
 func (g *Graph) SetNextPropID(propID uint16) {
 	meta := *g.meta.Load()
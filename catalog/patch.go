@@ -18,6 +18,7 @@ const (
 	PatchTypeDropLabel
 	PatchTypeDropIndex
 	PatchTypeCreateProperties
+	PatchTypeUpdateStats
 )
 
 type (
@@ -33,17 +34,31 @@ type (
 		LabelInfo *model.LabelInfo
 	}
 
+	// PatchIndex represents the payload of patching create/drop index DDL.
+	PatchIndex struct {
+		GraphID   int64
+		IndexInfo *model.IndexInfo
+	}
+
 	// PatchProperties represents the payload of patching create properties
 	PatchProperties struct {
 		MaxPropID  uint16
 		GraphID    int64
 		Properties []*model.PropertyInfo
 	}
+
+	// PatchStats represents the payload of patching the statistics of a graph.
+	PatchStats struct {
+		GraphID int64
+		Stats   *model.GraphStats
+	}
 )
 
 // Apply applies the patch to catalog.
 // Note: we need to ensure the DDL changes have applied to persistent storage first.
 func (c *Catalog) Apply(patch *Patch) {
+	defer c.schemaVersion.Add(1)
+
 	switch patch.Type {
 	case PatchTypeCreateGraph:
 		data := patch.Data.(*model.GraphInfo)
@@ -78,6 +93,26 @@ func (c *Catalog) Apply(patch *Patch) {
 		}
 		graph.DropLabel(data.LabelInfo)
 
+	case PatchTypeCreateIndex:
+		data := patch.Data.(*PatchIndex)
+		graph := c.GraphByID(data.GraphID)
+		if graph == nil {
+			logutil.Errorf("Create index on not exists graph. GraphID: %d", data.GraphID)
+			return
+		}
+		graph.CreateIndex(data.IndexInfo)
+		c.indexVersion.Add(1)
+
+	case PatchTypeDropIndex:
+		data := patch.Data.(*PatchIndex)
+		graph := c.GraphByID(data.GraphID)
+		if graph == nil {
+			logutil.Errorf("Drop index on not exists graph. GraphID: %d", data.GraphID)
+			return
+		}
+		graph.DropIndex(data.IndexInfo)
+		c.indexVersion.Add(1)
+
 	case PatchTypeCreateProperties:
 		data := patch.Data.(*PatchProperties)
 		graph := c.GraphByID(data.GraphID)
@@ -85,5 +120,14 @@ func (c *Catalog) Apply(patch *Patch) {
 		for _, p := range data.Properties {
 			graph.CreateProperty(p)
 		}
+
+	case PatchTypeUpdateStats:
+		data := patch.Data.(*PatchStats)
+		graph := c.GraphByID(data.GraphID)
+		if graph == nil {
+			logutil.Errorf("Update stats on not exists graph. GraphID: %d", data.GraphID)
+			return
+		}
+		graph.SetStats(data.Stats)
 	}
 }
@@ -106,6 +106,55 @@ func TestCatalog_Apply(t *testing.T) {
 				assert.NotNil(graph.Property("property2"))
 			},
 		},
+		{
+			patch: &Patch{
+				Type: PatchTypeCreateIndex,
+				Data: &PatchIndex{
+					GraphID: 1,
+					IndexInfo: &model.IndexInfo{
+						ID:         4,
+						Name:       model.NewCIStr("index1"),
+						Properties: []model.CIStr{model.NewCIStr("property1")},
+					},
+				},
+			},
+			checker: func() {
+				graph := catalog.Graph("graph1")
+				assert.NotNil(graph.Index("index1"))
+				assert.NotNil(graph.IndexByID(4))
+				assert.Len(graph.Meta().Indexes, 1)
+			},
+		},
+		{
+			patch: &Patch{
+				Type: PatchTypeDropIndex,
+				Data: &PatchIndex{
+					GraphID: 1,
+					IndexInfo: &model.IndexInfo{
+						ID:   4,
+						Name: model.NewCIStr("index1"),
+					},
+				},
+			},
+			checker: func() {
+				graph := catalog.Graph("graph1")
+				assert.Nil(graph.Index("index1"))
+				assert.Empty(graph.Meta().Indexes)
+			},
+		},
+		{
+			patch: &Patch{
+				Type: PatchTypeUpdateStats,
+				Data: &PatchStats{
+					GraphID: 1,
+					Stats:   &model.GraphStats{VertexCount: 10},
+				},
+			},
+			checker: func() {
+				graph := catalog.Graph("graph1")
+				assert.Equal(int64(10), graph.Stats().VertexCount)
+			},
+		},
 		{
 			patch: &Patch{
 				Type: PatchTypeDropGraph,
@@ -120,10 +169,11 @@ func TestCatalog_Apply(t *testing.T) {
 		},
 	}
 
-	for _, c := range cases {
+	for i, c := range cases {
 		catalog.Apply(c.patch)
 		if c.checker != nil {
 			c.checker()
 		}
+		assert.Equal(int64(i+1), catalog.SchemaVersion())
 	}
 }
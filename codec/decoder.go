@@ -5,6 +5,7 @@ package codec
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/parser/model"
@@ -26,16 +27,16 @@ func NewPropertyDecoder(labels []*model.LabelInfo, properties []*model.PropertyI
 	}
 }
 
-func (d *PropertyDecoder) Decode(rowData []byte) (map[uint16]struct{}, map[uint16]datum.Datum, error) {
+func (d *PropertyDecoder) Decode(rowData []byte) (map[int64]struct{}, map[uint16]datum.Datum, error) {
 	err := d.fromBytes(rowData)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	labelIDs := make(map[uint16]struct{})
+	labelIDs := make(map[int64]struct{})
 	for _, label := range d.labels {
-		if d.hasLabel(uint16(label.ID)) {
-			labelIDs[uint16(label.ID)] = struct{}{}
+		if d.hasLabel(label.ID) {
+			labelIDs[label.ID] = struct{}{}
 		}
 	}
 
@@ -56,9 +57,24 @@ func (d *PropertyDecoder) Decode(rowData []byte) (map[uint16]struct{}, map[uint1
 }
 
 func (d *PropertyDecoder) decodeColDatum(propData []byte) (datum.Datum, error) {
+	return decodeDatum(propData)
+}
+
+// DecodeValue decodes the property value encoded by EncodeValue.
+func DecodeValue(b []byte) (datum.Datum, error) {
+	if len(b) == 0 {
+		return nil, fmt.Errorf("invalid encoded value")
+	}
+	return decodeDatum(b)
+}
+
+// decodeDatum decodes the datum encoded by appendDatum.
+func decodeDatum(propData []byte) (datum.Datum, error) {
 	var value datum.Datum
 	typ := types.T(propData[0])
 	switch typ {
+	case types.Bool:
+		value = datum.NewBool(propData[1] != 0)
 	case types.Int:
 		value = datum.NewInt(decodeInt(propData[1:]))
 	case types.Float:
@@ -69,15 +85,99 @@ func (d *PropertyDecoder) decodeColDatum(propData []byte) (datum.Datum, error) {
 		value = datum.NewFloat(v)
 	case types.String:
 		value = datum.NewString(string(propData[1:]))
+	case types.Bytes:
+		value = datum.NewBytes(append([]byte(nil), propData[1:]...))
+	case types.Decimal:
+		v, err := datum.ParseDecimal(string(propData[1:]))
+		if err != nil {
+			return nil, err
+		}
+		value = v
 	case types.Date:
 		value = decodeDate(propData[1:])
+	case types.Time:
+		value = datum.NewTime(datum.TimeOfDay(decodeInt(propData[1:])))
+	case types.TimeTZ:
+		value = decodeTimeTZ(propData[1:])
+	case types.Timestamp:
+		value = &datum.Timestamp{Time: decodeTimestamp(propData[1:]).UTC()}
+	case types.TimestampTZ:
+		value = decodeTimestampTZ(propData[1:])
+	case types.Interval:
+		value = decodeInterval(propData[1:])
+	case types.List:
+		return decodeList(propData[1:])
+	case types.Map:
+		return decodeMap(propData[1:])
 	default:
-		// TODO: support more types
 		return value, fmt.Errorf("unknown type %s", typ)
 	}
 	return value, nil
 }
 
+func decodeList(val []byte) (datum.Datum, error) {
+	val, count, err := DecodeUvarint(val)
+	if err != nil {
+		return nil, err
+	}
+	var elems []datum.Datum
+	for i := uint64(0); i < count; i++ {
+		var elem datum.Datum
+		val, elem, err = decodeElement(val)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return datum.NewList(elems), nil
+}
+
+func decodeMap(val []byte) (datum.Datum, error) {
+	val, count, err := DecodeUvarint(val)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]datum.Datum)
+	for i := uint64(0); i < count; i++ {
+		var keyLen uint64
+		val, keyLen, err = DecodeUvarint(val)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(val)) < keyLen {
+			return nil, fmt.Errorf("insufficient bytes to decode map key")
+		}
+		key := string(val[:keyLen])
+		var elem datum.Datum
+		val, elem, err = decodeElement(val[keyLen:])
+		if err != nil {
+			return nil, err
+		}
+		entries[key] = elem
+	}
+	return datum.NewMap(entries), nil
+}
+
+// decodeElement decodes the element encoded by encodeElement and returns the
+// remaining bytes.
+func decodeElement(val []byte) ([]byte, datum.Datum, error) {
+	val, n, err := DecodeUvarint(val)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n == 0 || uint64(len(val)) < n {
+		return nil, nil, fmt.Errorf("insufficient bytes to decode element")
+	}
+	if types.T(val[0]) == types.Unknown {
+		return val[n:], datum.Null, nil
+	}
+	elem, err := decodeDatum(val[:n])
+	if err != nil {
+		return nil, nil, err
+	}
+	return val[n:], elem, nil
+}
+
 func decodeInt(val []byte) int64 {
 	switch len(val) {
 	case 1:
@@ -94,3 +194,28 @@ func decodeInt(val []byte) int64 {
 func decodeDate(val []byte) *datum.Date {
 	return datum.NewDateFromUnixEpochDays(int32(decodeInt(val)))
 }
+
+func decodeTimeTZ(val []byte) *datum.TimeTZ {
+	t := int32(binary.LittleEndian.Uint32(val))
+	offset := int32(binary.LittleEndian.Uint32(val[4:]))
+	return datum.NewTimeTZ(datum.TimeOfDay(t), offset)
+}
+
+func decodeTimestamp(val []byte) time.Time {
+	sec := int64(binary.LittleEndian.Uint64(val))
+	nsec := int64(binary.LittleEndian.Uint32(val[8:]))
+	return time.Unix(sec, nsec)
+}
+
+func decodeTimestampTZ(val []byte) *datum.TimestampTZ {
+	offset := int32(binary.LittleEndian.Uint32(val[12:]))
+	t := decodeTimestamp(val).In(time.FixedZone("", int(offset)))
+	return &datum.TimestampTZ{Time: t}
+}
+
+func decodeInterval(val []byte) *datum.Interval {
+	months := int64(binary.LittleEndian.Uint64(val))
+	days := int64(binary.LittleEndian.Uint64(val[8:]))
+	seconds := int64(binary.LittleEndian.Uint64(val[16:]))
+	return datum.NewIntervalFromParts(months, days, seconds)
+}
@@ -4,8 +4,11 @@ package codec
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/apd/v3"
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/parser/model"
 	"github.com/stretchr/testify/assert"
@@ -13,12 +16,12 @@ import (
 
 func TestPropertyDecoder_Decode(t *testing.T) {
 	cases := []struct {
-		labelIDs    []uint16
+		labelIDs    []int64
 		propertyIDs []uint16
 		values      []datum.Datum
 	}{
 		{
-			labelIDs:    []uint16{1, 2, 3},
+			labelIDs:    []int64{1, 2, 3},
 			propertyIDs: []uint16{1, 2, 3},
 			values: []datum.Datum{
 				datum.NewString("hello"),
@@ -27,7 +30,7 @@ func TestPropertyDecoder_Decode(t *testing.T) {
 			},
 		},
 		{
-			labelIDs:    []uint16{2, 3, 1},
+			labelIDs:    []int64{2, 3, 1},
 			propertyIDs: []uint16{2, 3, 1},
 			values: []datum.Datum{
 				datum.NewInt(1),
@@ -45,7 +48,7 @@ func TestPropertyDecoder_Decode(t *testing.T) {
 		var labels []*model.LabelInfo
 		for _, id := range c.labelIDs {
 			labels = append(labels, &model.LabelInfo{
-				ID:   int64(id),
+				ID:   id,
 				Name: model.NewCIStr(fmt.Sprintf("label%d", id)),
 			})
 		}
@@ -61,7 +64,7 @@ func TestPropertyDecoder_Decode(t *testing.T) {
 		decoder := NewPropertyDecoder(labels, properties)
 		labelIDs, row, err := decoder.Decode(bytes)
 		assert.NoError(t, err)
-		assert.Equal(t, map[uint16]struct{}{
+		assert.Equal(t, map[int64]struct{}{
 			1: {},
 			2: {},
 			3: {},
@@ -73,3 +76,108 @@ func TestPropertyDecoder_Decode(t *testing.T) {
 		}, row)
 	}
 }
+
+func TestPropertyDecoder_DecodeTypes(t *testing.T) {
+	decimal, _, err := apd.NewFromString("-12345678901234567890.123456789000")
+	assert.Nil(t, err)
+
+	values := []datum.Datum{
+		datum.NewBool(true),
+		datum.NewBool(false),
+		datum.NewInt(-1 << 40),
+		datum.NewFloat(-1.5),
+		datum.NewString("hello"),
+		datum.NewBytes([]byte{0, 1, 255}),
+		datum.NewDecimal(decimal),
+		datum.NewDateFromUnixEpochDays(-365),
+		datum.NewTime(datum.TimeOfDay(12*3600 + 34*60 + 56)),
+		datum.NewTimeTZ(datum.TimeOfDay(1), -570),
+		&datum.Timestamp{Time: time.Date(1960, 1, 2, 3, 4, 5, 6, time.UTC)},
+		&datum.TimestampTZ{Time: time.Date(2022, 1, 2, 3, 4, 5, 6, time.FixedZone("", 8*3600))},
+		datum.NewIntervalFromParts(-14, 3, 86401),
+		datum.NewList([]datum.Datum{datum.NewString("a"), datum.Null, datum.NewList([]datum.Datum{datum.NewInt(1)})}),
+		datum.NewMap(map[string]datum.Datum{"color": datum.NewString("red"), "size": datum.NewInt(3), "none": datum.Null}),
+	}
+
+	var properties []*model.PropertyInfo
+	var propertyIDs []uint16
+	for i := range values {
+		id := uint16(i + 1)
+		propertyIDs = append(propertyIDs, id)
+		properties = append(properties, &model.PropertyInfo{
+			ID:   id,
+			Name: model.NewCIStr(fmt.Sprintf("property%d", id)),
+		})
+	}
+
+	encoder := &PropertyEncoder{}
+	bytes, err := encoder.Encode(nil, nil, propertyIDs, values)
+	assert.Nil(t, err)
+
+	decoder := NewPropertyDecoder(nil, properties)
+	_, row, err := decoder.Decode(bytes)
+	assert.NoError(t, err)
+	assert.Len(t, row, len(values))
+	for i, value := range values {
+		got := row[propertyIDs[i]]
+		assert.Equal(t, value.Type(), got.Type())
+		assert.Equal(t, value.String(), got.String())
+	}
+
+	// The precision of decimal and the time zone are kept.
+	assert.Equal(t, 0, decimal.Cmp(datum.AsDecimal(row[7])))
+	assert.Equal(t, decimal.Exponent, datum.AsDecimal(row[7]).Exponent)
+	_, offset := datum.AsTimestampTZ(row[12]).Zone()
+	assert.Equal(t, 8*3600, offset)
+	assert.True(t, values[10].(*datum.Timestamp).Equal(datum.AsTimestamp(row[11]).Time))
+	months, days, seconds := datum.AsInterval(row[13]).Parts()
+	assert.Equal(t, []int64{-14, 3, 86401}, []int64{months, days, seconds})
+	assert.Equal(t, values[13], row[14])
+	assert.Equal(t, values[14], row[15])
+}
+
+func TestPropertyDecoder_DecodeLargeRow(t *testing.T) {
+	// The values exceed 64 KiB and the label ID exceeds 16 bits.
+	large := strings.Repeat("a", 1<<17)
+	labels := []*model.LabelInfo{{ID: 1 << 20, Name: model.NewCIStr("label")}, {ID: 1, Name: model.NewCIStr("other")}}
+	properties := []*model.PropertyInfo{
+		{ID: 1, Name: model.NewCIStr("property1")},
+		{ID: 2, Name: model.NewCIStr("property2")},
+	}
+
+	encoder := &PropertyEncoder{}
+	bytes, err := encoder.Encode(nil, []int64{1 << 20}, []uint16{1, 2}, []datum.Datum{
+		datum.NewString(large),
+		datum.NewInt(1),
+	})
+	assert.Nil(t, err)
+
+	decoder := NewPropertyDecoder(labels, properties)
+	labelIDs, row, err := decoder.Decode(bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int64]struct{}{1 << 20: {}}, labelIDs)
+	assert.Equal(t, map[uint16]datum.Datum{
+		1: datum.NewString(large),
+		2: datum.NewInt(1),
+	}, row)
+}
+
+func TestDecodeValue(t *testing.T) {
+	values := []datum.Datum{
+		datum.NewInt(-1),
+		datum.NewString(""),
+		datum.NewDateFromUnixEpochDays(1),
+		datum.NewList([]datum.Datum{datum.NewInt(1), datum.Null}),
+	}
+	for _, value := range values {
+		b, err := EncodeValue(value)
+		assert.NoError(t, err)
+		got, err := DecodeValue(b)
+		assert.NoError(t, err)
+		assert.Equal(t, value.Type(), got.Type())
+		assert.Equal(t, value.String(), got.String())
+	}
+
+	_, err := DecodeValue(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+// ---
+
+package codec
+
+import "errors"
+
+const degreeSep = 'd'
+
+const DegreeKeyLen = VertexKeyLen + 1 /*degreeSep*/ + 1 /*edgeSep*/
+
+// IncomingDegreeKey encodes the key of the counter of incoming edges of a vertex.
+// The counters are maintained with the edges, so that the degree of a vertex is
+// read without scanning its edges. The key is longer than the vertex key and the
+// separator is less than the edge separators, so it never falls into the ranges
+// of vertices and edges.
+//
+// The key format is: ${Prefix}${GraphID}${VertexID}${DegreeSep}${IncomingEdgeSep}.
+func IncomingDegreeKey(graphID, vertexID int64) []byte {
+	return degreeKey(graphID, vertexID, incomingEdgeSep)
+}
+
+// OutgoingDegreeKey encodes the key of the counter of outgoing edges of a vertex.
+//
+// The key format is: ${Prefix}${GraphID}${VertexID}${DegreeSep}${OutgoingEdgeSep}.
+func OutgoingDegreeKey(graphID, vertexID int64) []byte {
+	return degreeKey(graphID, vertexID, outgoingEdgeSep)
+}
+
+func degreeKey(graphID, vertexID int64, edgeSep byte) []byte {
+	result := make([]byte, 0, DegreeKeyLen)
+	result = append(result, prefix...)
+	result = EncodeInt(result, graphID)
+	result = EncodeInt(result, vertexID)
+	result = append(result, degreeSep, edgeSep)
+	return result
+}
+
+// EncodeDegree encodes the value of degree counter.
+func EncodeDegree(degree int64) []byte {
+	return EncodeInt(nil, degree)
+}
+
+// DecodeDegree decodes the value of degree counter.
+func DecodeDegree(val []byte) (int64, error) {
+	if len(val) != 8 {
+		return 0, errors.New("invalid degree value")
+	}
+	_, degree, err := DecodeInt(val)
+	return degree, err
+}
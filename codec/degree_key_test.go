@@ -0,0 +1,30 @@
+// ---
+
+package codec
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDegreeKey(t *testing.T) {
+	for _, vertexID := range []int64{0, 100, math.MaxInt64} {
+		for _, key := range [][]byte{IncomingDegreeKey(1, vertexID), OutgoingDegreeKey(1, vertexID)} {
+			require.Len(t, key, DegreeKeyLen)
+			// The degree keys are out of the ranges of edges.
+			require.Less(t, string(key), string(IncomingEdgeKey(1, 0, vertexID, 0)))
+			require.Less(t, string(key), string(OutgoingEdgeKey(1, vertexID, 0, 0)))
+			require.Greater(t, string(key), string(VertexKey(1, vertexID)))
+		}
+	}
+
+	for _, degree := range []int64{0, 1, math.MaxInt64} {
+		decoded, err := DecodeDegree(EncodeDegree(degree))
+		require.NoError(t, err)
+		require.Equal(t, degree, decoded)
+	}
+	_, err := DecodeDegree([]byte{1})
+	require.Error(t, err)
+}
@@ -9,25 +9,36 @@ const (
 	outgoingEdgeSep = 'o'
 )
 
-const EdgeKeyLen = 1 /*prefix*/ + 8 /*graphID*/ + 8 /*srcVertexID*/ + 1 /*edgeSep*/ + 8 /*dstVertexID*/
+const EdgeKeyLen = 1 /*prefix*/ + 8 /*graphID*/ + 8 /*srcVertexID*/ + 1 /*edgeSep*/ + 8 /*dstVertexID*/ + 8 /*edgeID*/
 
-// IncomingEdgeKey encodes the incoming edge key.
+// legacyEdgeKeyLen is the length of the edge keys without the edge IDs, which
+// are written by the storage format before the edge IDs were introduced.
+const legacyEdgeKeyLen = EdgeKeyLen - 8 /*edgeID*/
+
+var errLegacyEdgeKey = errors.New("edge key without edge ID: incompatible data directory")
+
+// IncomingEdgeKey encodes the incoming edge key. The edge ID is appended to the
+// key, so that there can be multiple edges between the same pair of vertices.
 //
-// The key format is: ${Prefix}${GraphID}${DstVertexID}${IncomingEdgeSep}${SrcVertexID}.
-func IncomingEdgeKey(graphID, srcVertexID, dstVertexID int64) []byte {
+// The key format is: ${Prefix}${GraphID}${DstVertexID}${IncomingEdgeSep}${SrcVertexID}${EdgeID}.
+func IncomingEdgeKey(graphID, srcVertexID, dstVertexID, edgeID int64) []byte {
 	result := make([]byte, 0, EdgeKeyLen)
 	result = append(result, prefix...)
 	result = EncodeInt(result, graphID)
 	result = EncodeInt(result, dstVertexID)
 	result = append(result, incomingEdgeSep)
 	result = EncodeInt(result, srcVertexID)
+	result = EncodeInt(result, edgeID)
 	return result
 }
 
 // ParseIncomingEdgeKey parse the incoming edge key.
-func ParseIncomingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID int64, err error) {
+func ParseIncomingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID, edgeID int64, err error) {
+	if len(key) == legacyEdgeKeyLen {
+		return 0, 0, 0, 0, errLegacyEdgeKey
+	}
 	if len(key) < EdgeKeyLen {
-		return 0, 0, 0, errors.New("insufficient key length")
+		return 0, 0, 0, 0, errors.New("insufficient key length")
 	}
 	_, graphID, err = DecodeInt(key[len(prefix):])
 	if err != nil {
@@ -38,26 +49,41 @@ func ParseIncomingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID int64,
 		return
 	}
 	_, srcVertexID, err = DecodeInt(key[len(prefix)+8+8+1:])
+	if err != nil {
+		return
+	}
+	_, edgeID, err = DecodeInt(key[len(prefix)+8+8+1+8:])
 	return
 }
 
-// OutgoingEdgeKey encodes the outgoing edge key.
+// OutgoingEdgeKey encodes the outgoing edge key. The edge ID is appended to the
+// key, so that there can be multiple edges between the same pair of vertices.
 //
-// The key format is: ${Prefix}${GraphID}${SrcVertexID}${outgoingEdgeSep}${DstVertexID}.
-func OutgoingEdgeKey(graphID, srcVertexID, dstVertexID int64) []byte {
+// The key format is: ${Prefix}${GraphID}${SrcVertexID}${outgoingEdgeSep}${DstVertexID}${EdgeID}.
+func OutgoingEdgeKey(graphID, srcVertexID, dstVertexID, edgeID int64) []byte {
 	result := make([]byte, 0, EdgeKeyLen)
 	result = append(result, prefix...)
 	result = EncodeInt(result, graphID)
 	result = EncodeInt(result, srcVertexID)
 	result = append(result, outgoingEdgeSep)
 	result = EncodeInt(result, dstVertexID)
+	result = EncodeInt(result, edgeID)
 	return result
 }
 
+// IsOutgoingEdgeKey reports whether the key is an outgoing edge key, which is
+// used to tell the keys apart when scanning the range of vertices.
+func IsOutgoingEdgeKey(key []byte) bool {
+	return len(key) == EdgeKeyLen && key[VertexKeyLen] == outgoingEdgeSep
+}
+
 // ParseOutgoingEdgeKey parse the outgoing edge key.
-func ParseOutgoingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID int64, err error) {
+func ParseOutgoingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID, edgeID int64, err error) {
+	if len(key) == legacyEdgeKeyLen {
+		return 0, 0, 0, 0, errLegacyEdgeKey
+	}
 	if len(key) < EdgeKeyLen {
-		return 0, 0, 0, errors.New("insufficient key length")
+		return 0, 0, 0, 0, errors.New("insufficient key length")
 	}
 	_, graphID, err = DecodeInt(key[len(prefix):])
 	if err != nil {
@@ -68,5 +94,40 @@ func ParseOutgoingEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID int64,
 		return
 	}
 	_, dstVertexID, err = DecodeInt(key[len(prefix)+8+8+1:])
+	if err != nil {
+		return
+	}
+	_, edgeID, err = DecodeInt(key[len(prefix)+8+8+1+8:])
+	return
+}
+
+// IsLegacyEdgeKey reports whether the key is an edge key without the edge ID.
+func IsLegacyEdgeKey(key []byte) bool {
+	return len(key) == legacyEdgeKeyLen && (key[VertexKeyLen] == incomingEdgeSep || key[VertexKeyLen] == outgoingEdgeSep)
+}
+
+// ParseLegacyEdgeKey parses the edge key without the edge ID, which is used to
+// upgrade the edge keys to the current format. The key format is the format of
+// the incoming or outgoing edge key without the trailing ${EdgeID}.
+func ParseLegacyEdgeKey(key []byte) (graphID, srcVertexID, dstVertexID int64, outgoing bool, err error) {
+	if !IsLegacyEdgeKey(key) {
+		return 0, 0, 0, false, errors.New("invalid legacy edge key")
+	}
+	_, graphID, err = DecodeInt(key[len(prefix):])
+	if err != nil {
+		return
+	}
+	_, srcVertexID, err = DecodeInt(key[len(prefix)+8:])
+	if err != nil {
+		return
+	}
+	_, dstVertexID, err = DecodeInt(key[len(prefix)+8+8+1:])
+	if err != nil {
+		return
+	}
+	outgoing = key[VertexKeyLen] == outgoingEdgeSep
+	if !outgoing {
+		srcVertexID, dstVertexID = dstVertexID, srcVertexID
+	}
 	return
 }
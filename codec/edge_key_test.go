@@ -14,33 +14,85 @@ func TestEdgeKey(t *testing.T) {
 		graphID     int64
 		srcVertexID int64
 		dstVertexID int64
+		edgeID      int64
 	}{
 		{
 			graphID:     100,
 			srcVertexID: 200,
 			dstVertexID: 300,
+			edgeID:      400,
 		},
 		{
 			graphID:     math.MaxInt64,
 			srcVertexID: math.MaxInt64,
 			dstVertexID: math.MaxInt64,
+			edgeID:      math.MaxInt64,
 		},
 	}
 	for _, c := range cases {
-		incomingEdgeKey := IncomingEdgeKey(c.graphID, c.srcVertexID, c.dstVertexID)
-		graphID, srcVertexID, dstVertexID, err := ParseIncomingEdgeKey(incomingEdgeKey)
+		incomingEdgeKey := IncomingEdgeKey(c.graphID, c.srcVertexID, c.dstVertexID, c.edgeID)
+		graphID, srcVertexID, dstVertexID, edgeID, err := ParseIncomingEdgeKey(incomingEdgeKey)
 		require.NoError(t, err)
 		require.Equal(t, c.graphID, graphID)
 		require.Equal(t, c.srcVertexID, srcVertexID)
 		require.Equal(t, c.dstVertexID, dstVertexID)
+		require.Equal(t, c.edgeID, edgeID)
 
-		outgoingEdgeKey := OutgoingEdgeKey(c.graphID, c.srcVertexID, c.dstVertexID)
-		graphID, srcVertexID, dstVertexID, err = ParseOutgoingEdgeKey(outgoingEdgeKey)
+		outgoingEdgeKey := OutgoingEdgeKey(c.graphID, c.srcVertexID, c.dstVertexID, c.edgeID)
+		graphID, srcVertexID, dstVertexID, edgeID, err = ParseOutgoingEdgeKey(outgoingEdgeKey)
 		require.NoError(t, err)
 		require.Equal(t, c.graphID, graphID)
 		require.Equal(t, c.srcVertexID, srcVertexID)
 		require.Equal(t, c.dstVertexID, dstVertexID)
+		require.Equal(t, c.edgeID, edgeID)
 
 		require.NotEqual(t, incomingEdgeKey, outgoingEdgeKey)
+		require.True(t, IsOutgoingEdgeKey(outgoingEdgeKey))
+		require.False(t, IsOutgoingEdgeKey(incomingEdgeKey))
+		require.False(t, IsOutgoingEdgeKey(VertexKey(c.graphID, c.srcVertexID)))
+		require.False(t, IsOutgoingEdgeKey(OutgoingDegreeKey(c.graphID, c.srcVertexID)))
 	}
 }
+
+func TestEdgeKey_MultiEdges(t *testing.T) {
+	// The parallel edges are ordered by the edge ID and stay adjacent.
+	k1 := OutgoingEdgeKey(1, 2, 3, 10)
+	k2 := OutgoingEdgeKey(1, 2, 3, 11)
+	k3 := OutgoingEdgeKey(1, 2, 4, 5)
+	require.Less(t, string(k1), string(k2))
+	require.Less(t, string(k2), string(k3))
+
+	k1 = IncomingEdgeKey(1, 2, 3, 10)
+	k2 = IncomingEdgeKey(1, 2, 3, 11)
+	k3 = IncomingEdgeKey(1, 4, 3, 5)
+	require.Less(t, string(k1), string(k2))
+	require.Less(t, string(k2), string(k3))
+}
+
+func TestEdgeKey_Legacy(t *testing.T) {
+	// The edge keys without the edge IDs are rejected.
+	key := OutgoingEdgeKey(1, 2, 3, 4)
+	_, _, _, _, err := ParseOutgoingEdgeKey(key[:len(key)-8])
+	require.ErrorContains(t, err, "incompatible data directory")
+
+	key = IncomingEdgeKey(1, 2, 3, 4)
+	_, _, _, _, err = ParseIncomingEdgeKey(key[:len(key)-8])
+	require.ErrorContains(t, err, "incompatible data directory")
+
+	for _, outgoing := range []bool{true, false} {
+		key := IncomingEdgeKey(1, 2, 3, 4)
+		if outgoing {
+			key = OutgoingEdgeKey(1, 2, 3, 4)
+		}
+		require.False(t, IsLegacyEdgeKey(key))
+		require.True(t, IsLegacyEdgeKey(key[:len(key)-8]))
+		graphID, srcVertexID, dstVertexID, isOutgoing, err := ParseLegacyEdgeKey(key[:len(key)-8])
+		require.NoError(t, err)
+		require.Equal(t, int64(1), graphID)
+		require.Equal(t, int64(2), srcVertexID)
+		require.Equal(t, int64(3), dstVertexID)
+		require.Equal(t, outgoing, isOutgoing)
+	}
+	require.False(t, IsLegacyEdgeKey(VertexKey(1, 2)))
+	require.False(t, IsLegacyEdgeKey(OutgoingDegreeKey(1, 2)))
+}
@@ -6,7 +6,9 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sort"
+	"time"
 
+	"github.com/cockroachdb/apd/v3"
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/types"
 )
@@ -19,34 +21,74 @@ type PropertyEncoder struct {
 }
 
 // Encode encodes properties into a value bytes.
-func (e *PropertyEncoder) Encode(buf []byte, labelIDs, propertyIDs []uint16, values []datum.Datum) ([]byte, error) {
+func (e *PropertyEncoder) Encode(buf []byte, labelIDs []int64, propertyIDs []uint16, values []datum.Datum) ([]byte, error) {
 	e.reform(labelIDs, propertyIDs, values)
 	for i, value := range e.values {
 		err := e.encodeDatum(value)
 		if err != nil {
 			return nil, err
 		}
-		e.offsets[i] = uint16(len(e.data))
+		if uint64(len(e.data)) > maxRowDataLen {
+			return nil, fmt.Errorf("row data too large: exceeds %d bytes", maxRowDataLen)
+		}
+		e.offsets[i] = uint32(len(e.data))
 	}
 	return e.toBytes(buf[:0]), nil
 }
 
 func (e *PropertyEncoder) encodeDatum(value datum.Datum) error {
+	data, err := appendDatum(e.data, value)
+	if err != nil {
+		return err
+	}
+	e.data = data
+	return nil
+}
+
+// EncodeValue encodes a single property value in the same format as the values
+// of rows, which is used to persist the values outside rows (e.g: the default
+// values of the label schemas).
+func EncodeValue(value datum.Datum) ([]byte, error) {
+	return appendDatum(nil, value)
+}
+
+// appendDatum appends the type and the encoded value of the datum to the buffer.
+func appendDatum(buf []byte, value datum.Datum) ([]byte, error) {
 	// Put the type information first.
-	e.data = append(e.data, byte(value.Type()))
+	buf = append(buf, byte(value.Type()))
 	switch value.Type() {
+	case types.Bool:
+		buf = encodeBool(buf, datum.AsBool(value))
 	case types.Int:
-		e.data = encodeInt(e.data, datum.AsInt(value))
+		buf = encodeInt(buf, datum.AsInt(value))
 	case types.Float:
-		e.data = EncodeFloat(e.data, datum.AsFloat(value))
+		buf = EncodeFloat(buf, datum.AsFloat(value))
 	case types.String:
-		e.data = append(e.data, datum.AsBytes(value)...)
+		buf = append(buf, datum.AsBytes(value)...)
+	case types.Bytes:
+		buf = append(buf, datum.AsBytes(value)...)
+	case types.Decimal:
+		buf = encodeDecimal(buf, datum.AsDecimal(value))
 	case types.Date:
-		e.data = encodeDate(e.data, datum.AsDate(value))
+		buf = encodeDate(buf, datum.AsDate(value))
+	case types.Time:
+		buf = encodeInt(buf, int64(datum.AsTime(value).TimeOfDay))
+	case types.TimeTZ:
+		buf = encodeTimeTZ(buf, datum.AsTimeTZ(value))
+	case types.Timestamp:
+		buf = encodeTimestamp(buf, datum.AsTimestamp(value).Time)
+	case types.TimestampTZ:
+		buf = encodeTimestampTZ(buf, datum.AsTimestampTZ(value).Time)
+	case types.Interval:
+		buf = encodeInterval(buf, datum.AsInterval(value))
+	case types.List:
+		return encodeList(buf, datum.AsList(value))
+	case types.Map:
+		return encodeMap(buf, datum.AsMap(value))
 	default:
-		return fmt.Errorf("unsupported encode type %T", value)
+		return nil, fmt.Errorf("unsupported encode type %T", value)
 	}
-	return nil
+	return buf, nil
 }
 
 func encodeInt(buf []byte, iVal int64) []byte {
@@ -66,14 +108,107 @@ func encodeInt(buf []byte, iVal int64) []byte {
 	return buf
 }
 
+func encodeBool(buf []byte, b bool) []byte {
+	if b {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+// encodeDecimal encodes the decimal as the scientific string, which keeps the
+// precision (e.g: the trailing zeros) and the special values.
+func encodeDecimal(buf []byte, d *apd.Decimal) []byte {
+	return append(buf, d.String()...)
+}
+
 func encodeDate(buf []byte, date *datum.Date) []byte {
 	return encodeInt(buf, int64(date.UnixEpochDays()))
 }
 
-func (e *PropertyEncoder) reform(labelIDs, propertyIDs []uint16, values []datum.Datum) {
+// encodeTimeTZ encodes the time of day and the offset of time zone in minutes
+// as 4-byte integers.
+func encodeTimeTZ(buf []byte, t *datum.TimeTZ) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(t.TimeOfDay))
+	return binary.LittleEndian.AppendUint32(buf, uint32(t.OffsetMinutes()))
+}
+
+// encodeTimestamp encodes the seconds since unix epoch as an 8-byte integer and
+// the nanoseconds as a 4-byte integer.
+func encodeTimestamp(buf []byte, t time.Time) []byte {
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(t.Unix()))
+	return binary.LittleEndian.AppendUint32(buf, uint32(t.Nanosecond()))
+}
+
+// encodeTimestampTZ encodes the timestamp followed by the offset of time zone
+// in seconds as a 4-byte integer.
+func encodeTimestampTZ(buf []byte, t time.Time) []byte {
+	_, offset := t.Zone()
+	buf = encodeTimestamp(buf, t)
+	return binary.LittleEndian.AppendUint32(buf, uint32(int32(offset)))
+}
+
+// encodeInterval encodes the months, days and seconds as 8-byte integers.
+func encodeInterval(buf []byte, i *datum.Interval) []byte {
+	months, days, seconds := i.Parts()
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(months))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(days))
+	return binary.LittleEndian.AppendUint64(buf, uint64(seconds))
+}
+
+// encodeList encodes the number of elements followed by the elements.
+func encodeList(buf []byte, elems []datum.Datum) ([]byte, error) {
+	buf = EncodeUvarint(buf, uint64(len(elems)))
+	for _, elem := range elems {
+		var err error
+		buf, err = encodeElement(buf, elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// encodeMap encodes the number of entries followed by the keys and values, and
+// the entries are sorted by keys to make the encoded bytes deterministic.
+func encodeMap(buf []byte, entries map[string]datum.Datum) ([]byte, error) {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf = EncodeUvarint(buf, uint64(len(keys)))
+	for _, key := range keys {
+		buf = EncodeUvarint(buf, uint64(len(key)))
+		buf = append(buf, key...)
+		var err error
+		buf, err = encodeElement(buf, entries[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// encodeElement encodes the element of a list or map prefixed with its length.
+// Unlike properties, the elements can be NULL.
+func encodeElement(buf []byte, elem datum.Datum) ([]byte, error) {
+	data := []byte{byte(types.Unknown)}
+	if elem != datum.Null {
+		var err error
+		data, err = appendDatum(nil, elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	buf = EncodeUvarint(buf, uint64(len(data)))
+	return append(buf, data...), nil
+}
+
+func (e *PropertyEncoder) reform(labelIDs []int64, propertyIDs []uint16, values []datum.Datum) {
 	e.labelIDs = append(e.labelIDs[:0], labelIDs...)
 	e.propertyIDs = append(e.propertyIDs[:0], propertyIDs...)
-	e.offsets = make([]uint16, len(e.propertyIDs))
+	e.offsets = make([]uint32, len(e.propertyIDs))
 	e.data = e.data[:0]
 	e.values = e.values[:0]
 
@@ -11,12 +11,12 @@ import (
 
 func TestPropertyEncoder_Encode(t *testing.T) {
 	cases := []struct {
-		labelIDs    []uint16
+		labelIDs    []int64
 		propertyIDs []uint16
 		values      []datum.Datum
 	}{
 		{
-			labelIDs:    []uint16{1, 2, 3},
+			labelIDs:    []int64{1, 2, 3},
 			propertyIDs: []uint16{1, 2, 3},
 			values: []datum.Datum{
 				datum.NewString("hello"),
@@ -2,31 +2,55 @@
 
 package codec
 
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/cockroachdb/apd/v3"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+// The index types distinguish the index keys of vertices and edges.
+const (
+	VertexIndexType byte = 'v'
+	EdgeIndexType   byte = 'e'
+)
+
 var (
 	prefix    = []byte("g")
 	vertexSep = []byte("v")
 	edgeSep   = []byte("e")
 	indexSep  = []byte("i")
+	labelSep  = []byte("l")
 )
 
 // INDEX CODEC DOCUMENTATIONS:
 
-// NOTE: Label is a special kind of indexSep ($LabelID equals $IndexID).
-// The following SQL specifies an edgeSep label `known` and the LabelID will be
-// the identifier of edgeSep `known`.
-//      INSERT EDGE e BETWEEN x AND y LABELS ( knows )
-//      FROM MATCH (x:Person)
-//         , MATCH (y:Person)
-//      WHERE id(x) = 1 AND id(y) = 2
+// NOTE: Label is a special kind of index which records the elements attached to
+// the label. The following SQL writes a label key of vertex x for label `Person`.
+//      INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Lee')
+//
+// The separators (LabelSep and IndexSep) are less than the encoded non-negative
+// vertex IDs, so that the label and index keys never fall into the key range of
+// the vertices and edges of a graph.
 
 //
 // - Key Format:
-//   Unique Key:     $Prefix_$GraphID_$LabelID_$Type
-//   Non-Unique Key: $Prefix_$GraphID_$LabelID_$Type_$Unique
-//   Unique Key:     $Prefix_$GraphID_$IndexID_$Type
-//   Non-Unique Key: $Prefix_$GraphID_$IndexID_$Type_$Unique
+//   Label Key:      $Prefix_$GraphID_$LabelSep_$LabelID_$Type_$Unique
+//   Unique Key:     $Prefix_$GraphID_$IndexSep_$IndexID_$Type_$Values
+//   Non-Unique Key: $Prefix_$GraphID_$IndexSep_$IndexID_$Type_$Values_$Unique
 // - Value Format:
-//   [($PropertyID, $PropertyValue), ...]
+//   Label Key:      a zero byte (see LabelValue)
+//   Unique Key:     $Unique
+//   Non-Unique Key: a zero byte (see LabelValue)
+//
+// $Values Explanation:
+// The property values of the index encoded by EncodeIndexValues. The index key
+// is only written if the element has all properties of the index.
 //
 // $Type Explanation:
 // We need to distinguish the indexSep key type because a label can be both attach
@@ -37,26 +61,46 @@ var (
 // $Unique Explanation:
 // 1. For vertexSep label indexSep: it will be the vertexSep identifier.
 // 2. For edgeSep label indexSep: it will be $SrcVertexID_$DstVertexID
+//
+// Only the vertex label keys are maintained currently.
 
-// LabelKey returns the encoded key of specified graph/label.
-func LabelKey(graphID, labelID, vertexID, dstVertexID int64) []byte {
-	var result []byte
-	if dstVertexID == 0 {
-		result = make([]byte, 0, len(prefix)+8 /*graphID*/ +8 /*labelID*/ +8 /*vertexID*/ + +len(vertexSep))
-	} else {
-		result = make([]byte, 0, len(prefix)+8 /*graphID*/ +8 /*labelID*/ +8 /*vertexID*/ + +len(edgeSep) + 8 /*dstVertexID*/)
-	}
+// LabelPrefix returns the common prefix of all label keys of the specified label.
+// The format is: $Prefix_$GraphID_$LabelSep_$LabelID.
+func LabelPrefix(graphID, labelID int64) []byte {
+	result := make([]byte, 0, len(prefix)+8 /*graphID*/ +len(labelSep)+8 /*labelID*/ +1 /*type*/ +8 /*vertexID*/)
 	result = append(result, prefix...)
 	result = EncodeInt(result, graphID)
+	result = append(result, labelSep...)
 	result = EncodeInt(result, labelID)
-	result = EncodeInt(result, vertexID)
-	if dstVertexID == 0 {
-		result = EncodeBytes(result, vertexSep)
-	} else {
-		result = EncodeBytes(result, edgeSep)
-		result = EncodeInt(result, dstVertexID)
+	return result
+}
+
+// VertexLabelPrefix returns the common prefix of the label keys of all vertices
+// attached to the specified label.
+func VertexLabelPrefix(graphID, labelID int64) []byte {
+	return append(LabelPrefix(graphID, labelID), VertexIndexType)
+}
+
+// VertexLabelKey returns the label key of the vertex for the specified label.
+func VertexLabelKey(graphID, labelID, vertexID int64) []byte {
+	return EncodeInt(VertexLabelPrefix(graphID, labelID), vertexID)
+}
+
+// ParseVertexLabelKey parses the vertex label key.
+func ParseVertexLabelKey(key []byte) (graphID, labelID, vertexID int64, err error) {
+	if len(key) != len(prefix)+8+len(labelSep)+8+1+8 || key[len(key)-9] != VertexIndexType {
+		return 0, 0, 0, errors.New("invalid vertex label key")
+	}
+	_, graphID, err = DecodeInt(key[len(prefix):])
+	if err != nil {
+		return
 	}
-	return nil
+	_, labelID, err = DecodeInt(key[len(prefix)+8+len(labelSep):])
+	if err != nil {
+		return
+	}
+	_, vertexID, err = DecodeInt(key[len(key)-8:])
+	return
 }
 
 // LabelValue returns a zero which is represents the flag byte of normal value.
@@ -64,32 +108,251 @@ func LabelValue() []byte {
 	return []byte{0}
 }
 
+// IndexPrefix returns the common prefix of all keys of the specified index.
+// The format is: $Prefix_$GraphID_$IndexSep_$IndexID.
+func IndexPrefix(graphID, indexID int64) []byte {
+	result := make([]byte, 0, len(prefix)+8 /*graphID*/ +len(indexSep)+8 /*indexID*/)
+	result = append(result, prefix...)
+	result = EncodeInt(result, graphID)
+	result = append(result, indexSep...)
+	result = EncodeInt(result, indexID)
+	return result
+}
+
+// IndexValuesPrefix returns the common prefix of the index keys of the specified
+// index type and the index values encoded by EncodeIndexValues.
+func IndexValuesPrefix(graphID, indexID int64, typ byte, values []byte) []byte {
+	result := IndexPrefix(graphID, indexID)
+	result = append(result, typ)
+	result = append(result, values...)
+	return result
+}
+
 // UniqueIndexKey encodes the unique index key described as above.
-func UniqueIndexKey(graphID, indexID int64, typ byte) []byte {
-	return nil
+func UniqueIndexKey(graphID, indexID int64, typ byte, values []byte) []byte {
+	return IndexValuesPrefix(graphID, indexID, typ, values)
 }
 
 // VertexNonUniqueIndexKey encodes the non-unique index key described as above.
-func VertexNonUniqueIndexKey(graphID, indexID, vertexID int64) []byte {
-	return nil
+func VertexNonUniqueIndexKey(graphID, indexID int64, values []byte, vertexID int64) []byte {
+	result := IndexValuesPrefix(graphID, indexID, VertexIndexType, values)
+	return EncodeInt(result, vertexID)
 }
 
 // EdgeNonUniqueIndexKey encodes the non-unique index key described as above.
-func EdgeNonUniqueIndexKey(graphID, indexID, srcVertexID, dstVertexID int64) []byte {
-	return nil
+func EdgeNonUniqueIndexKey(graphID, indexID int64, values []byte, srcVertexID, dstVertexID int64) []byte {
+	result := IndexValuesPrefix(graphID, indexID, EdgeIndexType, values)
+	result = EncodeInt(result, srcVertexID)
+	return EncodeInt(result, dstVertexID)
 }
 
 // ParseUniqueIndexKey parse the unique key.
-func ParseUniqueIndexKey(key []byte) (graphID, indexID int64, typ byte, err error) {
-	return
+func ParseUniqueIndexKey(key []byte) (graphID, indexID int64, typ byte, values []byte, err error) {
+	graphID, indexID, key, err = parseIndexPrefix(key)
+	if err != nil {
+		return
+	}
+	if len(key) < 1 {
+		err = errors.New("insufficient key length")
+		return
+	}
+	return graphID, indexID, key[0], key[1:], nil
 }
 
 // ParseVertexNonUniqueIndexKey parses the vertex non-unique key.
-func ParseVertexNonUniqueIndexKey(key []byte) (graphID, indexID, vertexID int64, err error) {
+func ParseVertexNonUniqueIndexKey(key []byte) (graphID, indexID int64, values []byte, vertexID int64, err error) {
+	graphID, indexID, key, err = parseIndexPrefix(key)
+	if err != nil {
+		return
+	}
+	if len(key) < 1+8 || key[0] != VertexIndexType {
+		err = errors.New("invalid vertex index key")
+		return
+	}
+	values = key[1 : len(key)-8]
+	_, vertexID, err = DecodeInt(key[len(key)-8:])
 	return
 }
 
 // ParseEdgeNonUniqueIndexKey parses the edge non-unique key.
-func ParseEdgeNonUniqueIndexKey(key []byte) (graphID, indexID, srcVertexID, dstVertexID int64, err error) {
+func ParseEdgeNonUniqueIndexKey(key []byte) (graphID, indexID int64, values []byte, srcVertexID, dstVertexID int64, err error) {
+	graphID, indexID, key, err = parseIndexPrefix(key)
+	if err != nil {
+		return
+	}
+	if len(key) < 1+8+8 || key[0] != EdgeIndexType {
+		err = errors.New("invalid edge index key")
+		return
+	}
+	values = key[1 : len(key)-16]
+	_, srcVertexID, err = DecodeInt(key[len(key)-16:])
+	if err != nil {
+		return
+	}
+	_, dstVertexID, err = DecodeInt(key[len(key)-8:])
 	return
 }
+
+func parseIndexPrefix(key []byte) (graphID, indexID int64, remain []byte, err error) {
+	if len(key) < len(prefix)+8+len(indexSep)+8 {
+		return 0, 0, nil, errors.New("insufficient key length")
+	}
+	_, graphID, err = DecodeInt(key[len(prefix):])
+	if err != nil {
+		return
+	}
+	_, indexID, err = DecodeInt(key[len(prefix)+8+len(indexSep):])
+	if err != nil {
+		return
+	}
+	return graphID, indexID, key[len(prefix)+8+len(indexSep)+8:], nil
+}
+
+const (
+	indexValueBool byte = iota + 1
+	indexValueNumber
+	indexValueBytes
+	indexValueOther
+)
+
+// The classes of numeric values in the index keys, in ascending order.
+const (
+	indexNumberNaN byte = iota
+	indexNumberNegInf
+	indexNumberNeg
+	indexNumberZero
+	indexNumberPos
+	indexNumberPosInf
+)
+
+// EncodeIndexValues encodes the property values into the memory-comparable
+// format used in index keys. The numeric values (Int, Float and Decimal) are
+// all encoded losslessly as decimals and String/Bytes are encoded as bytes, so
+// that the values compare equal in expressions have the same encoding.
+func EncodeIndexValues(b []byte, values []datum.Datum) ([]byte, error) {
+	for _, value := range values {
+		b = append(b, IndexValueClass(value)...)
+		switch value.Type() {
+		case types.Bool:
+			if datum.AsBool(value) {
+				b = append(b, 1)
+			} else {
+				b = append(b, 0)
+			}
+		case types.Int:
+			b = encodeIndexInt(b, datum.AsInt(value))
+		case types.Float:
+			b = encodeIndexFloat(b, datum.AsFloat(value))
+		case types.Decimal:
+			b = encodeIndexDecimal(b, datum.AsDecimal(value))
+		case types.String, types.Bytes:
+			b = EncodeBytes(b, datum.AsBytes(value))
+		default:
+			b = EncodeBytes(b, datum.AppendDatum(nil, value))
+		}
+	}
+	return b, nil
+}
+
+// IndexValueClass returns the leading bytes of the value encoded by
+// EncodeIndexValues. The values which can be compared with each other, e.g. all
+// the numeric values, have the same class, and the values of different classes
+// cannot be compared in expressions.
+func IndexValueClass(value datum.Datum) []byte {
+	switch value.Type() {
+	case types.Bool:
+		return []byte{indexValueBool}
+	case types.Int, types.Float, types.Decimal:
+		return []byte{indexValueNumber}
+	case types.String, types.Bytes:
+		return []byte{indexValueBytes}
+	default:
+		return []byte{indexValueOther, byte(value.Type())}
+	}
+}
+
+func encodeIndexInt(b []byte, v int64) []byte {
+	if v == 0 {
+		return append(b, indexNumberZero)
+	}
+	abs := uint64(v)
+	if v < 0 {
+		abs = -abs
+	}
+	digits := strconv.AppendUint(nil, abs, 10)
+	return encodeIndexNumber(b, v < 0, digits, int64(len(digits)))
+}
+
+// encodeIndexFloat encodes the float as the decimal with the shortest digits
+// which converts back to the same float, i.e. the decimal compared with the
+// float in expressions.
+func encodeIndexFloat(b []byte, f float64) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(b, indexNumberNaN)
+	case math.IsInf(f, -1):
+		return append(b, indexNumberNegInf)
+	case math.IsInf(f, 1):
+		return append(b, indexNumberPosInf)
+	case f == 0:
+		return append(b, indexNumberZero)
+	}
+	// The format is d.ddde±dd.
+	s := strconv.AppendFloat(nil, math.Abs(f), 'e', -1, 64)
+	e := bytes.IndexByte(s, 'e')
+	exp, _ := strconv.Atoi(string(s[e+1:]))
+	digits := s[:1:1]
+	if e > 1 {
+		digits = append(digits, s[2:e]...)
+	}
+	return encodeIndexNumber(b, f < 0, digits, int64(exp)+1)
+}
+
+func encodeIndexDecimal(b []byte, d *apd.Decimal) []byte {
+	switch d.Form {
+	case apd.NaN, apd.NaNSignaling:
+		return append(b, indexNumberNaN)
+	case apd.Infinite:
+		if d.Negative {
+			return append(b, indexNumberNegInf)
+		}
+		return append(b, indexNumberPosInf)
+	}
+	if d.Coeff.Sign() == 0 {
+		return append(b, indexNumberZero)
+	}
+	digits := d.Coeff.Append(nil, 10)
+	return encodeIndexNumber(b, d.Negative, digits, int64(len(digits))+int64(d.Exponent))
+}
+
+// encodeIndexNumber encodes the non-zero number 0.digits * 10^exp, where the
+// digits have no leading zeros. The exponent is encoded before the digits, and
+// both are inverted for negative numbers to keep the encoding memory-comparable.
+func encodeIndexNumber(b []byte, negative bool, digits []byte, exp int64) []byte {
+	digits = bytes.TrimRight(digits, "0")
+	if negative {
+		b = append(b, indexNumberNeg)
+		b = EncodeIntDesc(b, exp)
+		for _, d := range digits {
+			b = append(b, ^d)
+		}
+		return append(b, 0xff)
+	}
+	b = append(b, indexNumberPos)
+	b = EncodeInt(b, exp)
+	b = append(b, digits...)
+	return append(b, 0)
+}
+
+// IndexLookupSupported reports whether the values of the type can be used to
+// look up the index, i.e. the values equal in expressions must have the same
+// encoding. The time types with time zones are not supported because the same
+// instant can be represented in different time zones.
+func IndexLookupSupported(tp types.T) bool {
+	switch tp {
+	case types.Bool, types.Int, types.Float, types.Decimal, types.String, types.Bytes, types.Date:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,3 +1,151 @@
 // ---
 
 package codec
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVertexNonUniqueIndexKey(t *testing.T) {
+	values, err := EncodeIndexValues(nil, []datum.Datum{datum.NewString("Lee"), datum.NewInt(22)})
+	require.NoError(t, err)
+
+	key := VertexNonUniqueIndexKey(100, 200, values, math.MaxInt64)
+	graphID, indexID, vals, vertexID, err := ParseVertexNonUniqueIndexKey(key)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), graphID)
+	require.Equal(t, int64(200), indexID)
+	require.Equal(t, values, vals)
+	require.Equal(t, int64(math.MaxInt64), vertexID)
+	require.True(t, len(key) > len(IndexPrefix(100, 200)))
+	require.Equal(t, IndexPrefix(100, 200), key[:len(IndexPrefix(100, 200))])
+
+	_, _, _, _, err = ParseVertexNonUniqueIndexKey(EdgeNonUniqueIndexKey(100, 200, values, 1, 2))
+	require.Error(t, err)
+}
+
+func TestEdgeNonUniqueIndexKey(t *testing.T) {
+	values, err := EncodeIndexValues(nil, []datum.Datum{datum.NewBool(true)})
+	require.NoError(t, err)
+
+	key := EdgeNonUniqueIndexKey(1, 2, values, 3, 4)
+	graphID, indexID, vals, srcVertexID, dstVertexID, err := ParseEdgeNonUniqueIndexKey(key)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), graphID)
+	require.Equal(t, int64(2), indexID)
+	require.Equal(t, values, vals)
+	require.Equal(t, int64(3), srcVertexID)
+	require.Equal(t, int64(4), dstVertexID)
+}
+
+func TestUniqueIndexKey(t *testing.T) {
+	values, err := EncodeIndexValues(nil, []datum.Datum{datum.NewString("a@b.c")})
+	require.NoError(t, err)
+
+	key := UniqueIndexKey(1, 2, VertexIndexType, values)
+	graphID, indexID, typ, vals, err := ParseUniqueIndexKey(key)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), graphID)
+	require.Equal(t, int64(2), indexID)
+	require.Equal(t, VertexIndexType, typ)
+	require.Equal(t, values, vals)
+}
+
+func TestEncodeIndexValues(t *testing.T) {
+	encode := func(d datum.Datum) string {
+		b, err := EncodeIndexValues(nil, []datum.Datum{d})
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	// The values equal in expressions have the same encoding.
+	require.Equal(t, encode(datum.NewInt(1)), encode(datum.NewFloat(1)))
+	require.Equal(t, encode(datum.NewInt(1)), encode(datum.NewDecimal(apd.New(10, -1))))
+	require.Equal(t, encode(datum.NewString("abc")), encode(datum.NewBytes([]byte("abc"))))
+
+	require.NotEqual(t, encode(datum.NewInt(1)), encode(datum.NewString("1")))
+	require.NotEqual(t, encode(datum.NewBool(true)), encode(datum.NewInt(1)))
+
+	// The encoding starts with the class of the value.
+	for _, d := range []datum.Datum{datum.NewBool(true), datum.NewInt(1), datum.NewFloat(1.5), datum.NewString("1")} {
+		require.True(t, strings.HasPrefix(encode(d), string(IndexValueClass(d))))
+	}
+	require.Equal(t, IndexValueClass(datum.NewInt(1)), IndexValueClass(datum.NewDecimal(apd.New(1, 0))))
+	require.Equal(t, IndexValueClass(datum.NewString("1")), IndexValueClass(datum.NewBytes([]byte("1"))))
+	require.NotEqual(t, IndexValueClass(datum.NewInt(1)), IndexValueClass(datum.NewString("1")))
+
+	require.Equal(t, encode(datum.NewFloat(0.1)), encode(datum.NewDecimal(apd.New(1, -1))))
+	require.Equal(t, encode(datum.NewInt(-120)), encode(datum.NewDecimal(apd.New(-12000, -2))))
+	require.Equal(t, encode(datum.NewInt(0)), encode(datum.NewDecimal(apd.New(0, 5))))
+	require.Equal(t, encode(datum.NewFloat(math.Copysign(0, -1))), encode(datum.NewInt(0)))
+
+	// The numbers are encoded losslessly.
+	require.NotEqual(t, encode(datum.NewInt(1<<53)), encode(datum.NewInt(1<<53+1)))
+	require.NotEqual(t, encode(datum.NewInt(math.MaxInt64)), encode(datum.NewInt(math.MaxInt64-1)))
+	require.NotEqual(t,
+		encode(datum.NewDecimal(apd.New(1<<53, 0))),
+		encode(datum.NewDecimal(apd.New(1<<53+1, 0))))
+	require.NotEqual(t,
+		encode(datum.NewDecimal(apd.New(1, -30))),
+		encode(datum.NewDecimal(apd.New(1000000000000000001, -48))))
+
+	// The encoding is memory-comparable.
+	require.Less(t, encode(datum.NewInt(-5)), encode(datum.NewFloat(2.5)))
+	require.Less(t, encode(datum.NewString("ab")), encode(datum.NewString("abc")))
+
+	big, _, err := apd.NewFromString("123456789012345678901234567890")
+	require.NoError(t, err)
+	numbers := []datum.Datum{
+		datum.NewFloat(math.NaN()),
+		datum.NewFloat(math.Inf(-1)),
+		datum.NewInt(math.MinInt64),
+		datum.NewInt(-1<<53 - 1),
+		datum.NewInt(-1 << 53),
+		datum.NewFloat(-100),
+		datum.NewDecimal(apd.New(-125, -1)),
+		datum.NewInt(-12),
+		datum.NewFloat(-0.5),
+		datum.NewDecimal(apd.New(-1, -30)),
+		datum.NewInt(0),
+		datum.NewDecimal(apd.New(1, -30)),
+		datum.NewFloat(0.1),
+		datum.NewDecimal(apd.New(12, -2)),
+		datum.NewInt(1),
+		datum.NewDecimal(apd.New(101, -2)),
+		datum.NewInt(12),
+		datum.NewFloat(12.5),
+		datum.NewInt(1 << 53),
+		datum.NewInt(1<<53 + 1),
+		datum.NewInt(math.MaxInt64),
+		datum.NewDecimal(big),
+		datum.NewFloat(1e300),
+		datum.NewDecimal(&apd.Decimal{Form: apd.Infinite}),
+	}
+	for i := 1; i < len(numbers); i++ {
+		require.Less(t, encode(numbers[i-1]), encode(numbers[i]), "%s < %s", numbers[i-1], numbers[i])
+	}
+}
+
+func TestVertexLabelKey(t *testing.T) {
+	key := VertexLabelKey(100, 200, math.MaxInt64)
+	graphID, labelID, vertexID, err := ParseVertexLabelKey(key)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), graphID)
+	require.Equal(t, int64(200), labelID)
+	require.Equal(t, int64(math.MaxInt64), vertexID)
+	require.Equal(t, VertexLabelPrefix(100, 200), key[:len(key)-8])
+	require.Equal(t, LabelPrefix(100, 200), key[:len(LabelPrefix(100, 200))])
+
+	// The label keys are out of the key range of vertices and edges.
+	require.Less(t, string(key), string(VertexKey(100, 0)))
+	require.Greater(t, string(key), string(VertexKey(99, math.MaxInt64)))
+
+	_, _, _, err = ParseVertexLabelKey(VertexKey(100, 200))
+	require.Error(t, err)
+}
@@ -3,6 +3,8 @@
 package codec
 
 import (
+	"encoding/binary"
+	"math"
 	"reflect"
 	"sort"
 	"unsafe"
@@ -22,27 +24,40 @@ type rowFlag byte
 // |+--------+--------- Reserved flag bits
 
 const (
-	version             = 0
+	// versionV0 stores the label IDs and property offsets as 2-byte integers,
+	// which is only decoded for the rows written by the old versions.
+	versionV0 = 0
+	// versionV1 stores the label IDs as 8-byte integers and the property offsets
+	// as 4-byte integers.
+	versionV1 = 1
+
+	version             = versionV1
 	versionMask rowFlag = 0x07
 )
 
+// maxRowDataLen is the max length of the property data of a row.
+const maxRowDataLen = math.MaxUint32
+
 func (f rowFlag) version() byte {
 	return byte(f & versionMask)
 }
 
-// rowBytes is used to encode/decode the value bytes.
-// Value Encode:
-// Flag[1byte]+LabelCount(varint)+LabelIDs+PropertyCount(varint)+PropertyIDs+PropertyOffsets+Data
+// rowBytes is used to encode/decode the value bytes. The rows are always encoded
+// in the latest version, so the old rows are upgraded when they are rewritten.
+// Value Encode (v1):
+// Flag[1byte]+LabelCount(varint)+LabelIDs[8bytes]+PropertyCount(varint)+PropertyIDs[2bytes]+PropertyOffsets[4bytes]+Data
+// Value Encode (v0):
+// Flag[1byte]+LabelCount(varint)+LabelIDs[2bytes]+PropertyCount(varint)+PropertyIDs[2bytes]+PropertyOffsets[2bytes]+Data
 type rowBytes struct {
-	labelIDs    []uint16
+	labelIDs    []int64
 	propertyIDs []uint16
-	offsets     []uint16
+	offsets     []uint32
 	data        []byte
 }
 
 // getData gets the row data at index `i`.
 func (r *rowBytes) getData(i int) []byte {
-	var start, end uint16
+	var start, end uint32
 	if i > 0 {
 		start = r.offsets[i-1]
 	}
@@ -50,7 +65,7 @@ func (r *rowBytes) getData(i int) []byte {
 	return r.data[start:end]
 }
 
-func (r *rowBytes) hasLabel(labelID uint16) bool {
+func (r *rowBytes) hasLabel(labelID int64) bool {
 	i := sort.Search(len(r.labelIDs), func(i int) bool {
 		return r.labelIDs[i] >= labelID
 	})
@@ -72,25 +87,37 @@ func (r *rowBytes) findProperty(propertyID uint16) int {
 func (r *rowBytes) toBytes(buf []byte) []byte {
 	buf = append(buf, version)
 	buf = EncodeUvarint(buf, uint64(len(r.labelIDs)))
-	buf = append(buf, u16SliceToBytes(r.labelIDs)...)
+	for _, id := range r.labelIDs {
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(id))
+	}
 	buf = EncodeUvarint(buf, uint64(len(r.propertyIDs)))
 	buf = append(buf, u16SliceToBytes(r.propertyIDs)...)
-	buf = append(buf, u16SliceToBytes(r.offsets)...)
+	for _, offset := range r.offsets {
+		buf = binary.LittleEndian.AppendUint32(buf, offset)
+	}
 	buf = append(buf, r.data...)
 	return buf
 }
 
 func (r *rowBytes) fromBytes(rowData []byte) error {
-	if rowFlag(rowData[0]).version() != version {
+	ver := rowFlag(rowData[0]).version()
+	if ver != versionV0 && ver != versionV1 {
 		return errInvalidCodecVer
 	}
+	labelIDLen, offsetLen := 8, 4
+	if ver == versionV0 {
+		labelIDLen, offsetLen = 2, 2
+	}
 
 	rowData, labelCount, err := DecodeUvarint(rowData[1:])
 	if err != nil {
 		return err
 	}
-	r.labelIDs = bytes2U16Slice(rowData[:labelCount*2])
-	rowData = rowData[labelCount*2:]
+	r.labelIDs = make([]int64, labelCount)
+	for i := range r.labelIDs {
+		r.labelIDs[i] = int64(decodeUint(rowData[i*labelIDLen:], labelIDLen))
+	}
+	rowData = rowData[int(labelCount)*labelIDLen:]
 
 	rowData, propertyCount, err := DecodeUvarint(rowData)
 	if err != nil {
@@ -99,11 +126,26 @@ func (r *rowBytes) fromBytes(rowData []byte) error {
 	r.propertyIDs = bytes2U16Slice(rowData[:propertyCount*2])
 	rowData = rowData[propertyCount*2:]
 
-	r.offsets = bytes2U16Slice(rowData[:propertyCount*2])
-	r.data = rowData[propertyCount*2:]
+	r.offsets = make([]uint32, propertyCount)
+	for i := range r.offsets {
+		r.offsets[i] = uint32(decodeUint(rowData[i*offsetLen:], offsetLen))
+	}
+	r.data = rowData[int(propertyCount)*offsetLen:]
 	return nil
 }
 
+// decodeUint decodes the little-endian unsigned integer of n bytes.
+func decodeUint(b []byte, n int) uint64 {
+	switch n {
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(b))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(b))
+	default:
+		return binary.LittleEndian.Uint64(b)
+	}
+}
+
 func bytes2U16Slice(b []byte) []uint16 {
 	if len(b) == 0 {
 		return nil
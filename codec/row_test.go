@@ -10,9 +10,9 @@ import (
 
 func TestRowBytes(t *testing.T) {
 	rb := &rowBytes{
-		labelIDs:    []uint16{1, 2, 3, 4},
+		labelIDs:    []int64{1, 2, 3, 1 << 40},
 		propertyIDs: []uint16{1, 2, 3, 4},
-		offsets:     []uint16{1, 2, 3, 4},
+		offsets:     []uint32{1, 2, 3, 4},
 		data:        []byte("abcd"),
 	}
 	bytes := rb.toBytes(nil)
@@ -29,3 +29,29 @@ func TestRowBytes(t *testing.T) {
 	idx = rb2.findProperty(5)
 	assert.Equal(t, -1, idx)
 }
+
+func TestRowBytes_V0(t *testing.T) {
+	// Flag+LabelCount+LabelIDs+PropertyCount+PropertyIDs+PropertyOffsets+Data
+	bytes := []byte{versionV0, 2, 1, 0, 2, 1, 2, 3, 0, 4, 0, 1, 0, 3, 0, 'a', 'b', 'c'}
+	rb := &rowBytes{}
+	err := rb.fromBytes(bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, &rowBytes{
+		labelIDs:    []int64{1, 258},
+		propertyIDs: []uint16{3, 4},
+		offsets:     []uint32{1, 3},
+		data:        []byte("abc"),
+	}, rb)
+	assert.True(t, rb.hasLabel(258))
+	assert.Equal(t, "bc", string(rb.getData(1)))
+
+	// The row is upgraded to the latest version when it is encoded again.
+	rb2 := &rowBytes{}
+	err = rb2.fromBytes(rb.toBytes(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, rb, rb2)
+	assert.Equal(t, byte(version), rb.toBytes(nil)[0])
+
+	err = rb2.fromBytes([]byte{versionV1 + 1})
+	assert.ErrorIs(t, err, errInvalidCodecVer)
+}
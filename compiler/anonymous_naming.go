@@ -0,0 +1,56 @@
+// ---
+
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
+
+// AnonymousNaming is used to assign a unique name to all anonymous variables
+// in the MATCH clauses. The planner identifies the vertices and connections of
+// a subgraph by variable name, so multiple anonymous variables must not share
+// the same (empty) name.
+//
+//	SELECT x.name FROM MATCH (x) -> () <-[:knows]- ()
+//
+// The AnonymousNaming will name the two anonymous vertices and edges above.
+type AnonymousNaming struct {
+	vertices int
+	edges    int
+	paths    int
+}
+
+func NewAnonymousNaming() *AnonymousNaming {
+	return &AnonymousNaming{}
+}
+
+func (a *AnonymousNaming) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	switch x := n.(type) {
+	case *ast.VertexPattern:
+		if x.Variable.Anonymous && x.Variable.Name.L == "" {
+			x.Variable.Name = model.NewCIStr(fmt.Sprintf("__anonymous_vertex_%d", a.vertices))
+			a.vertices++
+		}
+	case *ast.EdgePattern:
+		if x.Variable == nil {
+			x.Variable = &ast.VariableSpec{Anonymous: true}
+		}
+		if x.Variable.Anonymous && x.Variable.Name.L == "" {
+			x.Variable.Name = model.NewCIStr(fmt.Sprintf("__anonymous_edge_%d", a.edges))
+			a.edges++
+		}
+	case *ast.ReachabilityPathExpr:
+		if x.AnonymousName.L == "" {
+			x.AnonymousName = model.NewCIStr(fmt.Sprintf("__anonymous_path_%d", a.paths))
+			a.paths++
+		}
+	}
+	return n, false
+}
+
+func (a *AnonymousNaming) Leave(n ast.Node) (node ast.Node, ok bool) {
+	return n, true
+}
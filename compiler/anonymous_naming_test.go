@@ -0,0 +1,60 @@
+// ---
+
+package compiler
+
+import (
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/parser"
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymousNaming(t *testing.T) {
+	assert := assert.New(t)
+
+	cases := []struct {
+		query string
+		check func(node ast.Node)
+	}{
+		{
+			query: `SELECT x.name FROM MATCH (x) -> () <-[:knows]- ()`,
+			check: func(node ast.Node) {
+				path := node.(*ast.SelectStmt).From.Matches[0].Paths[0]
+				assert.Equal("x", path.Vertices[0].Variable.Name.L)
+				assert.False(path.Vertices[0].Variable.Anonymous)
+				assert.Equal("__anonymous_vertex_0", path.Vertices[1].Variable.Name.L)
+				assert.Equal("__anonymous_vertex_1", path.Vertices[2].Variable.Name.L)
+				e0 := path.Connections[0].(*ast.EdgePattern)
+				assert.Equal("__anonymous_edge_0", e0.Variable.Name.L)
+				assert.True(e0.Variable.Anonymous)
+				e1 := path.Connections[1].(*ast.EdgePattern)
+				assert.Equal("__anonymous_edge_1", e1.Variable.Name.L)
+				assert.Equal("knows", e1.Variable.Labels[0].L)
+			},
+		},
+		{
+			query: `DELETE x, e FROM MATCH (x) -[e]-> (), MATCH (x) -/:knows+/-> ()`,
+			check: func(node ast.Node) {
+				stmt := node.(*ast.DeleteStmt)
+				path := stmt.From.Matches[0].Paths[0]
+				assert.Equal("e", path.Connections[0].(*ast.EdgePattern).Variable.Name.L)
+				assert.Equal("__anonymous_vertex_0", path.Vertices[1].Variable.Name.L)
+				path = stmt.From.Matches[1].Paths[0]
+				assert.Equal("__anonymous_vertex_1", path.Vertices[1].Variable.Name.L)
+				expr := path.Connections[0].(*ast.ReachabilityPathExpr)
+				assert.Equal("__anonymous_path_0", expr.AnonymousName.L)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		parser := parser.New()
+		stmt, err := parser.ParseOneStmt(c.query)
+		assert.Nil(err)
+
+		n, ok := stmt.Accept(NewAnonymousNaming())
+		assert.True(ok)
+		c.check(n)
+	}
+}
@@ -0,0 +1,28 @@
+// ---
+
+package compiler
+
+import "github.com/simbiont-runtime/graphengine/parser/ast"
+
+// BindVariableCount returns the number of bind variables (?) in the statement,
+// whose values must be provided when the statement is executed.
+func BindVariableCount(node ast.Node) int {
+	counter := &bindVariableCounter{}
+	node.Accept(counter)
+	return counter.count
+}
+
+type bindVariableCounter struct {
+	count int
+}
+
+func (c *bindVariableCounter) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	if _, ok := n.(*ast.BindVariable); ok {
+		c.count++
+	}
+	return n, false
+}
+
+func (c *bindVariableCounter) Leave(n ast.Node) (node ast.Node, ok bool) {
+	return n, true
+}
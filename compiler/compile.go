@@ -13,10 +13,32 @@ import (
 // on the statement context to retrieve some environment information and set some intermediate
 // variables while compiling. The catalog is used to resolve names in the query.
 func Compile(sc *stmtctx.Context, node ast.StmtNode) (executor.Executor, error) {
+	plan, err := BuildPlan(sc, node)
+	if err != nil {
+		return nil, err
+	}
+
+	execBuilder := executor.NewBuilder(sc)
+	exec := execBuilder.Build(plan)
+	err = execBuilder.Error()
+	if err != nil {
+		return nil, err
+	}
+
+	return exec, nil
+}
+
+// BuildPlan builds the statement AST node into an optimized plan. The plan doesn't
+// depend on the values of bind variables, so it can be executed repeatedly as long
+// as the current graph and the catalog are not changed.
+func BuildPlan(sc *stmtctx.Context, node ast.StmtNode) (planner.Plan, error) {
 	// Macro expansion
 	macroExp := NewMacroExpansion()
 	node.Accept(macroExp)
 
+	// Name anonymous variables
+	node.Accept(NewAnonymousNaming())
+
 	// Check the AST to ensure it is valid.
 	preprocess := NewPreprocess(sc)
 	node.Accept(preprocess)
@@ -45,12 +67,5 @@ func Compile(sc *stmtctx.Context, node ast.StmtNode) (executor.Executor, error)
 		plan = planner.Optimize(logicalPlan)
 	}
 
-	execBuilder := executor.NewBuilder(sc)
-	exec := execBuilder.Build(plan)
-	err = execBuilder.Error()
-	if err != nil {
-		return nil, err
-	}
-
-	return exec, nil
+	return plan, nil
 }
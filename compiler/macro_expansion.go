@@ -40,6 +40,8 @@ func (m *MacroExpansion) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
 		m.macros = stmt.PathPatternMacros
 	case *ast.SelectStmt:
 		m.macros = stmt.PathPatternMacros
+	case *ast.ExplainStmt:
+		return n, false
 	case *ast.MatchClauseList:
 		return m.macroExpansion(n.(*ast.MatchClauseList))
 	default:
@@ -42,13 +42,16 @@ func (p *Preprocess) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
 		p.checkUseStmt(stmt)
 	case *ast.InsertStmt:
 		p.checkInsertStmt(stmt)
+	case *ast.UpdateStmt:
+		p.checkUpdateStmt(stmt)
+	case *ast.DeleteStmt:
+		p.checkDeleteStmt(stmt)
 	case *ast.SelectStmt:
 		p.checkSelectStmt(stmt)
 	case *ast.ShowStmt:
 		p.checkShowStmt(stmt)
-	case *ast.GroupByClause:
-		// FIXME: reject all unsupported clauses/expressions
-		p.err = errors.Errorf("unsupported clause/expression: %T", n)
+	case *ast.AnalyzeGraphStmt:
+		p.checkAnalyzeGraphStmt(stmt)
 	}
 	return n, p.err != nil
 }
@@ -257,10 +260,46 @@ func (p *Preprocess) checkInsertStmt(stmt *ast.InsertStmt) {
 	}
 }
 
+func (p *Preprocess) checkUpdateStmt(stmt *ast.UpdateStmt) {
+	graph := p.sc.CurrentGraph()
+	if graph == nil {
+		p.err = meta.ErrNoGraphSelected
+		return
+	}
+
+	// The assignments of an element update can only reference the updated element.
+	for _, update := range stmt.Updates {
+		for _, a := range update.Assignments {
+			if a.PropertyAccess.VariableName.L != update.VariableName.L {
+				p.err = errors.Annotatef(ErrVariableReferenceNotExits, "variable: %s", a.PropertyAccess.VariableName.L)
+				return
+			}
+		}
+	}
+}
+
+func (p *Preprocess) checkDeleteStmt(stmt *ast.DeleteStmt) {
+	graph := p.sc.CurrentGraph()
+	if graph == nil {
+		p.err = meta.ErrNoGraphSelected
+		return
+	}
+
+	// FIXME: support the solution modifiers of DELETE statement.
+	switch {
+	case stmt.Having != nil:
+		p.err = errors.Errorf("unsupported clause/expression: %T", stmt.Having)
+	case stmt.OrderBy != nil:
+		p.err = errors.Errorf("unsupported clause/expression: %T", stmt.OrderBy)
+	case stmt.Limit != nil:
+		p.err = errors.Errorf("unsupported clause/expression: %T", stmt.Limit)
+	}
+}
+
 func (p *Preprocess) checkSelectStmt(_ *ast.SelectStmt) {}
 
 func (p *Preprocess) checkShowStmt(stmt *ast.ShowStmt) {
-	if stmt.Tp == ast.ShowTargetLabels && stmt.GraphName.IsEmpty() {
+	if (stmt.Tp == ast.ShowTargetLabels || stmt.Tp == ast.ShowTargetStats) && stmt.GraphName.IsEmpty() {
 		graph := p.sc.CurrentGraph()
 		if graph == nil {
 			p.err = meta.ErrNoGraphSelected
@@ -268,3 +307,15 @@ func (p *Preprocess) checkShowStmt(stmt *ast.ShowStmt) {
 		}
 	}
 }
+
+func (p *Preprocess) checkAnalyzeGraphStmt(stmt *ast.AnalyzeGraphStmt) {
+	if stmt.Graph.IsEmpty() {
+		if p.sc.CurrentGraph() == nil {
+			p.err = meta.ErrNoGraphSelected
+		}
+		return
+	}
+	if p.sc.Catalog().Graph(stmt.Graph.L) == nil {
+		p.err = meta.ErrGraphNotExists
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/simbiont-runtime/graphengine/parser/model"
 	"github.com/simbiont-runtime/graphengine/stmtctx"
 	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"golang.org/x/exp/slices"
 )
 
 //	PropertyPreparation is used to create property lazily. In  GraphEngine: only Graph/Label/Index
@@ -22,6 +23,7 @@ type PropertyPreparation struct {
 	// Missing properties (lower case)
 	missing []string
 	graph   *catalog.Graph
+	err     error
 }
 
 func NewPropertyPreparation(sc *stmtctx.Context) *PropertyPreparation {
@@ -38,25 +40,47 @@ func (p *PropertyPreparation) Enter(n ast.Node) (node ast.Node, skipChildren boo
 			p.graph = p.sc.Catalog().Graph(node.IntoGraphName.L)
 		}
 	case *ast.PropertyAccess:
+		p.checkDeclared(node.PropertyName)
 		p.checkExistence(node.PropertyName)
-
+	case *ast.LabelPropertyDef:
+		// The properties in the label schema are created with the label.
+		if p.graph != nil {
+			p.checkExistence(node.Property)
+		}
 	}
 	return n, false
 }
 
 func (p *PropertyPreparation) checkExistence(propName model.CIStr) {
 	prop := p.graph.Property(propName.L)
-	if prop == nil {
+	if prop == nil && !slices.Contains(p.missing, propName.L) {
 		p.missing = append(p.missing, propName.L)
 	}
 }
 
+// checkDeclared checks the property is declared by a label schema if the graph
+// is strict.
+func (p *PropertyPreparation) checkDeclared(propName model.CIStr) {
+	if p.err != nil || !p.graph.Meta().Strict {
+		return
+	}
+	for _, label := range p.graph.Labels() {
+		if label.Meta().Property(propName.L) != nil {
+			return
+		}
+	}
+	p.err = errors.Errorf("property %s is not declared by any label in strict graph %s", propName, p.graph.Meta().Name)
+}
+
 func (p *PropertyPreparation) Leave(n ast.Node) (node ast.Node, ok bool) {
 	return n, true
 }
 
 // CreateMissing creates the missing properties.
 func (p *PropertyPreparation) CreateMissing() error {
+	if p.err != nil {
+		return p.err
+	}
 	if len(p.missing) == 0 {
 		return nil
 	}
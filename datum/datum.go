@@ -18,7 +18,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/apd/v3"
@@ -48,9 +50,18 @@ func (*TimestampTZ) isDatum() {}
 func (*Interval) isDatum()    {}
 func (*Vertex) isDatum()      {}
 func (*Edge) isDatum()        {}
+func (dList) isDatum()        {}
+func (dMap) isDatum()         {}
 
 type Row []Datum
 
+// HashKey returns a string which identifies the value of the datum. Two datums
+// of the same type have the same key if they are equal, so that the key can be
+// used to group rows and remove duplicated values.
+func HashKey(d Datum) string {
+	return string(rune(d.Type())) + d.String()
+}
+
 type dNull int
 
 func (dNull) Type() types.T  { return types.Unknown }
@@ -277,7 +288,7 @@ func (*Date) Type() types.T {
 }
 
 func (d *Date) String() string {
-	return time.Unix(int64(d.days)*secondsPerDay, 0).Format(dateLayout)
+	return time.Unix(int64(d.days)*secondsPerDay, 0).UTC().Format(dateLayout)
 }
 
 func (d *Date) UnixEpochDays() int32 {
@@ -398,13 +409,22 @@ func (t *TimeTZ) Type() types.T {
 }
 
 func (t *TimeTZ) String() string {
-	offsetHour := t.offsetMinutes / minutesPerHour
-	offsetMinute := t.offsetMinutes % minutesPerHour
-	if t.offsetMinutes >= 0 {
-		return fmt.Sprintf("%02d:%02d:%02d+%02d:%02d", t.Hour(), t.Minute(), t.Second(), offsetHour, offsetMinute)
-	} else {
-		return fmt.Sprintf("%02d:%02d:%02d%03d:%02d", t.Hour(), t.Minute(), t.Second(), offsetHour, offsetMinute)
+	sign, offset := '+', t.offsetMinutes
+	if offset < 0 {
+		sign, offset = '-', -offset
 	}
+	offsetHour := offset / minutesPerHour
+	offsetMinute := offset % minutesPerHour
+	return fmt.Sprintf("%02d:%02d:%02d%c%02d:%02d", t.Hour(), t.Minute(), t.Second(), sign, offsetHour, offsetMinute)
+}
+
+func NewTimeTZ(t TimeOfDay, offsetMinutes int32) *TimeTZ {
+	return &TimeTZ{TimeOfDay: t, offsetMinutes: offsetMinutes}
+}
+
+// OffsetMinutes returns the offset of time zone in minutes.
+func (t *TimeTZ) OffsetMinutes() int32 {
+	return t.offsetMinutes
 }
 
 var timeTZFormatRegex = regexp.MustCompile(`^(\d{2}):(\d{2}):(\d{2})([+-]\d{2}):(\d{2})$`)
@@ -428,15 +448,19 @@ func ParseTimeTZ(s string) (*TimeTZ, error) {
 	}
 	offsetHour, _ := strconv.Atoi(m[4])
 	offsetMinute, _ := strconv.Atoi(m[5])
-	if offsetHour > 12 {
+	if offsetHour > 12 || offsetHour < -12 {
 		return nil, errors.New("time zone offset hour out of range")
 	}
 	if offsetMinute > 59 {
 		return nil, errors.New("time zone offset minute out of range")
 	}
+	offsetMinutes := offsetHour*minutesPerHour + offsetMinute
+	if m[4][0] == '-' {
+		offsetMinutes = offsetHour*minutesPerHour - offsetMinute
+	}
 	return &TimeTZ{
 		TimeOfDay:     TimeOfDay(hour*secondsPerHour + minute*secondsPerMinute + second),
-		offsetMinutes: int32(offsetHour*minutesPerHour + offsetMinute),
+		offsetMinutes: int32(offsetMinutes),
 	}, nil
 }
 
@@ -619,6 +643,47 @@ func NewInterval(dur int64, unit IntervalUnit) *Interval {
 	}
 }
 
+// NewIntervalFromParts returns the interval of the months, days and seconds.
+func NewIntervalFromParts(months, days, seconds int64) *Interval {
+	return &Interval{months: months, days: days, seconds: seconds}
+}
+
+// Parts returns the months, days and seconds of the interval, which are kept
+// apart since the numbers of days of months and seconds of days vary.
+func (i *Interval) Parts() (months, days, seconds int64) {
+	return i.months, i.days, i.seconds
+}
+
+var intervalFormatRegex = regexp.MustCompile(`^(-?\d+)\s+(YEAR|MONTH|DAY|HOUR|MINUTE|SECOND)S?$`)
+
+// ParseInterval parses the interval in the format of Interval.String, e.g: '3 DAY'.
+func ParseInterval(s string) (*Interval, error) {
+	m := intervalFormatRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if len(m) != 3 {
+		return nil, fmt.Errorf("could not parse %q as Interval", s)
+	}
+	dur, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	var unit IntervalUnit
+	switch m[2] {
+	case "YEAR":
+		unit = IntervalUnitYear
+	case "MONTH":
+		unit = IntervalUnitMonth
+	case "DAY":
+		unit = IntervalUnitDay
+	case "HOUR":
+		unit = IntervalUnitHour
+	case "MINUTE":
+		unit = IntervalUnitMinute
+	case "SECOND":
+		unit = IntervalUnitSecond
+	}
+	return NewInterval(dur, unit), nil
+}
+
 func AsInterval(d Datum) *Interval {
 	v, err := TryAsInterval(d)
 	if err != nil {
@@ -668,6 +733,7 @@ func TryAsVertex(d Datum) (*Vertex, error) {
 }
 
 type Edge struct {
+	ID     int64
 	SrcID  int64
 	DstID  int64
 	Labels []string
@@ -679,7 +745,7 @@ func (e *Edge) Type() types.T {
 }
 
 func (e *Edge) String() string {
-	return fmt.Sprintf("EDGE(%d, %d)", e.SrcID, e.DstID)
+	return fmt.Sprintf("EDGE(%d, %d, %d)", e.ID, e.SrcID, e.DstID)
 }
 
 func AsEdge(d Datum) *Edge {
@@ -698,3 +764,83 @@ func TryAsEdge(d Datum) (*Edge, error) {
 		return nil, fmt.Errorf("cannot convert %T to edge", d)
 	}
 }
+
+// dList represents a list of datums, e.g: the edges bound to the group variable
+// of a variable-length path, or the values of a list property.
+type dList []Datum
+
+func (dList) Type() types.T {
+	return types.List
+}
+
+func (l dList) String() string {
+	elems := make([]string, 0, len(l))
+	for _, d := range l {
+		elems = append(elems, d.String())
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+func NewList(elems []Datum) Datum {
+	return dList(elems)
+}
+
+func AsList(d Datum) []Datum {
+	v, err := TryAsList(d)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TryAsList(d Datum) ([]Datum, error) {
+	switch v := d.(type) {
+	case dList:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to list", d)
+	}
+}
+
+// dMap represents a map from string keys to datums, e.g: the key/value attributes
+// stored in a map property.
+type dMap map[string]Datum
+
+func (dMap) Type() types.T {
+	return types.Map
+}
+
+// String returns the entries in the order of keys to make the result deterministic.
+func (m dMap) String() string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, key+": "+m[key].String())
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+func NewMap(entries map[string]Datum) Datum {
+	return dMap(entries)
+}
+
+func AsMap(d Datum) map[string]Datum {
+	v, err := TryAsMap(d)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func TryAsMap(d Datum) (map[string]Datum, error) {
+	switch v := d.(type) {
+	case dMap:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to map", d)
+	}
+}
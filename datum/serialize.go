@@ -0,0 +1,340 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datum
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var errTruncated = errors.New("truncated datum data")
+
+// AppendRow appends the serialized row to the buffer. The serialized format is
+// only used to exchange intermediate results inside a process (e.g: spilling
+// rows to disk), and the format is not guaranteed to be stable across versions.
+func AppendRow(b []byte, row Row) []byte {
+	b = binary.AppendUvarint(b, uint64(len(row)))
+	for _, d := range row {
+		b = AppendDatum(b, d)
+	}
+	return b
+}
+
+// DecodeRow decodes a row serialized by AppendRow and returns the remaining bytes.
+func DecodeRow(b []byte) (Row, []byte, error) {
+	n, b, err := decodeUvarint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	row := make(Row, 0, n)
+	for i := uint64(0); i < n; i++ {
+		var d Datum
+		d, b, err = DecodeDatum(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		row = append(row, d)
+	}
+	return row, b, nil
+}
+
+// AppendDatum appends the serialized datum to the buffer.
+func AppendDatum(b []byte, d Datum) []byte {
+	b = append(b, byte(d.Type()))
+	switch v := d.(type) {
+	case dNull:
+	case dBool:
+		if v {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	case dInt:
+		b = binary.AppendVarint(b, int64(v))
+	case dFloat:
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(float64(v)))
+	case dString:
+		b = appendBytes(b, []byte(v))
+	case dBytes:
+		b = appendBytes(b, v)
+	case dDecimal:
+		b = appendBytes(b, []byte(v.Decimal.String()))
+	case *Date:
+		b = binary.AppendVarint(b, int64(v.days))
+	case *Time:
+		b = binary.AppendVarint(b, int64(v.TimeOfDay))
+	case *TimeTZ:
+		b = binary.AppendVarint(b, int64(v.TimeOfDay))
+		b = binary.AppendVarint(b, int64(v.offsetMinutes))
+	case *Timestamp:
+		data, _ := v.Time.MarshalBinary()
+		b = appendBytes(b, data)
+	case *TimestampTZ:
+		data, _ := v.Time.MarshalBinary()
+		b = appendBytes(b, data)
+	case *Interval:
+		b = binary.AppendVarint(b, v.months)
+		b = binary.AppendVarint(b, v.days)
+		b = binary.AppendVarint(b, v.seconds)
+	case *Vertex:
+		b = binary.AppendVarint(b, v.ID)
+		b = appendLabels(b, v.Labels)
+		b = appendProps(b, v.Props)
+	case *Edge:
+		b = binary.AppendVarint(b, v.ID)
+		b = binary.AppendVarint(b, v.SrcID)
+		b = binary.AppendVarint(b, v.DstID)
+		b = appendLabels(b, v.Labels)
+		b = appendProps(b, v.Props)
+	case dList:
+		b = AppendRow(b, Row(v))
+	case dMap:
+		b = appendProps(b, v)
+	default:
+		panic(fmt.Sprintf("unsupported datum type %T", d))
+	}
+	return b
+}
+
+// DecodeDatum decodes a datum serialized by AppendDatum and returns the remaining bytes.
+func DecodeDatum(b []byte) (Datum, []byte, error) {
+	if len(b) == 0 {
+		return nil, nil, errTruncated
+	}
+	tp := types.T(b[0])
+	b = b[1:]
+
+	var err error
+	switch tp {
+	case types.Unknown:
+		return Null, b, nil
+	case types.Bool:
+		if len(b) == 0 {
+			return nil, nil, errTruncated
+		}
+		return NewBool(b[0] == 1), b[1:], nil
+	case types.Int:
+		var v int64
+		v, b, err = decodeVarint(b)
+		return NewInt(v), b, err
+	case types.Float:
+		if len(b) < 8 {
+			return nil, nil, errTruncated
+		}
+		return NewFloat(math.Float64frombits(binary.BigEndian.Uint64(b))), b[8:], nil
+	case types.String:
+		var v []byte
+		v, b, err = decodeBytes(b)
+		return NewString(string(v)), b, err
+	case types.Bytes:
+		var v []byte
+		v, b, err = decodeBytes(b)
+		return NewBytes(append([]byte(nil), v...)), b, err
+	case types.Decimal:
+		var v []byte
+		v, b, err = decodeBytes(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		d, err := ParseDecimal(string(v))
+		return d, b, err
+	case types.Date:
+		var v int64
+		v, b, err = decodeVarint(b)
+		return NewDateFromUnixEpochDays(int32(v)), b, err
+	case types.Time:
+		var v int64
+		v, b, err = decodeVarint(b)
+		return NewTime(TimeOfDay(v)), b, err
+	case types.TimeTZ:
+		var v, offset int64
+		v, b, err = decodeVarint(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		offset, b, err = decodeVarint(b)
+		return &TimeTZ{TimeOfDay: TimeOfDay(v), offsetMinutes: int32(offset)}, b, err
+	case types.Timestamp:
+		t := &Timestamp{}
+		b, err = decodeTime(b, &t.Time)
+		return t, b, err
+	case types.TimestampTZ:
+		t := &TimestampTZ{}
+		b, err = decodeTime(b, &t.Time)
+		return t, b, err
+	case types.Interval:
+		i := &Interval{}
+		for _, field := range []*int64{&i.months, &i.days, &i.seconds} {
+			*field, b, err = decodeVarint(b)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return i, b, nil
+	case types.Vertex:
+		v := &Vertex{}
+		v.ID, b, err = decodeVarint(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		v.Labels, b, err = decodeLabels(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		v.Props, b, err = decodeProps(b)
+		return v, b, err
+	case types.Edge:
+		e := &Edge{}
+		e.ID, b, err = decodeVarint(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.SrcID, b, err = decodeVarint(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.DstID, b, err = decodeVarint(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.Labels, b, err = decodeLabels(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.Props, b, err = decodeProps(b)
+		return e, b, err
+	case types.List:
+		var elems Row
+		elems, b, err = DecodeRow(b)
+		return NewList(elems), b, err
+	case types.Map:
+		var entries map[string]Datum
+		entries, b, err = decodeProps(b)
+		return NewMap(entries), b, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported datum type %s", tp)
+	}
+}
+
+func appendBytes(b []byte, data []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(len(data)))
+	return append(b, data...)
+}
+
+func appendLabels(b []byte, labels []string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(labels)))
+	for _, label := range labels {
+		b = appendBytes(b, []byte(label))
+	}
+	return b
+}
+
+func appendProps(b []byte, props map[string]Datum) []byte {
+	// Sort the property names to make the serialized data deterministic.
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	b = binary.AppendUvarint(b, uint64(len(names)))
+	for _, name := range names {
+		b = appendBytes(b, []byte(name))
+		b = AppendDatum(b, props[name])
+	}
+	return b
+}
+
+func decodeUvarint(b []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, nil, errTruncated
+	}
+	return v, b[n:], nil
+}
+
+func decodeVarint(b []byte) (int64, []byte, error) {
+	v, n := binary.Varint(b)
+	if n <= 0 {
+		return 0, nil, errTruncated
+	}
+	return v, b[n:], nil
+}
+
+func decodeBytes(b []byte) ([]byte, []byte, error) {
+	n, b, err := decodeUvarint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(b)) < n {
+		return nil, nil, errTruncated
+	}
+	return b[:n], b[n:], nil
+}
+
+func decodeTime(b []byte, t interface{ UnmarshalBinary([]byte) error }) ([]byte, error) {
+	data, b, err := decodeBytes(b)
+	if err != nil {
+		return nil, err
+	}
+	return b, t.UnmarshalBinary(data)
+}
+
+func decodeLabels(b []byte) ([]string, []byte, error) {
+	n, b, err := decodeUvarint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n == 0 {
+		return nil, b, nil
+	}
+	labels := make([]string, 0, n)
+	for i := uint64(0); i < n; i++ {
+		var label []byte
+		label, b, err = decodeBytes(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		labels = append(labels, string(label))
+	}
+	return labels, b, nil
+}
+
+func decodeProps(b []byte) (map[string]Datum, []byte, error) {
+	n, b, err := decodeUvarint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	props := make(map[string]Datum, n)
+	for i := uint64(0); i < n; i++ {
+		var name []byte
+		name, b, err = decodeBytes(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		var d Datum
+		d, b, err = DecodeDatum(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		props[string(name)] = d
+	}
+	return props, b, nil
+}
@@ -0,0 +1,63 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datum
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowSerialization(t *testing.T) {
+	dec, err := ParseDecimal("3.1415926")
+	require.NoError(t, err)
+	ttz, err := ParseTimeTZ("12:34:56+08:00")
+	require.NoError(t, err)
+
+	row := Row{
+		Null,
+		NewBool(true),
+		NewInt(-42),
+		NewFloat(1.5),
+		NewString("hello"),
+		NewBytes([]byte{0, 1, 2}),
+		dec,
+		NewDateFromUnixEpochDays(19000),
+		NewTime(3661),
+		ttz,
+		&Timestamp{Time: time.Unix(1700000000, 0).UTC()},
+		&Interval{months: 1, days: 2, seconds: 3},
+		&Vertex{ID: 1, Labels: []string{"Person"}, Props: map[string]Datum{"name": NewString("Bob")}},
+		&Edge{ID: 3, SrcID: 1, DstID: 2, Labels: []string{"knows"}, Props: map[string]Datum{}},
+		NewList([]Datum{NewInt(1), NewString("a")}),
+		NewMap(map[string]Datum{"color": NewString("red"), "size": NewInt(3)}),
+	}
+	data := AppendRow(nil, row)
+	decoded, remain, err := DecodeRow(data)
+	require.NoError(t, err)
+	require.Empty(t, remain)
+	require.Equal(t, len(row), len(decoded))
+	for i := range row {
+		require.Equal(t, row[i].Type(), decoded[i].Type())
+		require.Equal(t, row[i].String(), decoded[i].String())
+	}
+	require.Equal(t, row[11], decoded[11])
+	require.Equal(t, row[12], decoded[12])
+	require.Equal(t, row[13], decoded[13])
+
+	_, _, err = DecodeRow(data[:len(data)-1])
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package graphengine
 
 import (
+	"context"
 	"sync"
 
 	"github.com/simbiont-runtime/graphengine/catalog"
@@ -33,6 +34,10 @@ func Open(dirname string, opt *Options) (*DB, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkStorageFormat(context.Background(), store); err != nil {
+		_ = store.Close()
+		return nil, err
+	}
 
 	// Load the catalog from storage.
 	snapshot, err := store.Snapshot(store.CurrentVersion())
@@ -71,6 +76,8 @@ func (db *DB) NewSession() *session.Session {
 	defer db.mu.Unlock()
 
 	s := session.New(db.store, db.catalog)
+	s.StmtContext().SetMemQuotaSort(db.options.MemQuotaSort)
+	s.SetPlanCacheSize(db.options.PlanCacheSize)
 	s.OnClosed(db.onSessionClosed)
 	db.mu.sessions[s.ID()] = s
 	return s
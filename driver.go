@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/session"
@@ -39,6 +40,8 @@ var (
 	_ driver.Connector        = &connector{}
 	_ io.Closer               = &connector{}
 	_ driver.Conn             = &conn{}
+	_ driver.ConnBeginTx      = &conn{}
+	_ driver.Tx               = &tx{}
 	_ driver.Stmt             = &stmt{}
 	_ driver.StmtExecContext  = &stmt{}
 	_ driver.StmtQueryContext = &stmt{}
@@ -87,8 +90,12 @@ func (c *conn) Prepare(query string) (driver.Stmt, error) {
 	return c.PrepareContext(context.Background(), query)
 }
 
-func (c *conn) PrepareContext(_ context.Context, query string) (driver.Stmt, error) {
-	return &stmt{session: c.session, query: query}, nil
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	prepared, err := c.session.Prepare(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &stmt{session: c.session, prepared: prepared}, nil
 }
 
 func (c *conn) Close() error {
@@ -100,13 +107,31 @@ func (c *conn) Begin() (driver.Tx, error) {
 	return c.BeginTx(context.Background(), driver.TxOptions{})
 }
 
-func (c *conn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
-	return nil, errors.New("transactions are not supported")
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if sql.IsolationLevel(opts.Isolation) != sql.LevelDefault {
+		return nil, fmt.Errorf("unsupported isolation level: %s", sql.IsolationLevel(opts.Isolation))
+	}
+	if err := c.session.BeginTxn(ctx, opts.ReadOnly); err != nil {
+		return nil, err
+	}
+	return &tx{session: c.session}, nil
 }
 
-type stmt struct {
+type tx struct {
 	session *session.Session
-	query   string
+}
+
+func (t *tx) Commit() error {
+	return t.session.CommitTxn(context.Background())
+}
+
+func (t *tx) Rollback() error {
+	return t.session.RollbackTxn()
+}
+
+type stmt struct {
+	session  *session.Session
+	prepared *session.PreparedStmt
 }
 
 func (s *stmt) Close() error {
@@ -114,48 +139,83 @@ func (s *stmt) Close() error {
 }
 
 func (s *stmt) NumInput() int {
-	return -1
+	return s.prepared.NumParams()
 }
 
 func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
-	if len(args) > 0 {
-		return nil, fmt.Errorf("placeholder arguments not supported")
-	}
-	return s.ExecContext(context.Background(), nil)
+	return s.ExecContext(context.Background(), namedValues(args))
 }
 
 func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
-	if len(args) > 0 {
-		return nil, fmt.Errorf("placeholder arguments not supported")
+	params, err := bindParams(args)
+	if err != nil {
+		return nil, err
 	}
-	rs, err := s.session.Execute(ctx, s.query)
+	rs, err := s.prepared.Execute(ctx, params...)
 	if err != nil {
 		return nil, err
 	}
+	defer rs.Close()
 	if err := rs.Next(ctx); err != nil {
 		return nil, err
 	}
-	return driver.ResultNoRows, nil
+	return driver.RowsAffected(s.session.StmtContext().AffectedRows()), nil
 }
 
 func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
-	if len(args) > 0 {
-		return nil, fmt.Errorf("placeholder arguments not supported")
-	}
-	return s.QueryContext(context.Background(), nil)
+	return s.QueryContext(context.Background(), namedValues(args))
 }
 
 func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
-	if len(args) > 0 {
-		return nil, fmt.Errorf("placeholder arguments not supported")
+	params, err := bindParams(args)
+	if err != nil {
+		return nil, err
 	}
-	rs, err := s.session.Execute(ctx, s.query)
+	rs, err := s.prepared.Execute(ctx, params...)
 	if err != nil {
 		return nil, err
 	}
 	return &rows{ctx: ctx, rs: rs}, nil
 }
 
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, 0, len(args))
+	for i, arg := range args {
+		named = append(named, driver.NamedValue{Ordinal: i + 1, Value: arg})
+	}
+	return named
+}
+
+// bindParams converts the placeholder arguments into the values of bind variables.
+// The arguments have been converted into driver.Value by database/sql.
+func bindParams(args []driver.NamedValue) ([]datum.Datum, error) {
+	params := make([]datum.Datum, 0, len(args))
+	for _, arg := range args {
+		if arg.Name != "" {
+			return nil, fmt.Errorf("named arguments are not supported: %s", arg.Name)
+		}
+		switch v := arg.Value.(type) {
+		case nil:
+			params = append(params, datum.Null)
+		case bool:
+			params = append(params, datum.NewBool(v))
+		case int64:
+			params = append(params, datum.NewInt(v))
+		case float64:
+			params = append(params, datum.NewFloat(v))
+		case string:
+			params = append(params, datum.NewString(v))
+		case []byte:
+			params = append(params, datum.NewBytes(append([]byte(nil), v...)))
+		case time.Time:
+			params = append(params, &datum.TimestampTZ{Time: v})
+		default:
+			return nil, fmt.Errorf("unsupported argument type %T", arg.Value)
+		}
+	}
+	return params, nil
+}
+
 type rows struct {
 	ctx context.Context
 	rs  session.ResultSet
@@ -177,20 +237,41 @@ func (r *rows) Next(dest []driver.Value) error {
 		return io.EOF
 	}
 	for i, d := range r.rs.Row() {
-		if d == datum.Null {
-			dest[i] = nil
-			continue
+		dest[i] = driverValue(d)
+	}
+	return nil
+}
+
+// driverValue converts the datum into the value returned to database/sql. The
+// lists and maps are converted into []any and map[string]any recursively.
+func driverValue(d datum.Datum) any {
+	if d == datum.Null {
+		return nil
+	}
+	switch d.Type() {
+	case types.Bool:
+		return datum.AsBool(d)
+	case types.Int:
+		return datum.AsInt(d)
+	case types.Float:
+		return datum.AsFloat(d)
+	case types.String:
+		return datum.AsString(d)
+	case types.List:
+		list := datum.AsList(d)
+		values := make([]any, 0, len(list))
+		for _, elem := range list {
+			values = append(values, driverValue(elem))
 		}
-		switch d.Type() {
-		case types.Bool:
-			dest[i] = datum.AsBool(d)
-		case types.Int:
-			dest[i] = datum.AsInt(d)
-		case types.Float:
-			dest[i] = datum.AsFloat(d)
-		default:
-			dest[i] = datum.AsString(d)
+		return values
+	case types.Map:
+		entries := datum.AsMap(d)
+		values := make(map[string]any, len(entries))
+		for key, value := range entries {
+			values[key] = driverValue(value)
 		}
+		return values
+	default:
+		return d.String()
 	}
-	return nil
 }
@@ -30,3 +30,132 @@ func TestDriver(t *testing.T) {
 	require.False(t, rows.Next())
 	require.NoError(t, rows.Err())
 }
+
+func TestDriverTx(t *testing.T) {
+	db, err := sql.Open("graphEngine", t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn := lo.Must1(db.Conn(ctx))
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE GRAPH g"))
+	_ = lo.Must1(conn.ExecContext(ctx, "USE g"))
+
+	count := func() (n int) {
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM MATCH (x)").Scan(&n))
+		return
+	}
+
+	tx := lo.Must1(conn.BeginTx(ctx, nil))
+	_ = lo.Must1(tx.ExecContext(ctx, "INSERT VERTEX x PROPERTIES (x.a = 1)"))
+	require.Equal(t, 1, count())
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 0, count())
+
+	tx = lo.Must1(conn.BeginTx(ctx, nil))
+	_ = lo.Must1(tx.ExecContext(ctx, "INSERT VERTEX x PROPERTIES (x.a = 1)"))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 1, count())
+
+	tx = lo.Must1(conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true}))
+	_, err = tx.ExecContext(ctx, "INSERT VERTEX x PROPERTIES (x.a = 2)")
+	require.ErrorContains(t, err, "read-only transaction")
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 1, count())
+
+	_, err = conn.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	require.ErrorContains(t, err, "unsupported isolation level")
+}
+
+func TestDriverBindVariables(t *testing.T) {
+	db, err := sql.Open("graphEngine", t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn := lo.Must1(db.Conn(ctx))
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE GRAPH g"))
+	_ = lo.Must1(conn.ExecContext(ctx, "USE g"))
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE LABEL Person"))
+	for i, name := range []string{"Kathrine", "Riya", "Lee"} {
+		_ = lo.Must1(conn.ExecContext(ctx, "INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = ?, x.age = ?)", name, 20+i*10))
+	}
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE INDEX idx_name (name)"))
+
+	// The bind variable is looked up by index and injection is impossible.
+	for _, name := range []string{"Riya", "Riya' OR x.name <> '"} {
+		rows := lo.Must1(conn.QueryContext(ctx, "SELECT x.age FROM MATCH (x:Person) WHERE x.name = ?", name))
+		var ages []int
+		for rows.Next() {
+			var age int
+			require.NoError(t, rows.Scan(&age))
+			ages = append(ages, age)
+		}
+		require.NoError(t, rows.Err())
+		if name == "Riya" {
+			require.Equal(t, []int{30}, ages)
+		} else {
+			require.Empty(t, ages)
+		}
+	}
+
+	var names []string
+	rows := lo.Must1(conn.QueryContext(ctx, "SELECT x.name FROM MATCH (x) WHERE x.age > ? ORDER BY x.age LIMIT ?", 20, 1))
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []string{"Riya"}, names)
+
+	var count int
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM MATCH (x) WHERE x.name = ?", nil).Scan(&count))
+	require.Equal(t, 0, count)
+
+	// The number of arguments is checked by database/sql with NumInput.
+	_, err = conn.QueryContext(ctx, "SELECT x.name FROM MATCH (x) WHERE x.name = ?")
+	require.ErrorContains(t, err, "expected 1 arguments, got 0")
+	_, err = conn.QueryContext(ctx, "SELECT x.name FROM MATCH (x) WHERE x.name = ?", sql.Named("name", "Riya"))
+	require.ErrorContains(t, err, "named arguments are not supported")
+
+	stmt := lo.Must1(conn.PrepareContext(ctx, "UPDATE x SET (x.age = ?) FROM MATCH (x) WHERE x.name = ?"))
+	defer stmt.Close()
+	res := lo.Must1(stmt.ExecContext(ctx, 31, "Riya"))
+	require.Equal(t, int64(1), lo.Must1(res.RowsAffected()))
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT x.age FROM MATCH (x) WHERE x.name = ?", "Riya").Scan(&count))
+	require.Equal(t, 31, count)
+
+	// The prepared statement is still valid after the catalog is changed.
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE LABEL Company"))
+	res = lo.Must1(stmt.ExecContext(ctx, 32, "Riya"))
+	require.Equal(t, int64(1), lo.Must1(res.RowsAffected()))
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT x.age FROM MATCH (x) WHERE x.name = ?", "Riya").Scan(&count))
+	require.Equal(t, 32, count)
+}
+
+func TestDriverListAndMap(t *testing.T) {
+	db, err := sql.Open("graphEngine", t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn := lo.Must1(db.Conn(ctx))
+	_ = lo.Must1(conn.ExecContext(ctx, "CREATE GRAPH g"))
+	_ = lo.Must1(conn.ExecContext(ctx, "USE g"))
+	_ = lo.Must1(conn.ExecContext(ctx, "INSERT VERTEX x PROPERTIES (x.tags = ['a', 'b'], x.attrs = {'size': 3, 'nums': [1, ?]})", nil))
+
+	var (
+		tags  []any
+		attrs any
+	)
+	require.NoError(t, conn.QueryRowContext(ctx, "SELECT x.tags, x.attrs FROM MATCH (x)").Scan(&tags, &attrs))
+	require.Equal(t, []any{"a", "b"}, tags)
+	require.Equal(t, map[string]any{"size": int64(3), "nums": []any{int64(1), nil}}, attrs)
+}
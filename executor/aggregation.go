@@ -0,0 +1,107 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/expression"
+)
+
+// HashAggExec represents a hash aggregation executor. It consumes all rows of
+// the child executor and outputs one row for each group.
+type HashAggExec struct {
+	baseExecutor
+
+	aggFuncs     []*expression.AggregateExpr
+	groupByItems []expression.Expression
+
+	prepared bool
+	groups   map[string]*aggGroup
+	// keys records the order of groups to output the results in a stable order.
+	keys []string
+	idx  int
+}
+
+type aggGroup struct {
+	values datum.Row
+	accs   []expression.Accumulator
+}
+
+func (e *HashAggExec) Next(ctx context.Context) (datum.Row, error) {
+	if !e.prepared {
+		e.prepared = true
+		if err := e.prepare(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if e.idx >= len(e.keys) {
+		return nil, nil
+	}
+	group := e.groups[e.keys[e.idx]]
+	e.idx++
+
+	row := make(datum.Row, 0, len(e.aggFuncs)+len(e.groupByItems))
+	for _, acc := range group.accs {
+		d, err := acc.Result()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, d)
+	}
+	return append(row, group.values...), nil
+}
+
+func (e *HashAggExec) prepare(ctx context.Context) error {
+	e.groups = make(map[string]*aggGroup)
+	var key strings.Builder
+	for {
+		row, err := e.children[0].Next(ctx)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+
+		key.Reset()
+		values := make(datum.Row, 0, len(e.groupByItems))
+		for _, item := range e.groupByItems {
+			d, err := item.Eval(e.sc, row)
+			if err != nil {
+				return err
+			}
+			values = append(values, d)
+			key.WriteString(datum.HashKey(d))
+			key.WriteByte(0)
+		}
+		group, ok := e.groups[key.String()]
+		if !ok {
+			group = e.newGroup(values)
+			e.groups[key.String()] = group
+			e.keys = append(e.keys, key.String())
+		}
+		for i, aggFunc := range e.aggFuncs {
+			if err := aggFunc.Update(e.sc, group.accs[i], row); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The aggregation without GROUP BY always outputs one row even if the input is empty.
+	if len(e.groupByItems) == 0 && len(e.keys) == 0 {
+		e.groups[""] = e.newGroup(nil)
+		e.keys = append(e.keys, "")
+	}
+	return nil
+}
+
+func (e *HashAggExec) newGroup(values datum.Row) *aggGroup {
+	group := &aggGroup{values: values}
+	for _, aggFunc := range e.aggFuncs {
+		group.accs = append(group.accs, aggFunc.NewAccumulator())
+	}
+	return group
+}
@@ -0,0 +1,294 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/simbiont-runtime/graphengine/types"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// statsHistogramBuckets is the max number of buckets of the histograms.
+	statsHistogramBuckets = 32
+	// statsSketchSize is the max number of hashes kept by the sketches which
+	// estimate the numbers of distinct values of properties.
+	statsSketchSize = 1000
+)
+
+// AnalyzeExec is used to execute the ANALYZE GRAPH statement.
+type AnalyzeExec struct {
+	baseExecutor
+
+	done      bool
+	statement *ast.AnalyzeGraphStmt
+}
+
+// Next implements the Executor interface.
+func (e *AnalyzeExec) Next(_ context.Context) (datum.Row, error) {
+	if e.done {
+		return nil, nil
+	}
+	e.done = true
+
+	// The statistics are committed in a separate transaction as DDL.
+	if e.sc.Txn().InTxn() {
+		return nil, errors.Errorf("cannot execute ANALYZE statement in a transaction")
+	}
+
+	graph := e.sc.CurrentGraph()
+	if !e.statement.Graph.IsEmpty() {
+		graph = e.sc.Catalog().Graph(e.statement.Graph.L)
+	}
+	if graph == nil {
+		return nil, meta.ErrGraphNotExists
+	}
+
+	// The statistics are collected from a snapshot without locking the catalog,
+	// so that the transactions are committed while scanning the graph.
+	store := e.sc.Store()
+	snapshot, err := store.Snapshot(store.CurrentVersion())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := collectStats(snapshot, graph)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prevent the graph from being changed by DDL while saving the statistics.
+	e.sc.Catalog().MDLock()
+	defer e.sc.Catalog().MDUnlock()
+
+	err = kv.Txn(store, func(txn kv.Transaction) error {
+		return meta.New(txn).UpdateGraphStats(graph.Meta().ID, stats)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e.sc.Catalog().Apply(&catalog.Patch{
+		Type: catalog.PatchTypeUpdateStats,
+		Data: &catalog.PatchStats{
+			GraphID: graph.Meta().ID,
+			Stats:   stats,
+		},
+	})
+	return nil, nil
+}
+
+// statsCollector collects the statistics of a graph. The edges are collected
+// with the labels of their end vertices after all vertices are scanned, since
+// the destination vertex may be scanned after the edge.
+type statsCollector struct {
+	graph        *catalog.Graph
+	vertexLabels map[int64][]int64
+	outDegrees   map[int64]int64
+	inDegrees    map[int64]int64
+	edges        [][2]int64
+	labels       map[int64]*model.LabelStats
+	vertexProps  map[uint16]*propertyCollector
+	edgeProps    map[uint16]*propertyCollector
+}
+
+// propertyCollector collects the values of a property.
+type propertyCollector struct {
+	count   int64
+	sketch  *model.FMSketch
+	numbers []float64
+}
+
+// collectStats scans all vertices and edges of the graph to collect statistics.
+func collectStats(snapshot kv.Snapshot, graph *catalog.Graph) (*model.GraphStats, error) {
+	c := &statsCollector{
+		graph:        graph,
+		vertexLabels: make(map[int64][]int64),
+		outDegrees:   make(map[int64]int64),
+		inDegrees:    make(map[int64]int64),
+		labels:       make(map[int64]*model.LabelStats),
+		vertexProps:  make(map[uint16]*propertyCollector),
+		edgeProps:    make(map[uint16]*propertyCollector),
+	}
+
+	// The edges are stored in the range of their source vertices, and the incoming
+	// edges are skipped since they are the same as the outgoing edges.
+	graphID := graph.Meta().ID
+	lower := codec.VertexKey(graphID, 0)
+	upper := codec.VertexKey(graphID, math.MaxInt64)
+	iter, err := snapshot.Iter(lower, upper)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		key := iter.Key()
+		switch {
+		case len(key) == codec.VertexKeyLen:
+			_, vertexID, err := codec.ParseVertexKey(key)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.collectVertex(vertexID, iter.Value()); err != nil {
+				return nil, err
+			}
+		case codec.IsOutgoingEdgeKey(key):
+			_, srcID, dstID, _, err := codec.ParseOutgoingEdgeKey(key)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.collectEdge(srcID, dstID, iter.Value()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.build(), nil
+}
+
+func (c *statsCollector) collectVertex(vertexID int64, val []byte) error {
+	labels, props, err := decodeLabelsAndProperties(c.graph, val)
+	if err != nil {
+		return err
+	}
+	labelIDs := make([]int64, 0, len(labels))
+	for _, name := range labels {
+		if label := c.graph.Label(name); label != nil {
+			labelIDs = append(labelIDs, label.Meta().ID)
+			c.label(label.Meta().ID).VertexCount++
+		}
+	}
+	c.vertexLabels[vertexID] = labelIDs
+	c.collectProperties(c.vertexProps, props)
+	return nil
+}
+
+func (c *statsCollector) collectEdge(srcID, dstID int64, val []byte) error {
+	labels, props, err := decodeLabelsAndProperties(c.graph, val)
+	if err != nil {
+		return err
+	}
+	for _, name := range labels {
+		if label := c.graph.Label(name); label != nil {
+			c.label(label.Meta().ID).EdgeCount++
+		}
+	}
+	c.outDegrees[srcID]++
+	c.inDegrees[dstID]++
+	c.edges = append(c.edges, [2]int64{srcID, dstID})
+	c.collectProperties(c.edgeProps, props)
+	return nil
+}
+
+func (c *statsCollector) label(id int64) *model.LabelStats {
+	label, ok := c.labels[id]
+	if !ok {
+		label = &model.LabelStats{ID: id}
+		c.labels[id] = label
+	}
+	return label
+}
+
+func (c *statsCollector) collectProperties(collectors map[uint16]*propertyCollector, props map[string]datum.Datum) {
+	for name, value := range props {
+		prop := c.graph.Property(name)
+		if prop == nil || value == datum.Null {
+			continue
+		}
+		pc, ok := collectors[prop.ID]
+		if !ok {
+			pc = &propertyCollector{sketch: model.NewFMSketch(statsSketchSize)}
+			collectors[prop.ID] = pc
+		}
+		pc.count++
+		pc.sketch.Insert([]byte(datum.HashKey(value)))
+		switch value.Type() {
+		case types.Int:
+			pc.numbers = append(pc.numbers, float64(datum.AsInt(value)))
+		case types.Float:
+			pc.numbers = append(pc.numbers, datum.AsFloat(value))
+		}
+	}
+}
+
+func (c *statsCollector) build() *model.GraphStats {
+	stats := &model.GraphStats{
+		VertexCount: int64(len(c.vertexLabels)),
+		EdgeCount:   int64(len(c.edges)),
+	}
+
+	labelIDs := maps.Keys(c.labels)
+	slices.Sort(labelIDs)
+	for _, id := range labelIDs {
+		stats.Labels = append(stats.Labels, c.labels[id])
+	}
+
+	// Each edge is counted by all pairs of the labels of its end vertices, and
+	// the label ID 0 stands for any vertex.
+	pairs := make(map[[2]int64]int64)
+	for _, edge := range c.edges {
+		srcLabels := append([]int64{0}, c.vertexLabels[edge[0]]...)
+		dstLabels := append([]int64{0}, c.vertexLabels[edge[1]]...)
+		for _, src := range srcLabels {
+			for _, dst := range dstLabels {
+				pairs[[2]int64{src, dst}]++
+			}
+		}
+	}
+	pairKeys := maps.Keys(pairs)
+	slices.SortFunc(pairKeys, func(a, b [2]int64) bool {
+		return a[0] < b[0] || (a[0] == b[0] && a[1] < b[1])
+	})
+	for _, key := range pairKeys {
+		stats.LabelPairs = append(stats.LabelPairs, &model.LabelPairStats{
+			SrcID: key[0],
+			DstID: key[1],
+			Count: pairs[key],
+		})
+	}
+
+	outDegrees := make([]float64, 0, len(c.vertexLabels))
+	inDegrees := make([]float64, 0, len(c.vertexLabels))
+	for vertexID := range c.vertexLabels {
+		outDegrees = append(outDegrees, float64(c.outDegrees[vertexID]))
+		inDegrees = append(inDegrees, float64(c.inDegrees[vertexID]))
+	}
+	stats.OutDegree = model.NewHistogram(outDegrees, statsHistogramBuckets)
+	stats.InDegree = model.NewHistogram(inDegrees, statsHistogramBuckets)
+
+	stats.VertexProperties = buildPropertyStats(c.vertexProps)
+	stats.EdgeProperties = buildPropertyStats(c.edgeProps)
+	return stats
+}
+
+func buildPropertyStats(collectors map[uint16]*propertyCollector) []*model.PropertyStats {
+	ids := maps.Keys(collectors)
+	slices.Sort(ids)
+	props := make([]*model.PropertyStats, 0, len(ids))
+	for _, id := range ids {
+		pc := collectors[id]
+		prop := &model.PropertyStats{
+			ID:    id,
+			Count: pc.count,
+			NDV:   pc.sketch.NDV(),
+		}
+		if len(pc.numbers) > 0 {
+			prop.Histogram = model.NewHistogram(pc.numbers, statsHistogramBuckets)
+		}
+		props = append(props, prop)
+	}
+	return props
+}
@@ -0,0 +1,36 @@
+// ---
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeExec_NDV(t *testing.T) {
+	db := newTestDB(t, nil)
+	sess := newTestSession(t, db)
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Digit")
+	for i := 0; i < 40; i++ {
+		sess.mustExec(fmt.Sprintf("INSERT VERTEX x LABELS (Digit) PROPERTIES (x.digit = %d)", i))
+	}
+	sess.mustExec("INSERT VERTEX v PROPERTIES (v.num = a.digit * 40 + b.digit) FROM MATCH (a:Digit), MATCH (b:Digit)")
+	sess.mustExec("ANALYZE GRAPH")
+
+	graph := db.Catalog().Graph("g")
+	stats := graph.Stats()
+	require.Equal(t, int64(1640), stats.VertexCount)
+
+	// The number of distinct values is exact for the small number of values,
+	// and estimated for the large number of values.
+	digit := stats.VertexProperty(graph.Property("digit").ID)
+	require.Equal(t, int64(40), digit.NDV)
+	num := stats.VertexProperty(graph.Property("num").ID)
+	require.Equal(t, int64(1600), num.Count)
+	require.NotEqual(t, int64(1600), num.NDV)
+	require.InEpsilon(t, 1600, num.NDV, 0.3)
+}
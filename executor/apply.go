@@ -0,0 +1,140 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/planner"
+)
+
+// ApplyExec executes the subquery for each row of the outer executor (the only
+// child), and appends the subquery result to the row.
+type ApplyExec struct {
+	baseExecutor
+
+	innerBuilder *Builder
+	innerPlan    planner.PhysicalPlan
+	tp           planner.ApplyType
+	not          bool
+}
+
+func (e *ApplyExec) Next(ctx context.Context) (datum.Row, error) {
+	row, err := e.children[0].Next(ctx)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	result, err := e.evalSubquery(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	return append(row[:len(row):len(row)], result), nil
+}
+
+// evalSubquery executes the subquery for the outer row, which is pushed to the
+// statement context while the subquery is executed so that the correlated columns
+// and the bound vertices can be evaluated.
+func (e *ApplyExec) evalSubquery(ctx context.Context, outerRow datum.Row) (_ datum.Datum, err error) {
+	e.sc.PushOuterRow(outerRow)
+	defer e.sc.PopOuterRow()
+
+	exec := e.innerBuilder.Build(e.innerPlan)
+	if err := e.innerBuilder.Error(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := exec.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	if err := exec.Open(ctx); err != nil {
+		return nil, err
+	}
+
+	row, err := exec.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if e.tp == planner.ApplyTypeExists {
+		return datum.NewBool((row != nil) != e.not), nil
+	}
+	if row == nil {
+		return datum.Null, nil
+	}
+	next, err := exec.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if next != nil {
+		return nil, errors.New("more than one row returned by a subquery used as an expression")
+	}
+	return row[0], nil
+}
+
+// HashSemiJoinExec returns the rows of the outer executor (the first child) whose
+// keys are (or are not, if anti is true) found in the results of the inner
+// executor (the second child). The inner results are hashed by their keys before
+// returning the first row.
+type HashSemiJoinExec struct {
+	baseExecutor
+
+	anti      bool
+	outerKeys []int
+	innerKeys []int
+
+	prepared bool
+	keys     map[string]struct{}
+}
+
+func (e *HashSemiJoinExec) Next(ctx context.Context) (datum.Row, error) {
+	if !e.prepared {
+		if err := e.buildKeys(ctx); err != nil {
+			return nil, err
+		}
+		e.prepared = true
+	}
+	for {
+		row, err := e.children[0].Next(ctx)
+		if err != nil || row == nil {
+			return nil, err
+		}
+		var found bool
+		if key, ok := semiJoinKey(row, e.outerKeys); ok {
+			_, found = e.keys[key]
+		}
+		if found != e.anti {
+			// The result column of the EXISTS subquery, which is always true.
+			return append(row[:len(row):len(row)], datum.NewBool(true)), nil
+		}
+	}
+}
+
+func (e *HashSemiJoinExec) buildKeys(ctx context.Context) error {
+	e.keys = make(map[string]struct{})
+	for {
+		row, err := e.children[1].Next(ctx)
+		if err != nil || row == nil {
+			return err
+		}
+		if key, ok := semiJoinKey(row, e.innerKeys); ok {
+			e.keys[key] = struct{}{}
+		}
+	}
+}
+
+// semiJoinKey encodes the IDs of the vertices at the indexes of the row, and
+// returns false if any of them is not a vertex.
+func semiJoinKey(row datum.Row, indexes []int) (string, bool) {
+	key := make([]byte, 0, len(indexes)*8)
+	for _, idx := range indexes {
+		vertex, ok := row[idx].(*datum.Vertex)
+		if !ok {
+			return "", false
+		}
+		key = binary.BigEndian.AppendUint64(key, uint64(vertex.ID))
+	}
+	return string(key), true
+}
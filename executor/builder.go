@@ -14,6 +14,9 @@ import (
 type Builder struct {
 	sc  *stmtctx.Context
 	err error
+	// stats collects the runtime statistics of the physical plans by plan ID if
+	// it is not nil, which is used by EXPLAIN ANALYZE.
+	stats map[int]*runtimeStats
 }
 
 // NewBuilder returns a build instance.
@@ -25,6 +28,14 @@ func NewBuilder(sc *stmtctx.Context) *Builder {
 
 // Build builds an executor from a plan.
 func (b *Builder) Build(plan planner.Plan) Executor {
+	exec := b.build(plan)
+	if p, ok := plan.(planner.PhysicalPlan); ok && b.stats != nil && exec != nil {
+		return b.wrapStats(p, exec)
+	}
+	return exec
+}
+
+func (b *Builder) build(plan planner.Plan) Executor {
 	switch p := plan.(type) {
 	case *planner.DDL:
 		return b.buildDDL(p)
@@ -32,12 +43,30 @@ func (b *Builder) Build(plan planner.Plan) Executor {
 		return b.buildSimple(p)
 	case *planner.Insert:
 		return b.buildInsert(p)
+	case *planner.Update:
+		return b.buildUpdate(p)
+	case *planner.Delete:
+		return b.buildDelete(p)
 	case *planner.PhysicalMatch:
 		return b.buildMatch(p)
 	case *planner.PhysicalProjection:
 		return b.buildProjection(p)
 	case *planner.PhysicalSelection:
 		return b.buildSelection(p)
+	case *planner.PhysicalHashAgg:
+		return b.buildHashAgg(p)
+	case *planner.PhysicalSort:
+		return b.buildSort(p)
+	case *planner.PhysicalTopN:
+		return b.buildTopN(p)
+	case *planner.PhysicalLimit:
+		return b.buildLimit(p)
+	case *planner.PhysicalApply:
+		return b.buildApply(p)
+	case *planner.PhysicalHashSemiJoin:
+		return b.buildHashSemiJoin(p)
+	case *planner.Explain:
+		return b.buildExplain(p)
 	default:
 		b.err = errors.Errorf("unknown plan: %T", plan)
 	}
@@ -53,6 +82,7 @@ func (b *Builder) buildDDL(plan *planner.DDL) Executor {
 	exec := &DDLExec{
 		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
 		statement:    plan.Statement,
+		labelProps:   plan.LabelProperties,
 	}
 	return exec
 }
@@ -65,6 +95,11 @@ func (b *Builder) buildSimple(plan *planner.Simple) Executor {
 			baseExecutor: newBaseExecutor(b.sc, showStmtColumns[s.Tp], plan.ID()),
 			statement:    s,
 		}
+	case *ast.AnalyzeGraphStmt:
+		exec = &AnalyzeExec{
+			baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
+			statement:    s,
+		}
 	default:
 		exec = &SimpleExec{
 			baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
@@ -88,10 +123,35 @@ func (b *Builder) buildInsert(plan *planner.Insert) Executor {
 	return exec
 }
 
+func (b *Builder) buildUpdate(plan *planner.Update) Executor {
+	exec := &UpdateExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
+		graph:        plan.Graph,
+		updates:      plan.Updates,
+		encoder:      &codec.PropertyEncoder{},
+		matchExec:    b.Build(plan.MatchPlan),
+	}
+	return exec
+}
+
+func (b *Builder) buildDelete(plan *planner.Delete) Executor {
+	exec := &DeleteExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
+		graph:        plan.Graph,
+		deletes:      plan.Deletes,
+		matchExec:    b.Build(plan.MatchPlan),
+	}
+	return exec
+}
+
 func (b *Builder) buildMatch(plan *planner.PhysicalMatch) Executor {
 	exec := &MatchExec{
 		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID()),
 		subgraph:     plan.Subgraph,
+		indexLookups: plan.IndexLookups,
+		bindings:     plan.Bindings,
+		order:        plan.Order,
+		properties:   plan.Properties,
 	}
 	return exec
 }
@@ -113,3 +173,110 @@ func (b *Builder) buildSelection(plan *planner.PhysicalSelection) Executor {
 	}
 	return exec
 }
+
+func (b *Builder) buildHashAgg(plan *planner.PhysicalHashAgg) Executor {
+	childExec := b.Build(plan.Children()[0])
+	exec := &HashAggExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), childExec),
+		aggFuncs:     plan.AggFuncs,
+		groupByItems: plan.GroupByItems,
+	}
+	return exec
+}
+
+func (b *Builder) buildSort(plan *planner.PhysicalSort) Executor {
+	childExec := b.Build(plan.Children()[0])
+	exec := &SortExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), childExec),
+		byItems:      plan.ByItems,
+	}
+	return exec
+}
+
+func (b *Builder) buildTopN(plan *planner.PhysicalTopN) Executor {
+	childExec := b.Build(plan.Children()[0])
+	exec := &TopNExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), childExec),
+		byItems:      plan.ByItems,
+		offsetExpr:   plan.Offset,
+		countExpr:    plan.Count,
+	}
+	return exec
+}
+
+func (b *Builder) buildLimit(plan *planner.PhysicalLimit) Executor {
+	childExec := b.Build(plan.Children()[0])
+	exec := &LimitExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), childExec),
+		offsetExpr:   plan.Offset,
+		countExpr:    plan.Count,
+	}
+	return exec
+}
+
+func (b *Builder) buildApply(plan *planner.PhysicalApply) Executor {
+	outerExec := b.Build(plan.Children()[0])
+	exec := &ApplyExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), outerExec),
+		// The subquery executors are built for each outer row, and their runtime
+		// statistics are collected into the same map.
+		innerBuilder: &Builder{sc: b.sc, stats: b.stats},
+		innerPlan:    plan.Children()[1],
+		tp:           plan.Type,
+		not:          plan.Not,
+	}
+	return exec
+}
+
+func (b *Builder) buildHashSemiJoin(plan *planner.PhysicalHashSemiJoin) Executor {
+	outerExec := b.Build(plan.Children()[0])
+	innerExec := b.Build(plan.Children()[1])
+	exec := &HashSemiJoinExec{
+		baseExecutor: newBaseExecutor(b.sc, plan.Columns(), plan.ID(), outerExec, innerExec),
+		anti:         plan.Anti,
+		outerKeys:    plan.OuterKeys,
+		innerKeys:    plan.InnerKeys,
+	}
+	return exec
+}
+
+func (b *Builder) buildExplain(plan *planner.Explain) Executor {
+	exec := &ExplainExec{
+		explain: plan,
+	}
+	if !plan.Analyze {
+		exec.baseExecutor = newBaseExecutor(b.sc, plan.Columns(), plan.ID())
+		return exec
+	}
+
+	exec.stats = make(map[int]*runtimeStats)
+	b.stats = exec.stats
+	targetExec := b.Build(plan.TargetPlan)
+	b.stats = nil
+	exec.baseExecutor = newBaseExecutor(b.sc, plan.Columns(), plan.ID(), targetExec)
+	return exec
+}
+
+// wrapStats wraps the executor to collect its runtime statistics. The plan may
+// be built multiple times (the subquery of apply), and the statistics of all its
+// executors are accumulated.
+func (b *Builder) wrapStats(plan planner.PhysicalPlan, exec Executor) Executor {
+	stats := b.stats[plan.ID()]
+	if stats == nil {
+		stats = &runtimeStats{}
+		b.stats[plan.ID()] = stats
+	}
+	if m, ok := exec.(*MatchExec); ok {
+		if stats.kv == nil {
+			stats.kv = &kvStats{}
+		}
+		m.kvStats = stats.kv
+	}
+	if sort, ok := exec.(*SortExec); ok {
+		if stats.spill == nil {
+			stats.spill = &spillStats{}
+		}
+		sort.spillStats = stats.spill
+	}
+	return &statsExecutor{Executor: exec, stats: stats}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/meta"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
@@ -20,16 +21,23 @@ type DDLExec struct {
 
 	done      bool
 	statement ast.DDLNode
+	// labelProps is the schema of the label to be created.
+	labelProps []*model.LabelPropertyInfo
 }
 
 // Next implements the Executor interface.
-func (e *DDLExec) Next(_ context.Context) (datum.Row, error) {
+func (e *DDLExec) Next(ctx context.Context) (datum.Row, error) {
 	if e.done {
 		return nil, nil
 	}
 	e.done = true
 
-	// TODO: prevent executing DDL in transaction context.
+	// The DDL changes are committed in a separate transaction, which cannot be
+	// rolled back with the current transaction.
+	if e.sc.Txn().InTxn() {
+		return nil, errors.Errorf("cannot execute DDL statement in a transaction")
+	}
+
 	// Prevent executing DDL concurrently.
 	e.sc.Catalog().MDLock()
 	defer e.sc.Catalog().MDUnlock()
@@ -46,7 +54,11 @@ func (e *DDLExec) Next(_ context.Context) (datum.Row, error) {
 		case *ast.CreateLabelStmt:
 			patch, err = e.createLabel(m, stmt)
 		case *ast.DropLabelStmt:
-			patch, err = e.dropLabel(m, stmt)
+			patch, err = e.dropLabel(m, txn, stmt)
+		case *ast.CreateIndexStmt:
+			patch, err = e.createIndex(ctx, m, txn, stmt)
+		case *ast.DropIndexStmt:
+			patch, err = e.dropIndex(m, txn, stmt)
 
 		default:
 			return errors.Errorf("unknown DDL(%T)", e.statement)
@@ -79,9 +91,10 @@ func (e *DDLExec) createGraph(m *meta.Meta, stmt *ast.CreateGraphStmt) (*catalog
 		return nil, err
 	}
 	graphInfo := &model.GraphInfo{
-		ID:    id,
-		Name:  stmt.Graph,
-		Query: stmt.Text(),
+		ID:     id,
+		Name:   stmt.Graph,
+		Query:  stmt.Text(),
+		Strict: stmt.Strict,
 	}
 	err = m.CreateGraph(graphInfo)
 	if err != nil {
@@ -155,6 +168,11 @@ func (e *DDLExec) createLabel(m *meta.Meta, stmt *ast.CreateLabelStmt) (*catalog
 		Name:  stmt.Label,
 		Query: stmt.Text(),
 	}
+	// The plan may be executed repeatedly, so the schema is not shared with the
+	// catalog.
+	for _, prop := range e.labelProps {
+		labelInfo.Properties = append(labelInfo.Properties, prop.Clone())
+	}
 	err = m.CreateLabel(graph.Meta().ID, labelInfo)
 	if err != nil {
 		return nil, err
@@ -170,7 +188,7 @@ func (e *DDLExec) createLabel(m *meta.Meta, stmt *ast.CreateLabelStmt) (*catalog
 	return patch, nil
 }
 
-func (e *DDLExec) dropLabel(m *meta.Meta, stmt *ast.DropLabelStmt) (*catalog.Patch, error) {
+func (e *DDLExec) dropLabel(m *meta.Meta, txn kv.Transaction, stmt *ast.DropLabelStmt) (*catalog.Patch, error) {
 	graph := e.sc.CurrentGraph()
 	if graph == nil {
 		return nil, meta.ErrGraphNotExists
@@ -188,6 +206,10 @@ func (e *DDLExec) dropLabel(m *meta.Meta, stmt *ast.DropLabelStmt) (*catalog.Pat
 	if err != nil {
 		return nil, err
 	}
+	// Remove all label keys.
+	if err := deletePrefix(txn, codec.LabelPrefix(graph.Meta().ID, label.Meta().ID)); err != nil {
+		return nil, err
+	}
 
 	patch := &catalog.Patch{
 		Type: catalog.PatchTypeDropLabel,
@@ -198,3 +220,151 @@ func (e *DDLExec) dropLabel(m *meta.Meta, stmt *ast.DropLabelStmt) (*catalog.Pat
 	}
 	return patch, nil
 }
+
+func (e *DDLExec) createIndex(ctx context.Context, m *meta.Meta, txn kv.Transaction, stmt *ast.CreateIndexStmt) (*catalog.Patch, error) {
+	graph := e.sc.CurrentGraph()
+	if graph == nil {
+		return nil, meta.ErrGraphNotExists
+	}
+	index := graph.Index(stmt.IndexName.L)
+	if index != nil {
+		if stmt.IfNotExists {
+			return nil, nil
+		}
+		return nil, meta.ErrIndexExists
+	}
+	if !stmt.Label.IsEmpty() && graph.Label(stmt.Label.L) == nil {
+		return nil, meta.ErrLabelNotExists
+	}
+
+	// Persistent to storage.
+	id, err := m.NextGlobalID()
+	if err != nil {
+		return nil, err
+	}
+	indexInfo := &model.IndexInfo{
+		ID:         id,
+		Name:       stmt.IndexName,
+		Label:      stmt.Label,
+		Properties: stmt.Properties,
+		Unique:     stmt.KeyType == ast.IndexKeyTypeUnique,
+		Query:      stmt.Text(),
+	}
+	graphInfo, err := m.GetGraph(graph.Meta().ID)
+	if err != nil {
+		return nil, err
+	}
+	if graphInfo == nil {
+		return nil, meta.ErrGraphNotExists
+	}
+	graphInfo.Indexes = append(graphInfo.Indexes, indexInfo)
+	if err := m.UpdateGraph(graphInfo); err != nil {
+		return nil, err
+	}
+
+	// Backfill the index of existing vertices. The keys are collected first to
+	// avoid modifying the transaction buffer during iteration. The existing
+	// vertices which violate the unique constraint fail the DDL.
+	graphID := graph.Meta().ID
+	index = catalog.NewIndex(indexInfo)
+	var keys []indexKey
+	err = iterVertexValues(txn, graphID, func(vertexID int64, val []byte) error {
+		labels, props, err := decodeLabelsAndProperties(graph, val)
+		if err != nil {
+			return err
+		}
+		key, err := vertexIndexKey(graphID, index, vertexID, labels, props)
+		if err != nil || key == nil {
+			return err
+		}
+		keys = append(keys, indexKey{index: index, key: key, vertexID: vertexID})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := setIndexKeys(ctx, txn, keys); err != nil {
+		return nil, err
+	}
+
+	patch := &catalog.Patch{
+		Type: catalog.PatchTypeCreateIndex,
+		Data: &catalog.PatchIndex{
+			GraphID:   graphID,
+			IndexInfo: indexInfo,
+		},
+	}
+	return patch, nil
+}
+
+func (e *DDLExec) dropIndex(m *meta.Meta, txn kv.Transaction, stmt *ast.DropIndexStmt) (*catalog.Patch, error) {
+	graph := e.sc.CurrentGraph()
+	if graph == nil {
+		return nil, meta.ErrGraphNotExists
+	}
+	index := graph.Index(stmt.IndexName.L)
+	if index == nil {
+		if stmt.IfExists {
+			return nil, nil
+		}
+		return nil, meta.ErrIndexNotExists
+	}
+
+	// Persistent to storage.
+	graphID := graph.Meta().ID
+	graphInfo, err := m.GetGraph(graphID)
+	if err != nil {
+		return nil, err
+	}
+	if graphInfo == nil {
+		return nil, meta.ErrGraphNotExists
+	}
+	indexes := graphInfo.Indexes[:0]
+	for _, idx := range graphInfo.Indexes {
+		if idx.ID != index.Meta().ID {
+			indexes = append(indexes, idx)
+		}
+	}
+	graphInfo.Indexes = indexes
+	if err := m.UpdateGraph(graphInfo); err != nil {
+		return nil, err
+	}
+
+	// Remove all index entries.
+	if err := deletePrefix(txn, codec.IndexPrefix(graphID, index.Meta().ID)); err != nil {
+		return nil, err
+	}
+
+	patch := &catalog.Patch{
+		Type: catalog.PatchTypeDropIndex,
+		Data: &catalog.PatchIndex{
+			GraphID:   graphID,
+			IndexInfo: index.Meta(),
+		},
+	}
+	return patch, nil
+}
+
+// deletePrefix deletes all keys with the specified prefix.
+func deletePrefix(txn kv.Transaction, prefix kv.Key) error {
+	iter, err := txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return err
+	}
+	// The keys are collected first to avoid modifying the transaction buffer
+	// during iteration.
+	var keys []kv.Key
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		keys = append(keys, iter.Key().Clone())
+	}
+	iter.Close()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,9 +7,16 @@ import (
 	"testing"
 
 	"github.com/simbiont-runtime/graphengine"
+	"github.com/simbiont-runtime/graphengine/codec"
 	"github.com/simbiont-runtime/graphengine/compiler"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/meta"
 	"github.com/simbiont-runtime/graphengine/parser"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/simbiont-runtime/graphengine/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDDLExec_Next(t *testing.T) {
@@ -56,6 +63,44 @@ func TestDDLExec_Next(t *testing.T) {
 				assert.Nil(catalog.Graph("g1"))
 			},
 		},
+		{
+			query: "create graph g2 strict",
+			check: func() {
+				assert.True(catalog.Graph("g2").Meta().Strict)
+			},
+		},
+		{
+			graph: "g2",
+			query: "create label l2 (name string not null, age integer default 18)",
+			check: func() {
+				graph := catalog.Graph("g2")
+				labelInfo := graph.Label("l2").Meta()
+				assert.Len(labelInfo.Properties, 2)
+				assert.Equal("name", labelInfo.Properties[0].Name.L)
+				assert.Equal(types.String, labelInfo.Properties[0].Type)
+				assert.True(labelInfo.Properties[0].NotNull)
+				assert.Nil(labelInfo.Properties[0].Default)
+				age := labelInfo.Property("age")
+				assert.Equal(types.Int, age.Type)
+				assert.False(age.NotNull)
+				value, err := codec.DecodeValue(age.Default)
+				assert.Nil(err)
+				assert.Equal(datum.NewInt(18), value)
+				// The properties in the schema are created with the label.
+				assert.NotNil(graph.Property("name"))
+				assert.NotNil(graph.Property("age"))
+
+				// The schema is persisted.
+				err = kv.Txn(db.Store(), func(txn kv.Transaction) error {
+					labels, err := meta.New(txn).ListLabels(graph.Meta().ID)
+					assert.Nil(err)
+					assert.Len(labels, 1)
+					assert.Equal(labelInfo.Properties, labels[0].Properties)
+					return nil
+				})
+				assert.Nil(err)
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -81,4 +126,38 @@ func TestDDLExec_Next(t *testing.T) {
 			c.check()
 		}
 	}
+
+}
+
+func TestDDLExec_CreateIndexConcurrentDML(t *testing.T) {
+	db := newTestDB(t, nil)
+	sess1 := newTestSession(t, db)
+	sess2 := newTestSession(t, db)
+	sess1.mustExec("CREATE GRAPH g")
+	sess1.mustExec("USE g")
+	sess1.mustExec("CREATE LABEL Person")
+	sess1.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Kathrine', x.email = 'a@example.com')")
+	sess2.mustExec("USE g")
+
+	// The transaction writing vertices before the index created cannot be
+	// committed, because its vertices miss in the index.
+	sess1.mustExec("BEGIN")
+	sess1.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Riya', x.email = 'a@example.com')")
+	sess2.mustExec("CREATE UNIQUE INDEX idx_email ON Person (email)")
+	_, err := sess1.exec("COMMIT")
+	require.ErrorIs(t, err, stmtctx.ErrIndexChanged)
+
+	rows := sess1.mustExec("SELECT x.name FROM MATCH (x:Person) WHERE x.email = 'a@example.com'")
+	require.Equal(t, []datum.Row{{datum.NewString("Kathrine")}}, rows)
+
+	// The read-only transaction is not affected.
+	sess1.mustExec("BEGIN")
+	sess1.mustExec("SELECT x.name FROM MATCH (x:Person)")
+	sess2.mustExec("DROP INDEX idx_email")
+	sess1.mustExec("COMMIT")
+
+	// The transaction started after the index changed is committed.
+	sess1.mustExec("BEGIN")
+	sess1.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Riya', x.email = 'a@example.com')")
+	sess1.mustExec("COMMIT")
 }
@@ -0,0 +1,51 @@
+// ---
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// degreeDeltas accumulates the changes of the degree counters of vertices in a
+// statement, which are keyed by the degree keys. The changes are applied to the
+// stored counters at once, since a vertex can be connected by many edges.
+type degreeDeltas map[string]int64
+
+// addEdge counts the edge in the degrees of its source and destination vertices.
+func (d degreeDeltas) addEdge(graphID, srcID, dstID int64) {
+	d[string(codec.OutgoingDegreeKey(graphID, srcID))]++
+	d[string(codec.IncomingDegreeKey(graphID, dstID))]++
+}
+
+// apply adds the changes to the stored degree counters.
+func (d degreeDeltas) apply(ctx context.Context, txn kv.Transaction) error {
+	for key, delta := range d {
+		if delta == 0 {
+			continue
+		}
+		degree, err := getDegree(ctx, txn, kv.Key(key))
+		if err != nil {
+			return err
+		}
+		if err := txn.Set(kv.Key(key), codec.EncodeDegree(degree+delta)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDegree returns the stored degree counter, which is zero if it not exists.
+func getDegree(ctx context.Context, txn kv.Transaction, key kv.Key) (int64, error) {
+	val, err := txn.Get(ctx, key)
+	if err != nil {
+		if errors.ErrorEqual(err, kv.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return codec.DecodeDegree(val)
+}
@@ -0,0 +1,182 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/internal/logutil"
+	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// DeleteExec represents the executor of DELETE statement.
+type DeleteExec struct {
+	baseExecutor
+
+	done      bool
+	graph     *catalog.Graph
+	deletes   []*planner.ElementDelete
+	matchExec Executor
+
+	// The same element can be matched multiple times and we only delete it once.
+	vertices map[int64]*datum.Vertex
+	edges    map[int64]*datum.Edge
+}
+
+// Open implements the Executor interface.
+func (e *DeleteExec) Open(ctx context.Context) error {
+	e.vertices = make(map[int64]*datum.Vertex)
+	e.edges = make(map[int64]*datum.Edge)
+	return e.matchExec.Open(ctx)
+}
+
+// Next implements the Executor interface.
+func (e *DeleteExec) Next(ctx context.Context) (datum.Row, error) {
+	if e.done {
+		return nil, nil
+	}
+	e.done = true
+
+	for {
+		row, err := e.matchExec.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			break
+		}
+		for _, del := range e.deletes {
+			switch x := row[del.VariableIndex].(type) {
+			case *datum.Vertex:
+				e.vertices[x.ID] = x
+			case *datum.Edge:
+				e.edges[x.ID] = x
+			default:
+				return nil, errors.Errorf("cannot delete variable %s of type %s", del.VariableName, x.Type())
+			}
+		}
+	}
+
+	affectedRows := uint64(len(e.vertices) + len(e.edges))
+	if affectedRows == 0 {
+		return nil, nil
+	}
+
+	txn, err := e.sc.Txn().Activate()
+	if err != nil {
+		return nil, err
+	}
+	err = e.deleteElements(ctx, txn)
+	if err != nil {
+		logutil.Errorf("Delete vertices/edges failed: %+v", e.deletes)
+		return nil, err
+	}
+	e.sc.AddAffectedRows(affectedRows)
+	e.sc.AddDeletedRows(affectedRows)
+	return nil, nil
+}
+
+func (e *DeleteExec) deleteElements(ctx context.Context, txn kv.Transaction) error {
+	graphID := e.graph.Meta().ID
+	var keys []kv.Key
+	// The edges connected to the deleted vertices are deleted too, and an edge
+	// is counted only once in the degrees even if it is matched multiple times.
+	edges := make(map[int64]*datum.Edge, len(e.edges))
+	for edgeID, edge := range e.edges {
+		edges[edgeID] = edge
+	}
+	for vertexID, vertex := range e.vertices {
+		keys = append(keys,
+			codec.VertexKey(graphID, vertexID),
+			codec.IncomingDegreeKey(graphID, vertexID),
+			codec.OutgoingDegreeKey(graphID, vertexID),
+		)
+		keys = append(keys, vertexLabelKeys(e.graph, vertexID, vertex.Labels)...)
+		if err := e.collectIncidentEdges(txn, vertexID, edges); err != nil {
+			return err
+		}
+		indexKeys, err := vertexIndexKeys(e.graph, vertexID, vertex.Labels, vertex.Props)
+		if err != nil {
+			return err
+		}
+		for _, indexKey := range indexKeys {
+			keys = append(keys, indexKey.key)
+		}
+	}
+
+	degrees := make(degreeDeltas)
+	for edgeID, edge := range edges {
+		keys = append(keys,
+			codec.IncomingEdgeKey(graphID, edge.SrcID, edge.DstID, edgeID),
+			codec.OutgoingEdgeKey(graphID, edge.SrcID, edge.DstID, edgeID),
+		)
+		// The degree counters of the deleted vertices are deleted.
+		if _, deleted := e.vertices[edge.SrcID]; !deleted {
+			degrees[string(codec.OutgoingDegreeKey(graphID, edge.SrcID))]--
+		}
+		if _, deleted := e.vertices[edge.DstID]; !deleted {
+			degrees[string(codec.IncomingDegreeKey(graphID, edge.DstID))]--
+		}
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return degrees.apply(ctx, txn)
+}
+
+// collectIncidentEdges collects all edges connected to the vertex in both
+// directions. Each edge is stored twice (outgoing key of the source vertex and
+// incoming key of the destination vertex), and both keys need to be removed to
+// avoid dangling adjacency entries.
+func (e *DeleteExec) collectIncidentEdges(txn kv.Transaction, vertexID int64, edges map[int64]*datum.Edge) error {
+	graphID := e.graph.Meta().ID
+
+	lower := codec.OutgoingEdgeKey(graphID, vertexID, 0, 0)
+	upper := codec.OutgoingEdgeKey(graphID, vertexID, math.MaxInt64, math.MaxInt64)
+	iter, err := txn.Iter(lower, upper)
+	if err != nil {
+		return err
+	}
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		_, srcID, dstID, edgeID, err := codec.ParseOutgoingEdgeKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		edges[edgeID] = &datum.Edge{ID: edgeID, SrcID: srcID, DstID: dstID}
+	}
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	lower = codec.IncomingEdgeKey(graphID, 0, vertexID, 0)
+	upper = codec.IncomingEdgeKey(graphID, math.MaxInt64, vertexID, math.MaxInt64)
+	iter, err = txn.Iter(lower, upper)
+	if err != nil {
+		return err
+	}
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		_, srcID, dstID, edgeID, err := codec.ParseIncomingEdgeKey(iter.Key())
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		edges[edgeID] = &datum.Edge{ID: edgeID, SrcID: srcID, DstID: dstID}
+	}
+	iter.Close()
+	return err
+}
+
+// Close implements the Executor interface.
+func (e *DeleteExec) Close() error {
+	return e.matchExec.Close()
+}
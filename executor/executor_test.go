@@ -0,0 +1,61 @@
+// ---
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/session"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDB opens a database in a temporary directory, which is closed when
+// the test finished.
+func newTestDB(t *testing.T, opts *graphengine.Options) *graphengine.DB {
+	db, err := graphengine.Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+// testSession is a session which executes the queries in tests.
+type testSession struct {
+	*session.Session
+	t *testing.T
+}
+
+// newTestSession returns a new session of the database.
+func newTestSession(t *testing.T, db *graphengine.DB) *testSession {
+	return &testSession{Session: db.NewSession(), t: t}
+}
+
+// exec executes the query and returns all the result rows.
+func (s *testSession) exec(query string, params ...datum.Datum) ([]datum.Row, error) {
+	ctx := context.Background()
+	rs, err := s.Execute(ctx, query, params...)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	var rows []datum.Row
+	for {
+		if err := rs.Next(ctx); err != nil {
+			return nil, err
+		}
+		if !rs.Valid() {
+			return rows, nil
+		}
+		rows = append(rows, rs.Row())
+	}
+}
+
+// mustExec executes the query and fails the test if the query failed.
+func (s *testSession) mustExec(query string, params ...datum.Datum) []datum.Row {
+	rows, err := s.exec(query, params...)
+	require.NoError(s.t, err, query)
+	return rows
+}
@@ -0,0 +1,198 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// ExplainExec renders the physical plan tree as rows. If it is EXPLAIN ANALYZE,
+// the target executor (the only child) is run to completion first, and the
+// runtime statistics of each operator are rendered with the plan.
+type ExplainExec struct {
+	baseExecutor
+
+	explain *planner.Explain
+	stats   map[int]*runtimeStats
+	results []datum.Row
+	index   int
+}
+
+func (e *ExplainExec) Open(ctx context.Context) error {
+	if err := e.baseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	if e.explain.Analyze {
+		for {
+			row, err := e.children[0].Next(ctx)
+			if err != nil {
+				return err
+			}
+			if row == nil {
+				break
+			}
+		}
+	}
+	e.explainPlan(e.explain.TargetPlan, "", "")
+	return nil
+}
+
+// explainPlan appends the rows of the plan and its descendants in pre-order. The
+// indent is the prefix of the descendants, and the branch is the prefix of the
+// plan itself.
+func (e *ExplainExec) explainPlan(plan planner.PhysicalPlan, indent, branch string) {
+	row := datum.Row{
+		datum.NewString(branch + plan.ExplainID()),
+		datum.NewString(plan.ExplainInfo()),
+		datum.NewString(fmt.Sprintf("%.2f", planner.EstimateRows(plan))),
+	}
+	if e.explain.Analyze {
+		stats := e.stats[plan.ID()]
+		if stats == nil {
+			stats = &runtimeStats{}
+		}
+		row = append(row,
+			datum.NewInt(stats.rows),
+			datum.NewString(stats.time.String()),
+			datum.NewString(stats.String()),
+		)
+	}
+	e.results = append(e.results, row)
+
+	children := plan.Children()
+	for i, child := range children {
+		if i == len(children)-1 {
+			e.explainPlan(child, indent+"  ", indent+"└─")
+		} else {
+			e.explainPlan(child, indent+"│ ", indent+"├─")
+		}
+	}
+}
+
+func (e *ExplainExec) Next(_ context.Context) (datum.Row, error) {
+	if e.index >= len(e.results) {
+		return nil, nil
+	}
+	row := e.results[e.index]
+	e.index++
+	return row, nil
+}
+
+// runtimeStats is the runtime statistics of an operator collected by EXPLAIN
+// ANALYZE. The time includes the time spent in its children.
+type runtimeStats struct {
+	rows int64
+	time time.Duration
+	// kv is the KV statistics of MatchExec, and nil for other operators.
+	kv *kvStats
+	// spill is the spill statistics of SortExec, and nil for other operators.
+	spill *spillStats
+}
+
+// String returns the execution information other than rows and time.
+func (s *runtimeStats) String() string {
+	switch {
+	case s.kv != nil:
+		return fmt.Sprintf("kv_gets:%d, kv_iters:%d", s.kv.gets, s.kv.iters)
+	case s.spill != nil && s.spill.runs > 0:
+		return fmt.Sprintf("spill_runs:%d, spill_bytes:%d", s.spill.runs, s.spill.bytes)
+	default:
+		return ""
+	}
+}
+
+// kvStats counts the KV gets and the keys visited by iterators.
+type kvStats struct {
+	gets  int64
+	iters int64
+}
+
+// spillStats counts the sorted runs spilled to disk and their bytes.
+type spillStats struct {
+	runs  int64
+	bytes int64
+}
+
+// statsExecutor wraps an executor to collect its runtime statistics.
+type statsExecutor struct {
+	Executor
+
+	stats *runtimeStats
+}
+
+func (e *statsExecutor) Open(ctx context.Context) error {
+	start := time.Now()
+	err := e.Executor.Open(ctx)
+	e.stats.time += time.Since(start)
+	return err
+}
+
+func (e *statsExecutor) Next(ctx context.Context) (datum.Row, error) {
+	start := time.Now()
+	row, err := e.Executor.Next(ctx)
+	e.stats.time += time.Since(start)
+	if row != nil {
+		e.stats.rows++
+	}
+	return row, err
+}
+
+// countingTxn wraps a transaction to count the KV gets and iterations.
+type countingTxn struct {
+	kv.Transaction
+
+	stats *kvStats
+}
+
+// Get implements the kv.Getter interface.
+func (t *countingTxn) Get(ctx context.Context, k kv.Key) ([]byte, error) {
+	t.stats.gets++
+	return t.Transaction.Get(ctx, k)
+}
+
+// Iter implements the kv.Retriever interface.
+func (t *countingTxn) Iter(lowerBound kv.Key, upperBound kv.Key) (kv.Iterator, error) {
+	iter, err := t.Transaction.Iter(lowerBound, upperBound)
+	if err != nil {
+		return nil, err
+	}
+	return newCountingIter(iter, t.stats), nil
+}
+
+// IterReverse implements the kv.Retriever interface.
+func (t *countingTxn) IterReverse(lowerBound kv.Key, upperBound kv.Key) (kv.Iterator, error) {
+	iter, err := t.Transaction.IterReverse(lowerBound, upperBound)
+	if err != nil {
+		return nil, err
+	}
+	return newCountingIter(iter, t.stats), nil
+}
+
+// countingIter counts the keys visited by the iterator.
+type countingIter struct {
+	kv.Iterator
+
+	stats *kvStats
+}
+
+func newCountingIter(iter kv.Iterator, stats *kvStats) *countingIter {
+	if iter.Valid() {
+		stats.iters++
+	}
+	return &countingIter{Iterator: iter, stats: stats}
+}
+
+// Next implements the kv.Iterator interface.
+func (it *countingIter) Next() error {
+	err := it.Iterator.Next()
+	if err == nil && it.Valid() {
+		it.stats.iters++
+	}
+	return err
+}
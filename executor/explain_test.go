@@ -0,0 +1,45 @@
+// ---
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainExec_Analyze(t *testing.T) {
+	sess := newTestSession(t, newTestDB(t, nil))
+
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Person")
+	sess.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Kathrine', x.age = 20)")
+	sess.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Riya', x.age = 30)")
+	sess.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Lee', x.age = 40)")
+
+	rows := sess.mustExec("EXPLAIN ANALYZE SELECT x.name FROM MATCH (x:Person) WHERE MATCH_NUMBER(x) > 1")
+	require.Len(t, rows, 3)
+	for _, row := range rows {
+		require.Len(t, row, 6)
+	}
+	require.Equal(t, "Projection_1", datum.AsString(rows[0][0]))
+	require.Equal(t, "└─Selection_2", datum.AsString(rows[1][0]))
+	require.Equal(t, "  └─Match_3", datum.AsString(rows[2][0]))
+
+	// actRows
+	require.Equal(t, int64(2), datum.AsInt(rows[0][3]))
+	require.Equal(t, int64(2), datum.AsInt(rows[1][3]))
+	require.Equal(t, int64(3), datum.AsInt(rows[2][3]))
+
+	// The vertices are found by the label keys and then read by KV gets.
+	require.Equal(t, "", datum.AsString(rows[0][5]))
+	require.Equal(t, "kv_gets:3, kv_iters:3", datum.AsString(rows[2][5]))
+
+	// The query is not executed by EXPLAIN.
+	rows = sess.mustExec("EXPLAIN SELECT x.name FROM MATCH (x:Person) WHERE MATCH_NUMBER(x) > 1")
+	require.Len(t, rows, 3)
+	require.Len(t, rows[0], 3)
+	require.Equal(t, "  └─Match_3", datum.AsString(rows[2][0]))
+}
@@ -0,0 +1,174 @@
+// ---
+
+package executor
+
+import (
+	"context"
+	"math"
+
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"golang.org/x/exp/slices"
+)
+
+// indexKey is the key of an index for a vertex.
+type indexKey struct {
+	index    *catalog.Index
+	key      kv.Key
+	vertexID int64
+}
+
+// vertexIndexKey returns the index key of the vertex, or nil if the vertex
+// doesn't have the label or all properties of the index. The vertex ID is the
+// value of the unique index key instead of a part of the key.
+func vertexIndexKey(graphID int64, index *catalog.Index, vertexID int64, labels []string, props map[string]datum.Datum) (kv.Key, error) {
+	info := index.Meta()
+	if !info.Label.IsEmpty() && !slices.Contains(labels, info.Label.L) {
+		return nil, nil
+	}
+	values := make([]datum.Datum, 0, len(info.Properties))
+	for _, name := range info.Properties {
+		value, ok := props[name.L]
+		if !ok || value == datum.Null {
+			return nil, nil
+		}
+		values = append(values, value)
+	}
+	encoded, err := codec.EncodeIndexValues(nil, values)
+	if err != nil {
+		return nil, err
+	}
+	if info.Unique {
+		return codec.UniqueIndexKey(graphID, info.ID, codec.VertexIndexType, encoded), nil
+	}
+	return codec.VertexNonUniqueIndexKey(graphID, info.ID, encoded, vertexID), nil
+}
+
+// vertexIndexKeys returns the keys of all indexes of the graph for the vertex.
+func vertexIndexKeys(graph *catalog.Graph, vertexID int64, labels []string, props map[string]datum.Datum) ([]indexKey, error) {
+	var keys []indexKey
+	for _, index := range graph.Indexes() {
+		key, err := vertexIndexKey(graph.Meta().ID, index, vertexID, labels, props)
+		if err != nil {
+			return nil, err
+		}
+		if key != nil {
+			keys = append(keys, indexKey{index: index, key: key, vertexID: vertexID})
+		}
+	}
+	return keys, nil
+}
+
+// setIndexKeys writes the index keys into the transaction. The unique index key
+// taken by another vertex is rejected. If the key doesn't exist in the snapshot
+// of the transaction either, it's presumed not to exist, and the key inserted by
+// a concurrent transaction is detected when committing.
+func setIndexKeys(ctx context.Context, txn kv.Transaction, keys []indexKey) error {
+	for _, k := range keys {
+		if !k.index.Meta().Unique {
+			if err := txn.Set(k.key, codec.LabelValue()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		val, err := txn.Get(ctx, k.key)
+		if err == nil {
+			_, vertexID, err := codec.DecodeInt(val)
+			if err != nil {
+				return err
+			}
+			if vertexID != k.vertexID {
+				return meta.NewErrDuplicateKey(k.index.Meta(), vertexID)
+			}
+			continue
+		}
+		if !kv.IsErrNotFound(err) {
+			return err
+		}
+		var ops []kv.FlagsOp
+		_, err = txn.Snapshot().Get(ctx, k.key)
+		if kv.IsErrNotFound(err) {
+			ops = append(ops, kv.SetPresumeKeyNotExists)
+		} else if err != nil {
+			return err
+		}
+		if err := txn.SetWithFlags(k.key, codec.EncodeInt(nil, k.vertexID), ops...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vertexLabelKeys returns the label keys of the vertex.
+func vertexLabelKeys(graph *catalog.Graph, vertexID int64, labels []string) []kv.Key {
+	keys := make([]kv.Key, 0, len(labels))
+	for _, name := range labels {
+		label := graph.Label(name)
+		if label == nil {
+			continue
+		}
+		keys = append(keys, codec.VertexLabelKey(graph.Meta().ID, label.Meta().ID, vertexID))
+	}
+	return keys
+}
+
+// iterVertexValues iterates all vertices of the graph with their raw values.
+func iterVertexValues(txn kv.Transaction, graphID int64, f func(vertexID int64, val []byte) error) error {
+	lower := codec.VertexKey(graphID, 0)
+	upper := codec.VertexKey(graphID, math.MaxInt64)
+	iter, err := txn.Iter(lower, upper)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		// TODO: better way to skip edge keys
+		if len(iter.Key()) != codec.VertexKeyLen {
+			continue
+		}
+		_, vertexID, err := codec.ParseVertexKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		if err := f(vertexID, iter.Value()); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// decodeLabelsAndProperties decodes the value of vertex or edge into the label
+// names and properties.
+func decodeLabelsAndProperties(graph *catalog.Graph, val []byte) (labels []string, properties map[string]datum.Datum, _ error) {
+	return decodeLabelsAndPropertiesOf(graph, val, graph.Properties())
+}
+
+// decodeLabelsAndPropertiesOf decodes the value of vertex or edge into the label
+// names and the given properties, and the other properties are skipped.
+func decodeLabelsAndPropertiesOf(graph *catalog.Graph, val []byte, props []*model.PropertyInfo) (labels []string, properties map[string]datum.Datum, _ error) {
+	var labelInfos []*model.LabelInfo
+	for _, label := range graph.Labels() {
+		labelInfos = append(labelInfos, label.Meta())
+	}
+	dec := codec.NewPropertyDecoder(labelInfos, props)
+
+	labelIDs, propertyValues, err := dec.Decode(val)
+	if err != nil {
+		return nil, nil, err
+	}
+	properties = make(map[string]datum.Datum)
+	for labelID := range labelIDs {
+		labels = append(labels, graph.LabelByID(labelID).Meta().Name.L)
+	}
+	for propID, propVal := range propertyValues {
+		propName := graph.PropertyByID(propID).Name.L
+		properties[propName] = propVal
+	}
+	return labels, properties, nil
+}
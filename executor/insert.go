@@ -5,6 +5,7 @@ package executor
 import (
 	"context"
 
+	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/catalog"
 	"github.com/simbiont-runtime/graphengine/codec"
 	"github.com/simbiont-runtime/graphengine/datum"
@@ -22,14 +23,17 @@ type InsertExec struct {
 	graph      *catalog.Graph
 	insertions []*planner.ElementInsertion
 	kvs        []kv.Pair
+	indexKeys  []indexKey
 	buffer     []byte
 	encoder    *codec.PropertyEncoder
 	decoder    *codec.PropertyDecoder
 	matchExec  Executor
+	degrees    degreeDeltas
 }
 
 // Open implements the Executor interface.
 func (e *InsertExec) Open(ctx context.Context) error {
+	e.degrees = make(degreeDeltas)
 	if e.matchExec != nil {
 		if err := e.matchExec.Open(ctx); err != nil {
 			return err
@@ -78,16 +82,22 @@ func (e *InsertExec) Next(ctx context.Context) (datum.Row, error) {
 		return nil, nil
 	}
 
-	// FIXME: use transaction in stmtctx.Context
-	err = kv.Txn(e.sc.Store(), func(txn kv.Transaction) error {
-		for _, pair := range e.kvs {
-			err := txn.Set(pair.Key, pair.Val)
-			if err != nil {
-				return err
-			}
+	txn, err := e.sc.Txn().Activate()
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range e.kvs {
+		err = txn.Set(pair.Key, pair.Val)
+		if err != nil {
+			break
 		}
-		return nil
-	})
+	}
+	if err == nil {
+		err = setIndexKeys(ctx, txn, e.indexKeys)
+	}
+	if err == nil {
+		err = e.degrees.apply(ctx, txn)
+	}
 	if err != nil {
 		logutil.Errorf("Insert vertices/edges failed: %+v", e.insertions)
 	}
@@ -113,7 +123,11 @@ func (e *InsertExec) encodeInsertions(matchRow datum.Row) error {
 				return err
 			}
 		case ast.InsertionTypeEdge:
-			if err := e.encodeEdge(graphID, insertion, matchRow); err != nil {
+			edgeID, err := idRange.Next()
+			if err != nil {
+				return err
+			}
+			if err := e.encodeEdge(graphID, edgeID, insertion, matchRow); err != nil {
 				return err
 			}
 		}
@@ -139,21 +153,17 @@ func (e *InsertExec) encodeInsertionsFromMatch(ctx context.Context) error {
 
 func (e *InsertExec) encodeVertex(graphID, vertexID int64, insertion *planner.ElementInsertion, matchRow datum.Row) error {
 	key := codec.VertexKey(graphID, vertexID)
+	props, propertyIDs, values, err := e.evalProperties(insertion, matchRow)
+	if err != nil {
+		return err
+	}
 	var (
-		propertyIDs []uint16
-		values      datum.Row
+		labelIDs []int64
+		labels   []string
 	)
-	for _, assignment := range insertion.Assignments {
-		value, err := assignment.Expr.Eval(e.sc, matchRow)
-		if err != nil {
-			return err
-		}
-		propertyIDs = append(propertyIDs, assignment.PropertyRef.Property.ID)
-		values = append(values, value)
-	}
-	var labelIDs []uint16
 	for _, label := range insertion.Labels {
-		labelIDs = append(labelIDs, uint16(label.Meta().ID))
+		labelIDs = append(labelIDs, label.Meta().ID)
+		labels = append(labels, label.Meta().Name.L)
 	}
 	ret, err := e.encoder.Encode(e.buffer, labelIDs, propertyIDs, values)
 	if err != nil {
@@ -162,22 +172,23 @@ func (e *InsertExec) encodeVertex(graphID, vertexID int64, insertion *planner.El
 	val := make([]byte, len(ret))
 	copy(val, ret)
 	e.kvs = append(e.kvs, kv.Pair{Key: key, Val: val})
+	for _, label := range insertion.Labels {
+		labelKey := codec.VertexLabelKey(graphID, label.Meta().ID, vertexID)
+		e.kvs = append(e.kvs, kv.Pair{Key: labelKey, Val: codec.LabelValue()})
+	}
+
+	indexKeys, err := vertexIndexKeys(e.graph, vertexID, labels, props)
+	if err != nil {
+		return err
+	}
+	e.indexKeys = append(e.indexKeys, indexKeys...)
 	return nil
 }
 
-func (e *InsertExec) encodeEdge(graphID int64, insertion *planner.ElementInsertion, matchRow datum.Row) error {
-	// TODO: Edge also need an unique ID. How to encode and index it? See https://pgql-lang.org/spec/1.5/#id.
-	var (
-		propertyIDs []uint16
-		values      []datum.Datum
-	)
-	for _, assignment := range insertion.Assignments {
-		value, err := assignment.Expr.Eval(e.sc, matchRow)
-		if err != nil {
-			return err
-		}
-		propertyIDs = append(propertyIDs, assignment.PropertyRef.Property.ID)
-		values = append(values, value)
+func (e *InsertExec) encodeEdge(graphID, edgeID int64, insertion *planner.ElementInsertion, matchRow datum.Row) error {
+	_, propertyIDs, values, err := e.evalProperties(insertion, matchRow)
+	if err != nil {
+		return err
 	}
 
 	srcIDVal, err := insertion.FromIDExpr.Eval(e.sc, matchRow)
@@ -192,9 +203,9 @@ func (e *InsertExec) encodeEdge(graphID int64, insertion *planner.ElementInserti
 	srcID := datum.AsInt(srcIDVal)
 	dstID := datum.AsInt(dstIDVal)
 
-	var labelIDs []uint16
+	var labelIDs []int64
 	for _, label := range insertion.Labels {
-		labelIDs = append(labelIDs, uint16(label.Meta().ID))
+		labelIDs = append(labelIDs, label.Meta().ID)
 	}
 	ret, err := e.encoder.Encode(e.buffer, labelIDs, propertyIDs, values)
 	if err != nil {
@@ -202,11 +213,43 @@ func (e *InsertExec) encodeEdge(graphID int64, insertion *planner.ElementInserti
 	}
 	val := make([]byte, len(ret))
 	copy(val, ret)
-	e.kvs = append(e.kvs, kv.Pair{Key: codec.IncomingEdgeKey(graphID, srcID, dstID), Val: val})
-	e.kvs = append(e.kvs, kv.Pair{Key: codec.OutgoingEdgeKey(graphID, srcID, dstID), Val: val})
+	e.kvs = append(e.kvs, kv.Pair{Key: codec.IncomingEdgeKey(graphID, srcID, dstID, edgeID), Val: val})
+	e.kvs = append(e.kvs, kv.Pair{Key: codec.OutgoingEdgeKey(graphID, srcID, dstID, edgeID), Val: val})
+	e.degrees.addEdge(graphID, srcID, dstID)
 	return nil
 }
 
+// evalProperties evaluates the property assignments of the insertion and checks
+// the properties against the schemas of the labels.
+func (e *InsertExec) evalProperties(insertion *planner.ElementInsertion, matchRow datum.Row) (map[string]datum.Datum, []uint16, datum.Row, error) {
+	props := make(map[string]datum.Datum, len(insertion.Assignments))
+	for _, assignment := range insertion.Assignments {
+		value, err := assignment.Expr.Eval(e.sc, matchRow)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		props[assignment.PropertyRef.Property.Name.L] = value
+	}
+	if err := fillDefaults(insertion.Labels, props); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkLabelSchemas(insertion.Labels, props); err != nil {
+		return nil, nil, nil, err
+	}
+
+	propertyIDs := make([]uint16, 0, len(props))
+	values := make(datum.Row, 0, len(props))
+	for name, value := range props {
+		property := e.graph.Property(name)
+		if property == nil {
+			return nil, nil, nil, errors.Errorf("property %s not exists", name)
+		}
+		propertyIDs = append(propertyIDs, property.ID)
+		values = append(values, value)
+	}
+	return props, propertyIDs, values, nil
+}
+
 func (e *InsertExec) Close() error {
 	if e.matchExec != nil {
 		return e.matchExec.Close()
@@ -2,8 +2,99 @@
 
 package executor_test
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/stretchr/testify/require"
+)
 
 func TestInsertExec_Next(t *testing.T) {
 
 }
+
+func TestInsertExec_PropertyTypes(t *testing.T) {
+	sess := newTestSession(t, newTestDB(t, nil))
+
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Person")
+
+	decimal, _, err := apd.NewFromString("3.14159265358979323846264338327950288")
+	require.NoError(t, err)
+	values := []datum.Datum{
+		datum.NewBool(true),
+		datum.NewInt(42),
+		datum.NewFloat(0.1),
+		datum.NewString("hello"),
+		datum.NewBytes([]byte{0, 1, 255}),
+		datum.NewDecimal(decimal),
+		datum.NewDateFromUnixEpochDays(19000),
+		datum.NewTime(datum.TimeOfDay(12*3600 + 34*60 + 56)),
+		datum.NewTimeTZ(datum.TimeOfDay(12*3600), 330),
+		&datum.Timestamp{Time: time.Date(2022, 1, 2, 3, 4, 5, 123456789, time.UTC)},
+		&datum.TimestampTZ{Time: time.Date(2022, 1, 2, 3, 4, 5, 0, time.FixedZone("", -7*3600))},
+		datum.NewIntervalFromParts(1, 2, 3),
+		// The values of a row exceed 64 KiB.
+		datum.NewString(strings.Repeat("a", 1<<17)),
+	}
+	for i, value := range values {
+		sess.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.id = ?, x.v = ?)", datum.NewInt(int64(i)), value)
+	}
+
+	rows := sess.mustExec("SELECT x.v FROM MATCH (x:Person) ORDER BY x.id")
+	require.Len(t, rows, len(values))
+	for i, value := range values {
+		got := rows[i][0]
+		require.Equal(t, value.Type(), got.Type())
+		require.Equal(t, value.String(), got.String())
+	}
+	require.Equal(t, []byte{0, 1, 255}, datum.AsBytes(rows[4][0]))
+	require.Equal(t, decimal.String(), datum.AsDecimal(rows[5][0]).String())
+	require.True(t, values[9].(*datum.Timestamp).Equal(datum.AsTimestamp(rows[9][0]).Time))
+	require.True(t, values[10].(*datum.TimestampTZ).Equal(datum.AsTimestampTZ(rows[10][0]).Time))
+	_, offset := datum.AsTimestampTZ(rows[10][0]).Zone()
+	require.Equal(t, -7*3600, offset)
+	months, days, seconds := datum.AsInterval(rows[11][0]).Parts()
+	require.Equal(t, []int64{1, 2, 3}, []int64{months, days, seconds})
+}
+
+func TestInsertExec_UniqueIndex(t *testing.T) {
+	db := newTestDB(t, nil)
+	sess1 := newTestSession(t, db)
+	sess2 := newTestSession(t, db)
+	sess1.mustExec("CREATE GRAPH g")
+	sess1.mustExec("USE g")
+	sess1.mustExec("CREATE LABEL Person")
+	sess1.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Kathrine', x.email = 'kathrine@example.com')")
+	sess1.mustExec("CREATE UNIQUE INDEX idx_email ON Person (email)")
+	sess2.mustExec("USE g")
+
+	id := datum.AsInt(sess1.mustExec("SELECT ID(x) FROM MATCH (x:Person)")[0][0])
+
+	_, err := sess1.exec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Lee', x.email = 'kathrine@example.com')")
+	var dupErr *meta.ErrDuplicateKey
+	require.ErrorAs(t, err, &dupErr)
+	require.Equal(t, &meta.ErrDuplicateKey{
+		Index:      "idx_email",
+		Label:      "Person",
+		Properties: []string{"email"},
+		VertexID:   id,
+	}, dupErr)
+
+	// The same key inserted by the concurrent transactions is detected when
+	// the latter one commits.
+	sess1.mustExec("BEGIN")
+	sess2.mustExec("BEGIN")
+	sess1.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Riya', x.email = 'riya@example.com')")
+	sess2.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Jane', x.email = 'riya@example.com')")
+	sess1.mustExec("COMMIT")
+	_, err = sess2.exec("COMMIT")
+	require.ErrorAs(t, err, &dupErr)
+	require.Equal(t, "idx_email", dupErr.Index)
+	require.NotEqual(t, id, dupErr.VertexID)
+}
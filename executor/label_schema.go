@@ -0,0 +1,60 @@
+// ---
+
+package executor
+
+import (
+	"github.com/cockroachdb/apd/v3"
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+// fillDefaults sets the properties missing from the element to the default
+// values declared by the schemas of its labels.
+func fillDefaults(labels []*catalog.Label, props map[string]datum.Datum) error {
+	for _, label := range labels {
+		for _, prop := range label.Meta().Properties {
+			if _, ok := props[prop.Name.L]; ok || prop.Default == nil {
+				continue
+			}
+			value, err := codec.DecodeValue(prop.Default)
+			if err != nil {
+				return err
+			}
+			props[prop.Name.L] = value
+		}
+	}
+	return nil
+}
+
+// checkLabelSchemas checks the properties of the element against the schemas of
+// its labels. The integers assigned to the FLOAT and DECIMAL properties are
+// converted in place.
+func checkLabelSchemas(labels []*catalog.Label, props map[string]datum.Datum) error {
+	for _, label := range labels {
+		for _, prop := range label.Meta().Properties {
+			value, ok := props[prop.Name.L]
+			if !ok || value == datum.Null {
+				if prop.NotNull {
+					return errors.Errorf("property %s of label %s cannot be NULL", prop.Name, label.Meta().Name)
+				}
+				continue
+			}
+			if value.Type() == prop.Type {
+				continue
+			}
+			switch {
+			case value.Type() == types.Int && prop.Type == types.Float:
+				props[prop.Name.L] = datum.NewFloat(float64(datum.AsInt(value)))
+			case value.Type() == types.Int && prop.Type == types.Decimal:
+				props[prop.Name.L] = datum.NewDecimal(apd.New(datum.AsInt(value), 0))
+			default:
+				return errors.Errorf("property %s of label %s expects type %s, but got %s",
+					prop.Name, label.Meta().Name, prop.Type, value.Type())
+			}
+		}
+	}
+	return nil
+}
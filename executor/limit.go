@@ -0,0 +1,81 @@
+// ---
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+)
+
+// LimitExec represents a limit executor which skips the first offset rows and
+// outputs count rows at most.
+type LimitExec struct {
+	baseExecutor
+
+	offsetExpr expression.Expression
+	countExpr  expression.Expression
+
+	offset   int64
+	count    int64
+	skipped  int64
+	returned int64
+}
+
+func (e *LimitExec) Open(ctx context.Context) error {
+	offset, count, err := evalLimit(e.sc, e.offsetExpr, e.countExpr)
+	if err != nil {
+		return err
+	}
+	e.offset, e.count = offset, count
+	return e.baseExecutor.Open(ctx)
+}
+
+func (e *LimitExec) Next(ctx context.Context) (datum.Row, error) {
+	for e.skipped < e.offset {
+		row, err := e.children[0].Next(ctx)
+		if err != nil || row == nil {
+			return nil, err
+		}
+		e.skipped++
+	}
+	if e.returned >= e.count {
+		return nil, nil
+	}
+	row, err := e.children[0].Next(ctx)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	e.returned++
+	return row, nil
+}
+
+// evalLimit evaluates the OFFSET and LIMIT expressions. The offset is zero if
+// the OFFSET is not specified.
+func evalLimit(sc *stmtctx.Context, offsetExpr, countExpr expression.Expression) (offset, count int64, err error) {
+	eval := func(expr expression.Expression) (int64, error) {
+		d, err := expr.Eval(sc, nil)
+		if err != nil {
+			return 0, err
+		}
+		v, err := datum.TryAsInt(d)
+		if err != nil || v < 0 {
+			return 0, errors.Errorf("invalid LIMIT/OFFSET value: %s", d)
+		}
+		return v, nil
+	}
+	if offsetExpr != nil {
+		offset, err = eval(offsetExpr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	count, err = eval(countExpr)
+	if err != nil {
+		return 0, 0, err
+	}
+	return offset, count, nil
+}
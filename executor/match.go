@@ -10,6 +10,7 @@ import (
 	"github.com/simbiont-runtime/graphengine/catalog"
 	"github.com/simbiont-runtime/graphengine/codec"
 	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/expression"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
 	"github.com/simbiont-runtime/graphengine/parser/model"
 	"github.com/simbiont-runtime/graphengine/planner"
@@ -17,270 +18,714 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// MatchExec matches the subgraph by a depth-first search, which is resumable so
+// that the matches are produced lazily. The search follows the expansion plan,
+// and each step of the plan binds one or more variables to the candidates. The
+// steps being searched are kept in a stack, and the search is resumed from the
+// top step when the next row is requested.
 type MatchExec struct {
 	baseExecutor
 
-	subgraph *planner.Subgraph
+	subgraph     *planner.Subgraph
+	indexLookups map[string]*planner.IndexLookup
+	bindings     map[string]*expression.CorrelatedColumn
+	order        []*planner.MatchStep
+	properties   map[string][]string
 
 	prepared bool
-	matched  map[string]datum.Datum
-	results  []datum.Row
-	txn      kv.Transaction
+	// decodeProps are the properties to be decoded for the variables, see
+	// planner.PhysicalMatch.Properties.
+	decodeProps map[string][]*model.PropertyInfo
+	matched     map[string]datum.Datum
+	steps       []*matchStep
+	// matchNumber is the number of the last match, see planner.MatchNumberColumnName.
+	matchNumber int64
+	txn         kv.Transaction
+	// kvStats counts the KV accesses if it is not nil (EXPLAIN ANALYZE).
+	kvStats *kvStats
+}
+
+// matchBinding binds a variable to a vertex, an edge or a list of them.
+type matchBinding struct {
+	name  string
+	value datum.Datum
+}
+
+// candidateIter iterates the candidates of a search step.
+type candidateIter interface {
+	// next returns the bindings of the next candidate, and ok is false if there
+	// are no more candidates.
+	next(ctx context.Context) (bindings []matchBinding, ok bool, err error)
+	close()
+}
+
+// matchStep is a step of the search, and bound is the bindings of its current
+// candidate, which are unbound before moving to the next candidate.
+type matchStep struct {
+	iter  candidateIter
+	bound []matchBinding
 }
 
 func (m *MatchExec) Next(ctx context.Context) (datum.Row, error) {
 	if !m.prepared {
-		if err := m.prepare(ctx); err != nil {
+		if err := m.prepare(); err != nil {
 			return nil, err
 		}
 		m.prepared = true
 	}
-	if len(m.results) == 0 {
-		return nil, nil
+	for len(m.steps) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		step := m.steps[len(m.steps)-1]
+		for _, b := range step.bound {
+			delete(m.matched, b.name)
+		}
+		step.bound = nil
+
+		bindings, ok, err := step.iter.next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			step.iter.close()
+			m.steps = m.steps[:len(m.steps)-1]
+			continue
+		}
+		for _, b := range bindings {
+			m.matched[b.name] = b.value
+		}
+		step.bound = bindings
+		// The root step has no filters, and the i-th step of the stack is the
+		// (i-1)-th step of the plan.
+		if depth := len(m.steps) - 1; depth > 0 {
+			ok, err := m.evalFilters(m.order[depth-1].Filters)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		if m.isMatched() {
+			return m.resultRow(), nil
+		}
+
+		iter, err := m.nextStep(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if iter != nil {
+			m.steps = append(m.steps, &matchStep{iter: iter})
+		}
 	}
-	row := m.results[0]
-	m.results = m.results[1:]
-	return row, nil
+	return nil, nil
 }
 
-func (m *MatchExec) prepare(ctx context.Context) error {
+func (m *MatchExec) prepare() error {
 	m.matched = make(map[string]datum.Datum)
+	m.matchNumber = 0
+
+	// The properties are resolved at execution since the plan may be cached, and
+	// the properties which don't exist are never decoded.
+	graph := m.sc.CurrentGraph()
+	m.decodeProps = make(map[string][]*model.PropertyInfo, len(m.properties))
+	for name, propNames := range m.properties {
+		props := make([]*model.PropertyInfo, 0, len(propNames))
+		for _, propName := range propNames {
+			if prop := graph.Property(propName); prop != nil {
+				props = append(props, prop)
+			}
+		}
+		m.decodeProps[name] = props
+	}
 
-	txn, err := m.sc.Store().Begin()
+	txn, err := m.sc.Txn().Activate()
 	if err != nil {
 		return err
 	}
 	m.txn = txn
+	if m.kvStats != nil {
+		m.txn = &countingTxn{Transaction: txn, stats: m.kvStats}
+	}
 
-	return m.search(ctx)
+	// The bound vertices are matched before searching, and nothing is matched if
+	// any of them doesn't match the vertex pattern.
+	for name, binding := range m.bindings {
+		d, err := binding.Eval(m.sc, nil)
+		if err != nil {
+			return err
+		}
+		vertexVar, ok := d.(*datum.Vertex)
+		if !ok || !matchLabels(vertexVar.Labels, m.subgraph.Vertices[name].Labels) {
+			return nil
+		}
+		m.matched[name] = vertexVar
+	}
+
+	// The search starts from an empty step, which has only one candidate binding
+	// nothing, and then the i-th step of the stack searches the candidates of the
+	// i-th step of the plan.
+	m.steps = append(m.steps, &matchStep{iter: &vertexIDCandidates{vertexIDs: []int64{-1}}})
+	return nil
 }
 
-// search performs a depth-first search on the graph.
-func (m *MatchExec) search(ctx context.Context) error {
-	// Enumerate all possible connections to vertices that have not been visited.
-	for _, conn := range m.subgraph.Connections {
-		edge, ok := conn.(*planner.Edge)
-		if !ok {
-			return errors.Errorf("variable-length path is not supported yet")
+// nextStep returns the candidates of the next step of the expansion plan.
+func (m *MatchExec) nextStep(ctx context.Context) (candidateIter, error) {
+	depth := len(m.steps) - 1
+	if depth >= len(m.order) {
+		return nil, nil
+	}
+	step := m.order[depth]
+	if vertex := step.Vertex; vertex != nil {
+		if lookup, ok := m.indexLookups[vertex.Name.L]; ok {
+			return m.lookupVertices(ctx, vertex, lookup)
 		}
-		if _, ok := m.matched[edge.Name().L]; ok {
-			continue
+		return m.scanVertices(vertex)
+	}
+
+	var edge *planner.Edge
+	switch c := step.Conn.(type) {
+	case *planner.Edge:
+		edge = c
+	case *planner.VariableLengthPath:
+		return m.newPathFinder(c)
+	default:
+		return nil, errors.Errorf("unsupported connection type %T", c)
+	}
+
+	srcVar, srcVisited := m.matched[edge.SrcVarName().L]
+	dstVar, dstVisited := m.matched[edge.DstVarName().L]
+	switch {
+	case srcVisited && dstVisited:
+		return m.newEdgeCandidates(edge, srcVar.(*datum.Vertex).ID, dstVar.(*datum.Vertex).ID)
+	case srcVisited:
+		dstVertex := m.subgraph.Vertices[edge.DstVarName().L]
+		iter, err := m.newAdjacencyIter(edge, srcVar.(*datum.Vertex).ID, dstVertex, ast.EdgeDirectionOutgoing, m.propertiesOf(edge.Name().L), m.propertiesOf(dstVertex.Name.L))
+		if err != nil {
+			return nil, err
 		}
-		_, srcVisited := m.matched[edge.SrcVarName().L]
-		_, dstVisited := m.matched[edge.DstVarName().L]
-		if !srcVisited && !dstVisited {
-			continue
+		return &adjacencyCandidates{iter: iter, edgeName: edge.Name().L, endName: edge.DstVarName().L}, nil
+	default:
+		srcVertex := m.subgraph.Vertices[edge.SrcVarName().L]
+		iter, err := m.newAdjacencyIter(edge, dstVar.(*datum.Vertex).ID, srcVertex, ast.EdgeDirectionIncoming, m.propertiesOf(edge.Name().L), m.propertiesOf(srcVertex.Name.L))
+		if err != nil {
+			return nil, err
 		}
+		return &adjacencyCandidates{iter: iter, edgeName: edge.Name().L, endName: edge.SrcVarName().L}, nil
+	}
+}
 
-		if srcVisited && dstVisited {
-			srcVertexID := m.matched[edge.SrcVarName().L].(*datum.Vertex).ID
-			dstVertexID := m.matched[edge.DstVarName().L].(*datum.Vertex).ID
-			edgeVar, err := m.matchEdge(ctx, edge, srcVertexID, dstVertexID)
-			if err != nil {
-				return err
-			}
-			if edgeVar == nil {
-				return nil
-			}
-			return m.stepEdge(ctx, edge, edgeVar)
-		}
-
-		if !dstVisited {
-			srcID := m.matched[edge.SrcVarName().L].(*datum.Vertex).ID
-			dstVertex := m.subgraph.Vertices[edge.DstVarName().L]
-			return m.iterEdge(
-				ctx,
-				edge,
-				srcID,
-				dstVertex,
-				ast.EdgeDirectionOutgoing,
-				func(edgeVar *datum.Edge, endVar *datum.Vertex) error {
-					m.matched[edge.DstVarName().L] = endVar
-					defer func() {
-						delete(m.matched, edge.DstVarName().L)
-					}()
-					return m.stepEdge(ctx, edge, edgeVar)
-				},
-			)
-		}
-
-		if !srcVisited {
-			dstID := m.matched[edge.DstVarName().L].(*datum.Vertex).ID
-			srcVertex := m.subgraph.Vertices[edge.SrcVarName().L]
-			return m.iterEdge(
-				ctx,
-				edge,
-				dstID,
-				srcVertex,
-				ast.EdgeDirectionIncoming,
-				func(edgeVar *datum.Edge, endVar *datum.Vertex) error {
-					m.matched[edge.SrcVarName().L] = endVar
-					defer func() {
-						delete(m.matched, edge.SrcVarName().L)
-					}()
-					return m.stepEdge(ctx, edge, edgeVar)
-				},
-			)
-		}
-	}
-
-	// The subgraph is disconnected, so we need to find a new start vertex.
-	for _, vertex := range m.subgraph.Vertices {
-		_, visited := m.matched[vertex.Name.L]
-		if visited {
-			continue
+func (m *MatchExec) isMatched() bool {
+	for name := range m.subgraph.Vertices {
+		if _, ok := m.matched[name]; !ok {
+			return false
+		}
+	}
+	for name := range m.subgraph.Connections {
+		if _, ok := m.matched[name]; !ok {
+			return false
 		}
-		return m.iterVertex(vertex, func(vertexVar *datum.Vertex) error {
-			return m.stepVertex(ctx, vertex, vertexVar)
-		})
 	}
+	return true
+}
 
-	return nil
+// evalFilters reports whether the current candidate satisfies all filters.
+func (m *MatchExec) evalFilters(filters []expression.Expression) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	row := m.currentRow()
+	for _, filter := range filters {
+		d, err := filter.Eval(m.sc, row)
+		if err != nil {
+			return false, err
+		}
+		if d == datum.Null || !datum.AsBool(d) {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
-func (m *MatchExec) stepVertex(ctx context.Context, vertex *planner.Vertex, vertexVar *datum.Vertex) error {
-	m.matched[vertex.Name.L] = vertexVar
-	defer func() {
-		delete(m.matched, vertex.Name.L)
-	}()
-	if m.isMatched() {
-		m.appendResult()
-		return nil
+func (m *MatchExec) resultRow() datum.Row {
+	row := m.currentRow()
+	m.matchNumber++
+	row[len(row)-1] = datum.NewInt(m.matchNumber)
+	return row
+}
+
+// currentRow returns the row of the matched variables, and the variables which
+// have not been matched are NULL. The match number is NULL until the match is
+// found.
+func (m *MatchExec) currentRow() datum.Row {
+	result := make(datum.Row, 0, len(m.subgraph.SingletonVars)+len(m.subgraph.GroupVars)+1)
+	for _, singletonVar := range m.subgraph.SingletonVars {
+		d, ok := m.matched[singletonVar.Name.L]
+		if !ok {
+			d = datum.Null
+		}
+		result = append(result, d)
+	}
+	for _, groupVar := range m.subgraph.GroupVars {
+		d, ok := m.matched[groupVar.Name.L]
+		if !ok {
+			d = datum.Null
+		}
+		result = append(result, d)
 	}
-	return m.search(ctx)
+	return append(result, datum.Null)
 }
 
-func (m *MatchExec) stepEdge(ctx context.Context, edge *planner.Edge, edgeVar *datum.Edge) error {
-	m.matched[edge.Name().L] = edgeVar
-	defer func() {
-		delete(m.matched, edge.Name().L)
-	}()
-	if m.isMatched() {
-		m.appendResult()
-		return nil
+// scanVertices returns the vertices which match the vertex pattern. The vertices
+// are found by the label keys if the pattern has labels, otherwise all vertices
+// of the graph are scanned.
+func (m *MatchExec) scanVertices(vertex *planner.Vertex) (candidateIter, error) {
+	graphID := m.sc.CurrentGraph().Meta().ID
+	switch len(vertex.Labels) {
+	case 0:
+		iter, err := m.txn.Iter(codec.VertexKey(graphID, 0), codec.VertexKey(graphID, math.MaxInt64))
+		if err != nil {
+			return nil, err
+		}
+		return &vertexKeyCandidates{name: vertex.Name.L, iter: iter, decode: m.decodeVertexKey(vertex)}, nil
+	case 1:
+		prefix := kv.Key(codec.VertexLabelPrefix(graphID, vertex.Labels[0].Meta().ID))
+		iter, err := m.txn.Iter(prefix, prefix.PrefixNext())
+		if err != nil {
+			return nil, err
+		}
+		return &vertexKeyCandidates{name: vertex.Name.L, iter: iter, decode: m.matchVertexKey(vertex, parseVertexLabelKey)}, nil
+	}
+
+	// The vertex attached to multiple labels is visited only once, and the vertices
+	// are visited in the order of ID as the full scan does.
+	var vertexIDs []int64
+	for _, label := range vertex.Labels {
+		ids, err := m.scanVertexIDs(codec.VertexLabelPrefix(graphID, label.Meta().ID), parseVertexLabelKey)
+		if err != nil {
+			return nil, err
+		}
+		vertexIDs = append(vertexIDs, ids...)
 	}
-	return m.search(ctx)
+	slices.Sort(vertexIDs)
+	vertexIDs = slices.Compact(vertexIDs)
+	return &vertexIDCandidates{m: m, vertex: vertex, vertexIDs: vertexIDs}, nil
 }
 
-func (m *MatchExec) isMatched() bool {
-	return len(m.matched) == len(m.subgraph.Vertices)+len(m.subgraph.Connections)
+// lookupVertices returns the vertices whose indexed properties equal to the
+// values of index lookup.
+func (m *MatchExec) lookupVertices(ctx context.Context, vertex *planner.Vertex, lookup *planner.IndexLookup) (candidateIter, error) {
+	values := make([]datum.Datum, 0, len(lookup.Values))
+	for _, expr := range lookup.Values {
+		value, err := expr.Eval(m.sc, nil)
+		if err != nil {
+			return nil, err
+		}
+		// The value of bind variable is unknown when the index lookup is chosen.
+		// No vertex equals to NULL, and the vertices are scanned if the value
+		// cannot be looked up by index.
+		if value == datum.Null {
+			return &vertexIDCandidates{}, nil
+		}
+		if !codec.IndexLookupSupported(value.Type()) {
+			return m.scanVertices(vertex)
+		}
+		values = append(values, value)
+	}
+	graphID := m.sc.CurrentGraph().Meta().ID
+	ok, err := m.indexValuesComparable(graphID, lookup.Index.Meta().ID, values)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return m.scanVertices(vertex)
+	}
+	encoded, err := codec.EncodeIndexValues(nil, values)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unique index key has the only vertex ID as the value.
+	if lookup.Index.Meta().Unique {
+		val, err := m.txn.Get(ctx, codec.UniqueIndexKey(graphID, lookup.Index.Meta().ID, codec.VertexIndexType, encoded))
+		if kv.IsErrNotFound(err) {
+			return &vertexIDCandidates{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		_, vertexID, err := codec.DecodeInt(val)
+		if err != nil {
+			return nil, err
+		}
+		return &vertexIDCandidates{m: m, vertex: vertex, vertexIDs: []int64{vertexID}}, nil
+	}
+	prefix := kv.Key(codec.IndexValuesPrefix(graphID, lookup.Index.Meta().ID, codec.VertexIndexType, encoded))
+	iter, err := m.txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return nil, err
+	}
+	return &vertexKeyCandidates{name: vertex.Name.L, iter: iter, decode: m.matchVertexKey(vertex, parseVertexIndexKey)}, nil
 }
 
-func (m *MatchExec) appendResult() {
-	result := make(datum.Row, 0, len(m.subgraph.SingletonVars))
-	for _, singletonVar := range m.subgraph.SingletonVars {
-		d := m.matched[singletonVar.Name.L]
-		result = append(result, d)
+// indexValuesComparable reports whether the values in the index have the same
+// classes as the values of index lookup, i.e. the indexed properties of all the
+// vertices can be compared with the looked up values. The condition fails to
+// compare the values of different classes, e.g. an integer and a string, which
+// the index lookup skips silently, so the vertices are scanned and filtered if
+// the index has such values. The value after the first one is checked in the
+// keys with the same preceding values.
+func (m *MatchExec) indexValuesComparable(graphID, indexID int64, values []datum.Datum) (bool, error) {
+	var encoded []byte
+	for _, value := range values {
+		prefix := kv.Key(codec.IndexValuesPrefix(graphID, indexID, codec.VertexIndexType, encoded))
+		classPrefix := append(prefix.Clone(), codec.IndexValueClass(value)...)
+		// The keys of the other classes are before or after the keys of the class.
+		for _, r := range [][2]kv.Key{{prefix, classPrefix}, {classPrefix.PrefixNext(), prefix.PrefixNext()}} {
+			exists, err := m.keyExists(r[0], r[1])
+			if err != nil || exists {
+				return false, err
+			}
+		}
+		var err error
+		encoded, err = codec.EncodeIndexValues(encoded, []datum.Datum{value})
+		if err != nil {
+			return false, err
+		}
 	}
-	m.results = append(m.results, result)
+	return true, nil
 }
 
-func (m *MatchExec) iterVertex(vertex *planner.Vertex, f func(vertexVar *datum.Vertex) error) error {
-	graph := m.sc.CurrentGraph()
-	lower := codec.VertexKey(graph.Meta().ID, 0)
-	upper := codec.VertexKey(graph.Meta().ID, math.MaxInt64)
+// keyExists reports whether any key is in the range [lower, upper).
+func (m *MatchExec) keyExists(lower, upper kv.Key) (bool, error) {
 	iter, err := m.txn.Iter(lower, upper)
 	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
 
-		return err
+// scanVertexIDs returns the vertex IDs parsed from the keys with the prefix.
+func (m *MatchExec) scanVertexIDs(prefix kv.Key, parse func(key kv.Key) (int64, error)) ([]int64, error) {
+	iter, err := m.txn.Iter(prefix, prefix.PrefixNext())
+	if err != nil {
+		return nil, err
 	}
 	defer iter.Close()
 
+	var vertexIDs []int64
 	for ; err == nil && iter.Valid(); err = iter.Next() {
+		vertexID, err := parse(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		vertexIDs = append(vertexIDs, vertexID)
+	}
+	return vertexIDs, err
+}
+
+func parseVertexLabelKey(key kv.Key) (int64, error) {
+	_, _, vertexID, err := codec.ParseVertexLabelKey(key)
+	return vertexID, err
+}
+
+func parseVertexIndexKey(key kv.Key) (int64, error) {
+	_, _, _, vertexID, err := codec.ParseVertexNonUniqueIndexKey(key)
+	return vertexID, err
+}
+
+// decodeVertexKey returns the decode function of vertexKeyCandidates, which
+// decodes the vertex from the vertex key and value, and returns nil for the other
+// keys in the range of vertex keys.
+func (m *MatchExec) decodeVertexKey(vertex *planner.Vertex) func(context.Context, kv.Key, []byte) (*datum.Vertex, error) {
+	props := m.propertiesOf(vertex.Name.L)
+	return func(_ context.Context, key kv.Key, val []byte) (*datum.Vertex, error) {
 		// TODO: better way to skip edge keys
-		if len(iter.Key()) != codec.VertexKeyLen {
-			continue
+		if len(key) != codec.VertexKeyLen {
+			return nil, nil
 		}
-		_, vertexID, err := codec.ParseVertexKey(iter.Key())
+		_, vertexID, err := codec.ParseVertexKey(key)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		vertexVar := &datum.Vertex{ID: vertexID}
+		if err := m.decodeVertexValue(val, vertexVar, props); err != nil {
+			return nil, err
+		}
+		return vertexVar, nil
+	}
+}
 
-		vertexVar := &datum.Vertex{
-			ID: vertexID,
+// matchVertexKey returns the decode function of vertexKeyCandidates, which fetches
+// the vertex whose ID is parsed from the key and matches the vertex pattern.
+func (m *MatchExec) matchVertexKey(vertex *planner.Vertex, parse func(key kv.Key) (int64, error)) func(context.Context, kv.Key, []byte) (*datum.Vertex, error) {
+	props := m.propertiesOf(vertex.Name.L)
+	return func(ctx context.Context, key kv.Key, _ []byte) (*datum.Vertex, error) {
+		vertexID, err := parse(key)
+		if err != nil {
+			return nil, err
 		}
-		if err := m.decodeVertexValue(iter.Value(), vertexVar); err != nil {
-			return err
+		return m.matchVertex(ctx, vertex, vertexID, props)
+	}
+}
+
+// vertexKeyCandidates binds the vertex to the vertices decoded from the keys of
+// the iterator. The keys which are decoded to nil are skipped.
+type vertexKeyCandidates struct {
+	name   string
+	iter   kv.Iterator
+	decode func(ctx context.Context, key kv.Key, val []byte) (*datum.Vertex, error)
+}
+
+func (c *vertexKeyCandidates) next(ctx context.Context) ([]matchBinding, bool, error) {
+	for c.iter.Valid() {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
 		}
-		if !matchLabels(vertexVar.Labels, vertex.Labels) {
-			continue
+		vertexVar, err := c.decode(ctx, c.iter.Key(), c.iter.Value())
+		if err != nil {
+			return nil, false, err
+		}
+		if err := c.iter.Next(); err != nil {
+			return nil, false, err
+		}
+		if vertexVar != nil {
+			return []matchBinding{{name: c.name, value: vertexVar}}, true, nil
 		}
+	}
+	return nil, false, nil
+}
 
-		// Check if the vertex matches the label requirements.
-		if len(vertex.Labels) > 0 {
-			if !slices.ContainsFunc(vertexVar.Labels, func(labelName string) bool {
-				return slices.ContainsFunc(vertex.Labels, func(label *catalog.Label) bool {
-					return label.Meta().Name.L == labelName
-				})
-			}) {
-				continue
-			}
+func (c *vertexKeyCandidates) close() {
+	c.iter.Close()
+}
+
+// vertexIDCandidates binds the vertex to the vertices with the IDs. If the vertex
+// pattern is nil, each ID is a candidate which binds nothing.
+type vertexIDCandidates struct {
+	m         *MatchExec
+	vertex    *planner.Vertex
+	vertexIDs []int64
+}
+
+func (c *vertexIDCandidates) next(ctx context.Context) ([]matchBinding, bool, error) {
+	for len(c.vertexIDs) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
 		}
-		if err := f(vertexVar); err != nil {
-			return err
+		vertexID := c.vertexIDs[0]
+		c.vertexIDs = c.vertexIDs[1:]
+		if c.vertex == nil {
+			return nil, true, nil
+		}
+		vertexVar, err := c.m.matchVertex(ctx, c.vertex, vertexID, c.m.propertiesOf(c.vertex.Name.L))
+		if err != nil {
+			return nil, false, err
+		}
+		if vertexVar != nil {
+			return []matchBinding{{name: c.vertex.Name.L, value: vertexVar}}, true, nil
 		}
 	}
-	return nil
+	return nil, false, nil
 }
 
-func (m *MatchExec) iterEdge(
-	ctx context.Context,
+func (c *vertexIDCandidates) close() {}
+
+// adjacencyIter iterates the edges of the start vertex in the direction, whose
+// end vertices match the vertex pattern.
+type adjacencyIter struct {
+	m         *MatchExec
+	edge      *planner.Edge
+	startID   int64
+	end       *planner.Vertex
+	direction ast.EdgeDirection
+	iter      kv.Iterator
+	// edgeProps and endProps are the properties to be decoded for the edges and
+	// end vertices, which are all decoded if nil.
+	edgeProps []*model.PropertyInfo
+	endProps  []*model.PropertyInfo
+}
+
+func (m *MatchExec) newAdjacencyIter(
 	edge *planner.Edge,
 	startID int64,
-	endVertex *planner.Vertex,
+	end *planner.Vertex,
 	direction ast.EdgeDirection,
-	f func(edgeVar *datum.Edge, endVar *datum.Vertex) error,
-) error {
+	edgeProps, endProps []*model.PropertyInfo,
+) (*adjacencyIter, error) {
 	graph := m.sc.CurrentGraph()
 	var lower, upper []byte
 	if direction == ast.EdgeDirectionOutgoing {
-		lower = codec.OutgoingEdgeKey(graph.Meta().ID, startID, 0)
-		upper = codec.OutgoingEdgeKey(graph.Meta().ID, startID, math.MaxInt64)
+		lower = codec.OutgoingEdgeKey(graph.Meta().ID, startID, 0, 0)
+		upper = codec.OutgoingEdgeKey(graph.Meta().ID, startID, math.MaxInt64, math.MaxInt64)
 	} else {
-		lower = codec.IncomingEdgeKey(graph.Meta().ID, 0, startID)
-		upper = codec.IncomingEdgeKey(graph.Meta().ID, math.MaxInt64, startID)
+		lower = codec.IncomingEdgeKey(graph.Meta().ID, 0, startID, 0)
+		upper = codec.IncomingEdgeKey(graph.Meta().ID, math.MaxInt64, startID, math.MaxInt64)
 	}
 	iter, err := m.txn.Iter(lower, upper)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer iter.Close()
-
-	for ; err == nil && iter.Valid(); err = iter.Next() {
-		var endVertexID int64
-		if direction == ast.EdgeDirectionOutgoing {
-			_, _, endVertexID, err = codec.ParseOutgoingEdgeKey(iter.Key())
-		} else {
-			_, endVertexID, _, err = codec.ParseIncomingEdgeKey(iter.Key())
-		}
+	return &adjacencyIter{
+		m:         m,
+		edge:      edge,
+		startID:   startID,
+		end:       end,
+		direction: direction,
+		iter:      iter,
+		edgeProps: edgeProps,
+		endProps:  endProps,
+	}, nil
+}
 
-		edgeVar := &datum.Edge{}
-		if err := m.decodeEdgeValue(iter.Value(), edgeVar); err != nil {
-			return err
+// next returns the next edge and its end vertex, and the edge is nil if there are
+// no more edges.
+func (it *adjacencyIter) next(ctx context.Context) (*datum.Edge, *datum.Vertex, error) {
+	for it.iter.Valid() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
 		}
+		edgeVar, endVar, err := it.current(ctx)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
-		if !matchLabels(edgeVar.Labels, edge.Labels) {
-			continue
+		if err := it.iter.Next(); err != nil {
+			return nil, nil, err
 		}
+		if edgeVar != nil {
+			return edgeVar, endVar, nil
+		}
+	}
+	return nil, nil, nil
+}
 
-		endVar, err := m.matchVertex(ctx, endVertex, endVertexID)
+// current returns the edge at the current key and its end vertex, or nil if they
+// don't match the patterns.
+func (it *adjacencyIter) current(ctx context.Context) (*datum.Edge, *datum.Vertex, error) {
+	var (
+		endVertexID, edgeID int64
+		err                 error
+	)
+	if it.direction == ast.EdgeDirectionOutgoing {
+		_, _, endVertexID, edgeID, err = codec.ParseOutgoingEdgeKey(it.iter.Key())
+	} else {
+		_, endVertexID, _, edgeID, err = codec.ParseIncomingEdgeKey(it.iter.Key())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	edgeVar := &datum.Edge{ID: edgeID}
+	if it.direction == ast.EdgeDirectionOutgoing {
+		edgeVar.SrcID, edgeVar.DstID = it.startID, endVertexID
+	} else {
+		edgeVar.SrcID, edgeVar.DstID = endVertexID, it.startID
+	}
+	if err := it.m.decodeEdgeValue(it.iter.Value(), edgeVar, it.edgeProps); err != nil {
+		return nil, nil, err
+	}
+	if !matchLabels(edgeVar.Labels, it.edge.Labels) {
+		return nil, nil, nil
+	}
+
+	endVar, err := it.m.matchVertex(ctx, it.end, endVertexID, it.endProps)
+	if err != nil || endVar == nil {
+		return nil, nil, err
+	}
+	return edgeVar, endVar, nil
+}
+
+func (it *adjacencyIter) close() {
+	it.iter.Close()
+}
+
+// adjacencyCandidates binds the edge and its end vertex to the edges of a matched
+// vertex.
+type adjacencyCandidates struct {
+	iter     *adjacencyIter
+	edgeName string
+	endName  string
+}
+
+func (c *adjacencyCandidates) next(ctx context.Context) ([]matchBinding, bool, error) {
+	edgeVar, endVar, err := c.iter.next(ctx)
+	if err != nil || edgeVar == nil {
+		return nil, false, err
+	}
+	return []matchBinding{
+		{name: c.edgeName, value: edgeVar},
+		{name: c.endName, value: endVar},
+	}, true, nil
+}
+
+func (c *adjacencyCandidates) close() {
+	c.iter.close()
+}
+
+// edgeCandidates binds the edge to the edges from the source vertex to the
+// destination vertex. There can be multiple edges between the same pair of
+// vertices.
+type edgeCandidates struct {
+	m           *MatchExec
+	edge        *planner.Edge
+	srcVertexID int64
+	dstVertexID int64
+	iter        kv.Iterator
+}
+
+func (m *MatchExec) newEdgeCandidates(edge *planner.Edge, srcVertexID, dstVertexID int64) (*edgeCandidates, error) {
+	graph := m.sc.CurrentGraph()
+	lower := codec.OutgoingEdgeKey(graph.Meta().ID, srcVertexID, dstVertexID, 0)
+	upper := codec.OutgoingEdgeKey(graph.Meta().ID, srcVertexID, dstVertexID, math.MaxInt64)
+	iter, err := m.txn.Iter(lower, upper)
+	if err != nil {
+		return nil, err
+	}
+	return &edgeCandidates{
+		m:           m,
+		edge:        edge,
+		srcVertexID: srcVertexID,
+		dstVertexID: dstVertexID,
+		iter:        iter,
+	}, nil
+}
+
+func (c *edgeCandidates) next(ctx context.Context) ([]matchBinding, bool, error) {
+	for c.iter.Valid() {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+		_, _, _, edgeID, err := codec.ParseOutgoingEdgeKey(c.iter.Key())
 		if err != nil {
-			return err
+			return nil, false, err
 		}
-		if endVar == nil {
-			continue
+		edgeVar := &datum.Edge{
+			ID:    edgeID,
+			SrcID: c.srcVertexID,
+			DstID: c.dstVertexID,
 		}
-
-		if err := f(edgeVar, endVar); err != nil {
-			return err
+		if err := c.m.decodeEdgeValue(c.iter.Value(), edgeVar, c.m.propertiesOf(c.edge.Name().L)); err != nil {
+			return nil, false, err
+		}
+		if err := c.iter.Next(); err != nil {
+			return nil, false, err
+		}
+		if matchLabels(edgeVar.Labels, c.edge.Labels) {
+			return []matchBinding{{name: c.edge.Name().L, value: edgeVar}}, true, nil
 		}
 	}
-	return nil
+	return nil, false, nil
 }
 
-func (m *MatchExec) matchVertex(ctx context.Context, vertex *planner.Vertex, vertexID int64) (*datum.Vertex, error) {
+func (c *edgeCandidates) close() {
+	c.iter.Close()
+}
+
+// matchVertex fetches the vertex and decodes the properties, and returns nil if
+// the vertex doesn't exist or match the vertex pattern.
+func (m *MatchExec) matchVertex(ctx context.Context, vertex *planner.Vertex, vertexID int64, props []*model.PropertyInfo) (*datum.Vertex, error) {
 	graph := m.sc.CurrentGraph()
 	key := codec.VertexKey(graph.Meta().ID, vertexID)
 	val, err := m.txn.Get(ctx, key)
@@ -293,7 +738,7 @@ func (m *MatchExec) matchVertex(ctx context.Context, vertex *planner.Vertex, ver
 	vertexVar := &datum.Vertex{
 		ID: vertexID,
 	}
-	if err := m.decodeVertexValue(val, vertexVar); err != nil {
+	if err := m.decodeVertexValue(val, vertexVar, props); err != nil {
 		return nil, err
 	}
 	if !matchLabels(vertexVar.Labels, vertex.Labels) {
@@ -302,29 +747,6 @@ func (m *MatchExec) matchVertex(ctx context.Context, vertex *planner.Vertex, ver
 	return vertexVar, nil
 }
 
-func (m *MatchExec) matchEdge(ctx context.Context, edge *planner.Edge, srcVertexID, dstVertexID int64) (*datum.Edge, error) {
-	graph := m.sc.CurrentGraph()
-	edgeKey := codec.OutgoingEdgeKey(graph.Meta().ID, srcVertexID, dstVertexID)
-	val, err := m.txn.Get(ctx, edgeKey)
-	if err != nil {
-		if errors.ErrorEqual(err, kv.ErrNotExist) {
-			return nil, nil
-		}
-		return nil, err
-	}
-	edgeVar := &datum.Edge{
-		SrcID: srcVertexID,
-		DstID: dstVertexID,
-	}
-	if err := m.decodeEdgeValue(val, edgeVar); err != nil {
-		return nil, err
-	}
-	if !matchLabels(edgeVar.Labels, edge.Labels) {
-		return nil, nil
-	}
-	return edgeVar, nil
-}
-
 func matchLabels(labelNames []string, labels []*catalog.Label) bool {
 	if len(labels) == 0 {
 		return true
@@ -336,8 +758,18 @@ func matchLabels(labelNames []string, labels []*catalog.Label) bool {
 	})
 }
 
-func (m *MatchExec) decodeVertexValue(val []byte, v *datum.Vertex) error {
-	labels, properties, err := m.decodeLabelsAndProperties(val)
+// propertiesOf returns the properties to be decoded for the variable, which are
+// all decoded if nil.
+func (m *MatchExec) propertiesOf(name string) []*model.PropertyInfo {
+	props, ok := m.decodeProps[name]
+	if !ok {
+		return nil
+	}
+	return props
+}
+
+func (m *MatchExec) decodeVertexValue(val []byte, v *datum.Vertex, props []*model.PropertyInfo) error {
+	labels, properties, err := m.decodeLabelsAndProperties(val, props)
 	if err != nil {
 		return err
 	}
@@ -346,8 +778,8 @@ func (m *MatchExec) decodeVertexValue(val []byte, v *datum.Vertex) error {
 	return nil
 }
 
-func (m *MatchExec) decodeEdgeValue(val []byte, v *datum.Edge) error {
-	labels, properties, err := m.decodeLabelsAndProperties(val)
+func (m *MatchExec) decodeEdgeValue(val []byte, v *datum.Edge, props []*model.PropertyInfo) error {
+	labels, properties, err := m.decodeLabelsAndProperties(val, props)
 	if err != nil {
 		return err
 	}
@@ -356,33 +788,21 @@ func (m *MatchExec) decodeEdgeValue(val []byte, v *datum.Edge) error {
 	return nil
 }
 
-func (m *MatchExec) decodeLabelsAndProperties(val []byte) (labels []string, properties map[string]datum.Datum, _ error) {
+func (m *MatchExec) decodeLabelsAndProperties(val []byte, props []*model.PropertyInfo) (labels []string, properties map[string]datum.Datum, _ error) {
 	graph := m.sc.CurrentGraph()
-
-	var labelInfos []*model.LabelInfo
-	for _, label := range graph.Labels() {
-		labelInfos = append(labelInfos, label.Meta())
-	}
-	dec := codec.NewPropertyDecoder(labelInfos, graph.Properties())
-
-	labelIDs, propertyValues, err := dec.Decode(val)
-	if err != nil {
-		return nil, nil, err
-	}
-	properties = make(map[string]datum.Datum)
-	for labelID := range labelIDs {
-		labels = append(labels, graph.LabelByID(int64(labelID)).Meta().Name.L)
+	if props == nil {
+		props = graph.Properties()
 	}
-	for propID, propVal := range propertyValues {
-		propName := graph.PropertyByID(propID).Name.L
-		properties[propName] = propVal
-	}
-	return labels, properties, nil
+	return decodeLabelsAndPropertiesOf(graph, val, props)
 }
 
 func (m *MatchExec) Close() error {
-	if m.txn != nil {
-		return m.txn.Rollback()
+	for _, step := range m.steps {
+		step.iter.close()
 	}
-	return nil
+	m.steps = nil
+	// The transaction is owned by the session, which will be committed or rolled
+	// back after the statement finished.
+	m.txn = nil
+	return m.baseExecutor.Close()
 }
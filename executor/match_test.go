@@ -0,0 +1,41 @@
+// ---
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchExec_Streaming(t *testing.T) {
+	ctx := context.Background()
+	sess := newTestSession(t, newTestDB(t, nil))
+
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Person")
+	for i := 0; i < 10; i++ {
+		sess.mustExec("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Lee')")
+	}
+
+	// The match stops searching once the limit is reached, so only 3 of the 10
+	// vertices are read.
+	rows := sess.mustExec("EXPLAIN ANALYZE SELECT x.name FROM MATCH (x:Person) LIMIT 3")
+	require.Equal(t, "  └─Match_3", datum.AsString(rows[2][0]))
+	require.Equal(t, int64(3), datum.AsInt(rows[2][3]))
+	require.Equal(t, "kv_gets:3, kv_iters:4", datum.AsString(rows[2][5]))
+
+	// The search is interrupted when the context is cancelled.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rs, err := sess.Execute(cancelCtx, "SELECT x.name FROM MATCH (x:Person)")
+	require.NoError(t, err)
+	require.NoError(t, rs.Next(cancelCtx))
+	require.True(t, rs.Valid())
+	cancel()
+	require.ErrorIs(t, rs.Next(cancelCtx), context.Canceled)
+	require.NoError(t, rs.Close())
+}
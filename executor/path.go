@@ -0,0 +1,422 @@
+// ---
+
+package executor
+
+import (
+	"container/heap"
+	"context"
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/planner"
+)
+
+// pathNode represents a path from the start vertex of a variable-length path
+// search. The path is represented as a linked list from the last hop.
+type pathNode struct {
+	parent *pathNode
+	vertex *datum.Vertex
+	// edge is the edge of the last hop, which is nil for the start vertex.
+	edge *datum.Edge
+	hops int64
+	cost float64
+	seq  int64
+}
+
+// onPath reports whether the vertex is on the path.
+func (n *pathNode) onPath(vertexID int64) bool {
+	for p := n; p != nil; p = p.parent {
+		if p.vertex.ID == vertexID {
+			return true
+		}
+	}
+	return false
+}
+
+// pathState identifies the nodes which have the same possible extensions. The
+// hops is the actual number of hops if the maximum hops is bounded, otherwise
+// it saturates at the minimum hops.
+type pathState struct {
+	vertexID int64
+	hops     int64
+}
+
+// pathFinder finds the variable-length paths from a start vertex. If the source
+// vertex of the path is not bound, the search starts from the destination vertex
+// and walks backward. The paths are found lazily, and each path is a candidate of
+// the search step which binds the path variables.
+type pathFinder struct {
+	m        *MatchExec
+	path     *planner.VariableLengthPath
+	edge     *planner.Edge
+	backward bool
+	// end is the vertex pattern of the end of search.
+	end *planner.Vertex
+	// target is the ID of end vertex if the end vertex is bound, otherwise -1.
+	target int64
+	seq    int64
+
+	// stack is the nodes to be visited by the depth-first search of ALL paths.
+	stack []*pathNode
+	// queue, popped and emitted are the states of the best-first search.
+	queue    *pathQueue
+	popped   map[pathState]*pathVisit
+	emitted  map[int64]*pathVisit
+	k        int64
+	withTies bool
+}
+
+// pathVisit counts the paths which reach the same state or end vertex, and cost
+// is the cost of the first (also the cheapest) one.
+type pathVisit struct {
+	count int64
+	cost  float64
+}
+
+// newPathFinder returns the finder of the variable-length path whose source or
+// destination vertex (or both) has been bound.
+func (m *MatchExec) newPathFinder(path *planner.VariableLengthPath) (*pathFinder, error) {
+	edge, ok := path.Conn.(*planner.Edge)
+	if !ok {
+		return nil, errors.Errorf("variable-length path over path pattern macro is not supported yet")
+	}
+
+	srcVar, srcVisited := m.matched[path.SrcVarName().L]
+	dstVar, dstVisited := m.matched[path.DstVarName().L]
+	f := &pathFinder{
+		m:        m,
+		path:     path,
+		edge:     edge,
+		backward: !srcVisited,
+		target:   -1,
+	}
+	var start *datum.Vertex
+	if f.backward {
+		start = dstVar.(*datum.Vertex)
+		f.end = m.subgraph.Vertices[path.SrcVarName().L]
+	} else {
+		start = srcVar.(*datum.Vertex)
+		f.end = m.subgraph.Vertices[path.DstVarName().L]
+		if dstVisited {
+			f.target = dstVar.(*datum.Vertex).ID
+		}
+	}
+
+	root := &pathNode{vertex: start}
+	switch path.Goal {
+	case planner.PathFindingAll:
+		if path.MaxHops == math.MaxInt64 {
+			return nil, errors.Errorf("ALL path pattern requires an upper bound on the number of hops")
+		}
+		f.stack = []*pathNode{root}
+	case planner.PathFindingReaches, planner.PathFindingShortest, planner.PathFindingCheapest:
+		f.k = path.TopK
+		if path.Goal == planner.PathFindingReaches {
+			f.k = 1
+		}
+		f.withTies = path.WithTies && path.Goal != planner.PathFindingReaches
+		f.popped = make(map[pathState]*pathVisit)
+		f.emitted = make(map[int64]*pathVisit)
+		f.queue = &pathQueue{}
+		heap.Push(f.queue, root)
+	default:
+		return nil, errors.Errorf("unsupported path finding goal %d", path.Goal)
+	}
+	return f, nil
+}
+
+func (f *pathFinder) next(ctx context.Context) ([]matchBinding, bool, error) {
+	var (
+		node *pathNode
+		err  error
+	)
+	if f.path.Goal == planner.PathFindingAll {
+		node, err = f.nextEnumerated(ctx)
+	} else {
+		node, err = f.nextBestFirst(ctx)
+	}
+	if err != nil || node == nil {
+		return nil, false, err
+	}
+	return f.bindings(node), true, nil
+}
+
+func (f *pathFinder) close() {}
+
+// nextEnumerated returns the next path of all paths enumerated by depth-first
+// search.
+func (f *pathFinder) nextEnumerated(ctx context.Context) (*pathNode, error) {
+	for len(f.stack) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		node := f.stack[len(f.stack)-1]
+		f.stack = f.stack[:len(f.stack)-1]
+		if node.hops < f.path.MaxHops {
+			children, err := f.expand(ctx, node)
+			if err != nil {
+				return nil, err
+			}
+			// The children are pushed in reverse order so that they are visited
+			// in the order of discovery.
+			for i := len(children) - 1; i >= 0; i-- {
+				f.stack = append(f.stack, children[i])
+			}
+		}
+		if f.isEnd(node) {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+// nextBestFirst returns the next path found in the order of cost, which is the
+// breadth-first search if the cost of each hop is 1, and the Dijkstra's algorithm
+// otherwise. Each vertex reaches at most k paths (k is 1 for reachability), and
+// all paths with the same minimal cost are found if WithTies is true.
+func (f *pathFinder) nextBestFirst(ctx context.Context) (*pathNode, error) {
+	for f.queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		node := heap.Pop(f.queue).(*pathNode)
+
+		// Limit the number of paths which reach the same state.
+		state := f.state(node)
+		v, ok := f.popped[state]
+		if !ok {
+			v = &pathVisit{cost: node.cost}
+			f.popped[state] = v
+		}
+		if f.withTies && node.cost > v.cost || !f.withTies && v.count >= f.k {
+			continue
+		}
+		v.count++
+
+		if node.hops < f.path.MaxHops {
+			children, err := f.expand(ctx, node)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				// The zero-cost cycles are skipped, otherwise the ties are infinite.
+				if child.cost == node.cost && node.onPath(child.vertex.ID) {
+					continue
+				}
+				heap.Push(f.queue, child)
+			}
+		}
+
+		if f.isEnd(node) {
+			e, ok := f.emitted[node.vertex.ID]
+			if !ok {
+				e = &pathVisit{cost: node.cost}
+				f.emitted[node.vertex.ID] = e
+			}
+			if f.withTies && node.cost == e.cost || !f.withTies && e.count < f.k {
+				e.count++
+				return node, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (f *pathFinder) state(node *pathNode) pathState {
+	hops := node.hops
+	if f.path.MaxHops == math.MaxInt64 && hops > f.path.MinHops {
+		hops = f.path.MinHops
+	}
+	return pathState{vertexID: node.vertex.ID, hops: hops}
+}
+
+// isEnd reports whether the path satisfies the hops and the end vertex pattern.
+func (f *pathFinder) isEnd(node *pathNode) bool {
+	if node.hops < f.path.MinHops {
+		return false
+	}
+	if f.target >= 0 {
+		return node.vertex.ID == f.target
+	}
+	return matchLabels(node.vertex.Labels, f.end.Labels)
+}
+
+// expand extends the path by one hop.
+func (f *pathFinder) expand(ctx context.Context, node *pathNode) ([]*pathNode, error) {
+	// The hop source and destination are in the direction of the path.
+	from, to := f.path.HopSrc, f.path.HopDst
+	if f.backward {
+		from, to = to, from
+	}
+	if from != nil && !matchLabels(node.vertex.Labels, from.Labels) {
+		return nil, nil
+	}
+	if to == nil {
+		to = &planner.Vertex{}
+	}
+
+	var directions []ast.EdgeDirection
+	switch {
+	case f.path.AnyDirected():
+		directions = []ast.EdgeDirection{ast.EdgeDirectionOutgoing, ast.EdgeDirectionIncoming}
+	case f.backward:
+		directions = []ast.EdgeDirection{ast.EdgeDirectionIncoming}
+	default:
+		directions = []ast.EdgeDirection{ast.EdgeDirectionOutgoing}
+	}
+
+	var children []*pathNode
+	for _, direction := range directions {
+		// The vertices and edges of paths are bound to many variables, so their
+		// properties are all decoded.
+		iter, err := f.m.newAdjacencyIter(f.edge, node.vertex.ID, to, direction, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		children, err = f.expandHops(ctx, node, iter, children)
+		iter.close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return children, nil
+}
+
+// expandHops appends the hops of the adjacent edges to the children.
+func (f *pathFinder) expandHops(ctx context.Context, node *pathNode, iter *adjacencyIter, children []*pathNode) ([]*pathNode, error) {
+	for {
+		edgeVar, endVar, err := iter.next(ctx)
+		if err != nil || edgeVar == nil {
+			return children, err
+		}
+		hop := datum.Row{node.vertex, edgeVar, endVar}
+		if f.backward {
+			hop[0], hop[2] = endVar, node.vertex
+		}
+		if f.path.Constraints != nil {
+			d, err := f.path.Constraints.Eval(f.m.sc, hop)
+			if err != nil {
+				return nil, err
+			}
+			if d == datum.Null || !datum.AsBool(d) {
+				continue
+			}
+		}
+		cost, err := f.hopCost(hop)
+		if err != nil {
+			return nil, err
+		}
+		f.seq++
+		children = append(children, &pathNode{
+			parent: node,
+			vertex: endVar,
+			edge:   edgeVar,
+			hops:   node.hops + 1,
+			cost:   node.cost + cost,
+			seq:    f.seq,
+		})
+	}
+}
+
+// hopCost returns the cost of a hop, which is 1 if the COST is not specified.
+func (f *pathFinder) hopCost(hop datum.Row) (float64, error) {
+	if f.path.Goal != planner.PathFindingCheapest || f.path.Cost == nil {
+		return 1, nil
+	}
+	d, err := f.path.Cost.Eval(f.m.sc, hop)
+	if err != nil {
+		return 0, err
+	}
+	if d == datum.Null {
+		return 0, errors.Errorf("the cost of path cannot be NULL")
+	}
+	cost, err := castToFloat(d)
+	if err != nil {
+		return 0, errors.Annotatef(err, "invalid cost of path")
+	}
+	if cost < 0 {
+		return 0, errors.Errorf("the cost of path cannot be negative: %v", cost)
+	}
+	return cost, nil
+}
+
+// bindings returns the bindings of the path variable, the end vertex and group
+// variables of the path.
+func (f *pathFinder) bindings(node *pathNode) []matchBinding {
+	var (
+		vertices []datum.Datum
+		edges    []datum.Datum
+	)
+	for p := node; p != nil; p = p.parent {
+		vertices = append(vertices, p.vertex)
+		if p.edge != nil {
+			edges = append(edges, p.edge)
+		}
+	}
+	// The nodes are collected from the end of search, so they are in the direction
+	// of path if the search walks backward.
+	if !f.backward {
+		reverse(vertices)
+		reverse(edges)
+	}
+
+	bindings := []matchBinding{{name: f.path.Name().L, value: datum.NewList(edges)}}
+	if f.backward {
+		bindings = append(bindings, matchBinding{name: f.path.SrcVarName().L, value: node.vertex})
+	} else if f.target < 0 {
+		bindings = append(bindings, matchBinding{name: f.path.DstVarName().L, value: node.vertex})
+	}
+	if f.path.HopSrc != nil {
+		bindings = append(bindings, matchBinding{name: f.path.HopSrc.Name.L, value: datum.NewList(vertices[:len(vertices)-1])})
+	}
+	if f.path.HopDst != nil {
+		bindings = append(bindings, matchBinding{name: f.path.HopDst.Name.L, value: datum.NewList(vertices[1:])})
+	}
+	return bindings
+}
+
+func reverse(s []datum.Datum) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func castToFloat(d datum.Datum) (float64, error) {
+	if v, err := datum.TryAsInt(d); err == nil {
+		return float64(v), nil
+	}
+	if v, err := datum.TryAsFloat(d); err == nil {
+		return v, nil
+	}
+	if v, err := datum.TryAsDecimal(d); err == nil {
+		return v.Float64()
+	}
+	return 0, errors.Errorf("cannot convert %s to number", d.Type())
+}
+
+// pathQueue is a priority queue of paths ordered by cost, hops and the order
+// of discovery.
+type pathQueue []*pathNode
+
+func (q pathQueue) Len() int { return len(q) }
+
+func (q pathQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	if q[i].hops != q[j].hops {
+		return q[i].hops < q[j].hops
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q pathQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x any)   { *q = append(*q, x.(*pathNode)) }
+func (q *pathQueue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
@@ -38,7 +38,7 @@ func (p *SelectionExec) Next(ctx context.Context) (datum.Row, error) {
 		if err != nil {
 			return nil, err
 		}
-		if datum.AsBool(d) {
+		if d != datum.Null && datum.AsBool(d) {
 			return row, nil
 		}
 	}
@@ -16,7 +16,10 @@ package executor
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/simbiont-runtime/graphengine/catalog"
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/meta"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
@@ -32,6 +35,13 @@ var showStmtColumns = [...][]planner.ResultColumn{
 	ast.ShowTargetLabels: {
 		{Name: model.NewCIStr("label"), Type: types.String},
 	},
+	ast.ShowTargetStats: {
+		{Name: model.NewCIStr("type"), Type: types.String},
+		{Name: model.NewCIStr("name"), Type: types.String},
+		{Name: model.NewCIStr("count"), Type: types.Int},
+		{Name: model.NewCIStr("ndv"), Type: types.Int},
+		{Name: model.NewCIStr("histogram"), Type: types.String},
+	},
 }
 
 // ShowExec is used to execute the show statements.
@@ -64,10 +74,103 @@ func (e *ShowExec) Open(ctx context.Context) error {
 		for _, l := range labels {
 			e.results = append(e.results, datum.Row{datum.NewString(l.Meta().Name.O)})
 		}
+
+	case ast.ShowTargetStats:
+		graphName := e.sc.CurrentGraphName()
+		if !e.statement.GraphName.IsEmpty() {
+			graphName = e.statement.GraphName.L
+		}
+		graph := e.sc.Catalog().Graph(graphName)
+		if graph == nil {
+			return meta.ErrGraphNotExists
+		}
+		if stats := graph.Stats(); stats != nil {
+			e.results = showStatsRows(graph, stats)
+		}
 	}
 	return nil
 }
 
+// showStatsRows returns the rows of SHOW STATS. The labels and properties which
+// have been dropped since analyzed are not shown.
+func showStatsRows(graph *catalog.Graph, stats *model.GraphStats) []datum.Row {
+	graphName := graph.Meta().Name.O
+	rows := []datum.Row{
+		statsRow("vertex", graphName, stats.VertexCount, datum.Null, datum.Null),
+		statsRow("edge", graphName, stats.EdgeCount, datum.Null, datum.Null),
+		statsRow("out_degree", graphName, stats.OutDegree.Count(), datum.Null, explainHistogram(stats.OutDegree)),
+		statsRow("in_degree", graphName, stats.InDegree.Count(), datum.Null, explainHistogram(stats.InDegree)),
+	}
+
+	labelName := func(id int64) string {
+		if id == 0 {
+			return "*"
+		}
+		if label := graph.LabelByID(id); label != nil {
+			return label.Meta().Name.O
+		}
+		return ""
+	}
+	for _, l := range stats.Labels {
+		name := labelName(l.ID)
+		if name == "" {
+			continue
+		}
+		if l.VertexCount > 0 {
+			rows = append(rows, statsRow("vertex_label", name, l.VertexCount, datum.Null, datum.Null))
+		}
+		if l.EdgeCount > 0 {
+			rows = append(rows, statsRow("edge_label", name, l.EdgeCount, datum.Null, datum.Null))
+		}
+	}
+	for _, p := range stats.LabelPairs {
+		src, dst := labelName(p.SrcID), labelName(p.DstID)
+		// The edges between any vertices are shown as the edge count.
+		if src == "" || dst == "" || p.SrcID == 0 && p.DstID == 0 {
+			continue
+		}
+		rows = append(rows, statsRow("label_pair", src+"->"+dst, p.Count, datum.Null, datum.Null))
+	}
+
+	for _, props := range []struct {
+		tp    string
+		stats []*model.PropertyStats
+	}{
+		{"vertex_property", stats.VertexProperties},
+		{"edge_property", stats.EdgeProperties},
+	} {
+		for _, p := range props.stats {
+			prop := graph.PropertyByID(p.ID)
+			if prop == nil {
+				continue
+			}
+			var histogram datum.Datum = datum.Null
+			if p.Histogram != nil {
+				histogram = explainHistogram(p.Histogram)
+			}
+			rows = append(rows, statsRow(props.tp, prop.Name.O, p.Count, datum.NewInt(p.NDV), histogram))
+		}
+	}
+	return rows
+}
+
+func statsRow(tp, name string, count int64, ndv, histogram datum.Datum) datum.Row {
+	return datum.Row{datum.NewString(tp), datum.NewString(name), datum.NewInt(count), ndv, histogram}
+}
+
+// explainHistogram formats the buckets of histogram as `[lower,upper]:count`.
+func explainHistogram(h *model.Histogram) datum.Datum {
+	buckets := make([]string, 0, len(h.Buckets))
+	for _, b := range h.Buckets {
+		buckets = append(buckets, "["+formatFloat(b.Lower)+","+formatFloat(b.Upper)+"]:"+strconv.FormatInt(b.Count, 10))
+	}
+	return datum.NewString(strings.Join(buckets, " "))
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
 func (e *ShowExec) Next(_ context.Context) (datum.Row, error) {
 	if e.index >= len(e.results) {
 		return nil, nil
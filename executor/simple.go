@@ -19,7 +19,7 @@ type SimpleExec struct {
 	statement ast.StmtNode
 }
 
-func (e *SimpleExec) Next(_ context.Context) (datum.Row, error) {
+func (e *SimpleExec) Next(ctx context.Context) (datum.Row, error) {
 	if e.done {
 		return nil, nil
 	}
@@ -28,6 +28,12 @@ func (e *SimpleExec) Next(_ context.Context) (datum.Row, error) {
 	switch stmt := e.statement.(type) {
 	case *ast.UseStmt:
 		return nil, e.execUse(stmt)
+	case *ast.BeginStmt:
+		return nil, e.sc.Txn().Begin(ctx, false)
+	case *ast.CommitStmt:
+		return nil, e.sc.Txn().Commit(ctx)
+	case *ast.RollbackStmt:
+		return nil, e.sc.Txn().Rollback()
 	default:
 		return nil, errors.Errorf("unknown statement: %T", e.statement)
 	}
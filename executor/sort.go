@@ -0,0 +1,519 @@
+// ---
+
+package executor
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+)
+
+// sortRow is a row with its evaluated sort keys.
+type sortRow struct {
+	keys datum.Row
+	row  datum.Row
+}
+
+// SortExec represents a sort executor. The rows are sorted in memory until the
+// memory quota is exceeded, after which the sorted rows are spilled to a temporary
+// file as a sorted run. All sorted runs are merged while outputting the rows.
+type SortExec struct {
+	baseExecutor
+
+	byItems []*planner.ByItem
+
+	prepared bool
+	rows     []sortRow
+	memUsage int64
+	idx      int
+
+	// runs are the sorted runs spilled to disk.
+	runs   []*sortRun
+	merger *sortMerger
+	// spillStats counts the spilled runs if it is not nil (EXPLAIN ANALYZE).
+	spillStats *spillStats
+}
+
+func (e *SortExec) Next(ctx context.Context) (datum.Row, error) {
+	if !e.prepared {
+		e.prepared = true
+		if err := e.prepare(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.merger != nil {
+		return e.merger.next()
+	}
+	if e.idx >= len(e.rows) {
+		return nil, nil
+	}
+	row := e.rows[e.idx].row
+	e.idx++
+	return row, nil
+}
+
+func (e *SortExec) prepare(ctx context.Context) error {
+	quota := e.sc.MemQuotaSort()
+	for {
+		row, err := e.children[0].Next(ctx)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+		keys, err := evalSortKeys(e.sc, e.byItems, row)
+		if err != nil {
+			return err
+		}
+		e.rows = append(e.rows, sortRow{keys: keys, row: row})
+		e.memUsage += estimateRowSize(keys) + estimateRowSize(row)
+		if e.memUsage > quota {
+			if err := e.spill(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(e.runs) == 0 {
+		return sortRows(e.sc, e.byItems, e.rows)
+	}
+	if len(e.rows) > 0 {
+		if err := e.spill(); err != nil {
+			return err
+		}
+	}
+	merger, err := newSortMerger(e.sc, e.byItems, e.runs)
+	if err != nil {
+		return err
+	}
+	e.merger = merger
+	return nil
+}
+
+// spill sorts the rows in memory and writes them to a temporary file.
+func (e *SortExec) spill() error {
+	if err := sortRows(e.sc, e.byItems, e.rows); err != nil {
+		return err
+	}
+	run, err := newSortRun()
+	if err != nil {
+		return err
+	}
+	e.runs = append(e.runs, run)
+	for _, r := range e.rows {
+		if err := run.write(r); err != nil {
+			return err
+		}
+	}
+	if err := run.finish(); err != nil {
+		return err
+	}
+	if e.spillStats != nil {
+		e.spillStats.runs++
+		e.spillStats.bytes += run.size
+	}
+	e.rows = e.rows[:0]
+	e.memUsage = 0
+	return nil
+}
+
+func (e *SortExec) Close() error {
+	var firstErr error
+	for _, run := range e.runs {
+		if err := run.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	e.runs = nil
+	if err := e.baseExecutor.Close(); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	return firstErr
+}
+
+// TopNExec represents a top-n executor which outputs the rows of the range
+// [offset, offset+count) in the sorted order. Only offset+count rows are
+// retained in memory.
+type TopNExec struct {
+	baseExecutor
+
+	byItems    []*planner.ByItem
+	offsetExpr expression.Expression
+	countExpr  expression.Expression
+
+	prepared bool
+	heap     *topNHeap
+	rows     []sortRow
+	idx      int
+}
+
+func (e *TopNExec) Next(ctx context.Context) (datum.Row, error) {
+	if !e.prepared {
+		e.prepared = true
+		if err := e.prepare(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if e.idx >= len(e.rows) {
+		return nil, nil
+	}
+	row := e.rows[e.idx].row
+	e.idx++
+	return row, nil
+}
+
+func (e *TopNExec) prepare(ctx context.Context) error {
+	offset, count, err := evalLimit(e.sc, e.offsetExpr, e.countExpr)
+	if err != nil {
+		return err
+	}
+	limit := offset + count
+	if count == 0 {
+		return nil
+	}
+
+	// The heap retains the smallest limit rows, and the root is the greatest
+	// one, which is replaced if a smaller row arrives.
+	e.heap = &topNHeap{sc: e.sc, byItems: e.byItems}
+	for seq := int64(0); ; seq++ {
+		row, err := e.children[0].Next(ctx)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			break
+		}
+		keys, err := evalSortKeys(e.sc, e.byItems, row)
+		if err != nil {
+			return err
+		}
+		item := topNItem{sortRow: sortRow{keys: keys, row: row}, seq: seq}
+		if int64(e.heap.Len()) < limit {
+			heap.Push(e.heap, item)
+		} else if e.heap.less(item, e.heap.items[0]) {
+			e.heap.items[0] = item
+			heap.Fix(e.heap, 0)
+		}
+		if e.heap.err != nil {
+			return e.heap.err
+		}
+	}
+
+	items := e.heap.items
+	sort.Slice(items, func(i, j int) bool {
+		return e.heap.less(items[i], items[j])
+	})
+	if e.heap.err != nil {
+		return e.heap.err
+	}
+	for i := offset; i < int64(len(items)); i++ {
+		e.rows = append(e.rows, items[i].sortRow)
+	}
+	return nil
+}
+
+type topNItem struct {
+	sortRow
+	// seq is the sequence number of the row in the input, which keeps the
+	// rows with equal keys in the input order.
+	seq int64
+}
+
+// topNHeap is a max-heap of topNItem.
+type topNHeap struct {
+	sc      *stmtctx.Context
+	byItems []*planner.ByItem
+	items   []topNItem
+	err     error
+}
+
+func (h *topNHeap) less(a, b topNItem) bool {
+	cmp, err := compareSortKeys(h.sc, h.byItems, a.keys, b.keys)
+	if err != nil {
+		if h.err == nil {
+			h.err = err
+		}
+		return false
+	}
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return a.seq < b.seq
+}
+
+func (h *topNHeap) Len() int           { return len(h.items) }
+func (h *topNHeap) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h *topNHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topNHeap) Push(x any)         { h.items = append(h.items, x.(topNItem)) }
+func (h *topNHeap) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
+
+func evalSortKeys(sc *stmtctx.Context, byItems []*planner.ByItem, row datum.Row) (datum.Row, error) {
+	keys := make(datum.Row, 0, len(byItems))
+	for _, item := range byItems {
+		d, err := item.Expr.Eval(sc, row)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, d)
+	}
+	return keys, nil
+}
+
+// compareSortKeys compares two rows by the sort keys. The NULL values are ordered
+// after all non-NULL values in ascending order, and before them in descending order.
+func compareSortKeys(sc *stmtctx.Context, byItems []*planner.ByItem, a, b datum.Row) (int, error) {
+	for i, item := range byItems {
+		var cmp int
+		switch {
+		case a[i] == datum.Null && b[i] == datum.Null:
+			cmp = 0
+		case a[i] == datum.Null:
+			cmp = 1
+		case b[i] == datum.Null:
+			cmp = -1
+		default:
+			var err error
+			cmp, err = expression.Compare(sc, a[i], b[i])
+			if err != nil {
+				return 0, err
+			}
+		}
+		if item.Desc {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// sortRows sorts the rows stably by the sort keys.
+func sortRows(sc *stmtctx.Context, byItems []*planner.ByItem, rows []sortRow) error {
+	var firstErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		cmp, err := compareSortKeys(sc, byItems, rows[i].keys, rows[j].keys)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		return cmp < 0
+	})
+	return firstErr
+}
+
+// estimateRowSize estimates the memory usage of the row.
+func estimateRowSize(row datum.Row) int64 {
+	size := int64(24)
+	for _, d := range row {
+		size += estimateDatumSize(d)
+	}
+	return size
+}
+
+func estimateDatumSize(d datum.Datum) int64 {
+	// The size of an interface value.
+	const ifaceSize = 16
+
+	switch v := d.(type) {
+	case *datum.Vertex:
+		size := int64(ifaceSize + 64)
+		for _, label := range v.Labels {
+			size += int64(len(label)) + 16
+		}
+		for name, prop := range v.Props {
+			size += int64(len(name)) + 16 + estimateDatumSize(prop)
+		}
+		return size
+	case *datum.Edge:
+		size := int64(ifaceSize + 72)
+		for _, label := range v.Labels {
+			size += int64(len(label)) + 16
+		}
+		for name, prop := range v.Props {
+			size += int64(len(name)) + 16 + estimateDatumSize(prop)
+		}
+		return size
+	default:
+		return ifaceSize + 24 + int64(len(d.String()))
+	}
+}
+
+// sortRun is a sorted run spilled to a temporary file. Each record in the file
+// is the length-prefixed serialized sort keys and row.
+type sortRun struct {
+	file   *os.File
+	writer *bufio.Writer
+	reader *bufio.Reader
+	buf    []byte
+	// size is the number of bytes written to the file.
+	size int64
+}
+
+func newSortRun() (*sortRun, error) {
+	file, err := os.CreateTemp("", "graphengine-sort-*")
+	if err != nil {
+		return nil, errors.Annotate(err, "create sort spill file")
+	}
+	return &sortRun{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+func (r *sortRun) write(row sortRow) error {
+	data := datum.AppendRow(r.buf[:0], row.keys)
+	data = datum.AppendRow(data, row.row)
+	r.buf = data
+
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+	if _, err := r.writer.Write(lenBuf[:n]); err != nil {
+		return err
+	}
+	_, err := r.writer.Write(data)
+	r.size += int64(n + len(data))
+	return err
+}
+
+// finish flushes the buffered data and rewinds the file for reading.
+func (r *sortRun) finish() error {
+	if err := r.writer.Flush(); err != nil {
+		return err
+	}
+	if _, err := r.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	r.writer = nil
+	r.reader = bufio.NewReader(r.file)
+	return nil
+}
+
+// read reads the next row of the run. It returns nil if the run is exhausted.
+func (r *sortRun) read() (*sortRow, error) {
+	n, err := binary.ReadUvarint(r.reader)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if uint64(cap(r.buf)) < n {
+		r.buf = make([]byte, n)
+	}
+	data := r.buf[:n]
+	if _, err := io.ReadFull(r.reader, data); err != nil {
+		return nil, err
+	}
+	keys, data, err := datum.DecodeRow(data)
+	if err != nil {
+		return nil, err
+	}
+	row, _, err := datum.DecodeRow(data)
+	if err != nil {
+		return nil, err
+	}
+	return &sortRow{keys: keys, row: row}, nil
+}
+
+func (r *sortRun) close() error {
+	name := r.file.Name()
+	err := r.file.Close()
+	if rmErr := os.Remove(name); rmErr != nil && err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// sortMerger merges multiple sorted runs.
+type sortMerger struct {
+	sc      *stmtctx.Context
+	byItems []*planner.ByItem
+	// heads are the current rows of the runs which are not exhausted.
+	heads []mergeHead
+	err   error
+}
+
+type mergeHead struct {
+	sortRow
+	run   *sortRun
+	runID int
+}
+
+func newSortMerger(sc *stmtctx.Context, byItems []*planner.ByItem, runs []*sortRun) (*sortMerger, error) {
+	m := &sortMerger{sc: sc, byItems: byItems}
+	for i, run := range runs {
+		row, err := run.read()
+		if err != nil {
+			return nil, err
+		}
+		if row != nil {
+			m.heads = append(m.heads, mergeHead{sortRow: *row, run: run, runID: i})
+		}
+	}
+	heap.Init(m)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m, nil
+}
+
+func (m *sortMerger) next() (datum.Row, error) {
+	if len(m.heads) == 0 {
+		return nil, nil
+	}
+	head := &m.heads[0]
+	result := head.row
+	row, err := head.run.read()
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		heap.Pop(m)
+	} else {
+		head.sortRow = *row
+		heap.Fix(m, 0)
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return result, nil
+}
+
+func (m *sortMerger) Len() int { return len(m.heads) }
+
+func (m *sortMerger) Less(i, j int) bool {
+	cmp, err := compareSortKeys(m.sc, m.byItems, m.heads[i].keys, m.heads[j].keys)
+	if err != nil && m.err == nil {
+		m.err = err
+	}
+	if cmp != 0 {
+		return cmp < 0
+	}
+	// The runs are spilled in the input order, so the rows with equal keys
+	// are output in the order of runs to keep the sort stable.
+	return m.heads[i].runID < m.heads[j].runID
+}
+
+func (m *sortMerger) Swap(i, j int) { m.heads[i], m.heads[j] = m.heads[j], m.heads[i] }
+func (m *sortMerger) Push(x any)    { m.heads = append(m.heads, x.(mergeHead)) }
+func (m *sortMerger) Pop() any {
+	head := m.heads[len(m.heads)-1]
+	m.heads = m.heads[:len(m.heads)-1]
+	return head
+}
@@ -0,0 +1,65 @@
+// ---
+
+package executor_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortExec_Spill(t *testing.T) {
+	// The tiny memory quota makes the sort operator spill every row to disk.
+	sess := newTestSession(t, newTestDB(t, &graphengine.Options{MemQuotaSort: 1}))
+
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Person")
+	for i := 0; i < 20; i++ {
+		sess.mustExec(fmt.Sprintf("INSERT VERTEX x LABELS (Person) PROPERTIES (x.id = %d, x.age = %d)", i, (i*7)%5))
+	}
+
+	rows := sess.mustExec("SELECT x.age, x.id FROM MATCH (x:Person) ORDER BY x.age DESC, x.id")
+	require.Len(t, rows, 20)
+	for i := 1; i < len(rows); i++ {
+		prevAge, age := datum.AsInt(rows[i-1][0]), datum.AsInt(rows[i][0])
+		require.GreaterOrEqual(t, prevAge, age)
+		if prevAge == age {
+			require.Less(t, datum.AsInt(rows[i-1][1]), datum.AsInt(rows[i][1]))
+		}
+	}
+
+	rows = sess.mustExec("SELECT x.id FROM MATCH (x:Person) ORDER BY x.id LIMIT 3 OFFSET 15")
+	require.Equal(t, []datum.Row{{datum.NewInt(15)}, {datum.NewInt(16)}, {datum.NewInt(17)}}, rows)
+
+	// Each row is spilled as a sorted run.
+	info := sortExecutionInfo(t, sess, "EXPLAIN ANALYZE SELECT x.age, x.id FROM MATCH (x:Person) ORDER BY x.age DESC, x.id")
+	require.Regexp(t, `^spill_runs:20, spill_bytes:[1-9][0-9]*$`, info)
+
+	// The rows are sorted in memory within the default memory quota.
+	sess = newTestSession(t, newTestDB(t, nil))
+	sess.mustExec("CREATE GRAPH g")
+	sess.mustExec("USE g")
+	sess.mustExec("CREATE LABEL Person")
+	for i := 0; i < 20; i++ {
+		sess.mustExec(fmt.Sprintf("INSERT VERTEX x LABELS (Person) PROPERTIES (x.id = %d)", i))
+	}
+	info = sortExecutionInfo(t, sess, "EXPLAIN ANALYZE SELECT x.id FROM MATCH (x:Person) ORDER BY x.id")
+	require.Equal(t, "", info)
+}
+
+// sortExecutionInfo returns the execution information of the sort operator of
+// the EXPLAIN ANALYZE result.
+func sortExecutionInfo(t *testing.T, sess *testSession, query string) string {
+	for _, row := range sess.mustExec(query) {
+		if strings.Contains(datum.AsString(row[0]), "Sort_") {
+			return datum.AsString(row[5])
+		}
+	}
+	require.FailNow(t, "no sort operator", query)
+	return ""
+}
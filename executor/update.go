@@ -0,0 +1,227 @@
+// ---
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/internal/logutil"
+	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// UpdateExec represents the executor of UPDATE statement.
+type UpdateExec struct {
+	baseExecutor
+
+	done      bool
+	graph     *catalog.Graph
+	updates   []*planner.ElementUpdate
+	buffer    []byte
+	encoder   *codec.PropertyEncoder
+	matchExec Executor
+
+	// updated records the new value of all elements updated by the current
+	// statement. The same element can be matched multiple times and we only
+	// write it once.
+	updated map[string][]byte
+	kvs     []kv.Pair
+	// deleted records the stale index keys of the updated vertices.
+	deleted []kv.Key
+	// indexKeys records the new index keys of the updated vertices.
+	indexKeys []indexKey
+}
+
+// Open implements the Executor interface.
+func (e *UpdateExec) Open(ctx context.Context) error {
+	e.updated = make(map[string][]byte)
+	return e.matchExec.Open(ctx)
+}
+
+// Next implements the Executor interface.
+func (e *UpdateExec) Next(ctx context.Context) (datum.Row, error) {
+	if e.done {
+		return nil, nil
+	}
+	e.done = true
+
+	var affectedRows uint64
+	for {
+		row, err := e.matchExec.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			break
+		}
+		for _, update := range e.updates {
+			updated, err := e.updateElement(row, update)
+			if err != nil {
+				return nil, err
+			}
+			if updated {
+				affectedRows++
+			}
+		}
+	}
+
+	if len(e.kvs) == 0 {
+		return nil, nil
+	}
+
+	txn, err := e.sc.Txn().Activate()
+	if err != nil {
+		return nil, err
+	}
+	err = e.write(ctx, txn)
+	if err != nil {
+		logutil.Errorf("Update vertices/edges failed: %+v", e.updates)
+		return nil, err
+	}
+	e.sc.AddAffectedRows(affectedRows)
+	e.sc.AddUpdatedRows(affectedRows)
+	return nil, nil
+}
+
+func (e *UpdateExec) write(ctx context.Context, txn kv.Transaction) error {
+	// The stale index keys are removed first because the new index key is the
+	// same as the stale one if the indexed properties are not changed.
+	for _, key := range e.deleted {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	for _, pair := range e.kvs {
+		if err := txn.Set(pair.Key, pair.Val); err != nil {
+			return err
+		}
+	}
+	return setIndexKeys(ctx, txn, e.indexKeys)
+}
+
+// updateElement applies the assignments to the element referenced by the update and
+// reports whether the element is updated the first time in the current statement.
+func (e *UpdateExec) updateElement(row datum.Row, update *planner.ElementUpdate) (bool, error) {
+	var (
+		labels []string
+		props  map[string]datum.Datum
+		keys   []kv.Key
+	)
+	graphID := e.graph.Meta().ID
+	switch x := row[update.VariableIndex].(type) {
+	case *datum.Vertex:
+		labels, props = x.Labels, x.Props
+		keys = []kv.Key{codec.VertexKey(graphID, x.ID)}
+	case *datum.Edge:
+		labels, props = x.Labels, x.Props
+		keys = []kv.Key{
+			codec.IncomingEdgeKey(graphID, x.SrcID, x.DstID, x.ID),
+			codec.OutgoingEdgeKey(graphID, x.SrcID, x.DstID, x.ID),
+		}
+	default:
+		return false, errors.Errorf("cannot update variable %s of type %s", update.VariableName, x.Type())
+	}
+
+	newProps := make(map[string]datum.Datum, len(props)+len(update.Assignments))
+	for name, value := range props {
+		newProps[name] = value
+	}
+	for _, assignment := range update.Assignments {
+		value, err := assignment.Expr.Eval(e.sc, row)
+		if err != nil {
+			return false, err
+		}
+		name := assignment.PropertyRef.Property.Name.L
+		// Assigning NULL to a property removes the property from the element.
+		if value == datum.Null {
+			delete(newProps, name)
+			continue
+		}
+		newProps[name] = value
+	}
+	schemaLabels := make([]*catalog.Label, 0, len(labels))
+	for _, name := range labels {
+		if label := e.graph.Label(name); label != nil {
+			schemaLabels = append(schemaLabels, label)
+		}
+	}
+	if err := checkLabelSchemas(schemaLabels, newProps); err != nil {
+		return false, err
+	}
+
+	val, err := e.encodeElement(labels, newProps)
+	if err != nil {
+		return false, err
+	}
+
+	if prev, ok := e.updated[string(keys[0])]; ok {
+		if string(prev) != string(val) {
+			return false, errors.Errorf("conflicting updates to variable %s in the same statement", update.VariableName)
+		}
+		return false, nil
+	}
+	e.updated[string(keys[0])] = val
+	for _, key := range keys {
+		e.kvs = append(e.kvs, kv.Pair{Key: key, Val: val})
+	}
+	if v, ok := row[update.VariableIndex].(*datum.Vertex); ok {
+		if err := e.updateIndexes(v.ID, labels, props, newProps); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// updateIndexes replaces the index keys of the vertex if the indexed properties changed.
+func (e *UpdateExec) updateIndexes(vertexID int64, labels []string, oldProps, newProps map[string]datum.Datum) error {
+	oldKeys, err := vertexIndexKeys(e.graph, vertexID, labels, oldProps)
+	if err != nil {
+		return err
+	}
+	newKeys, err := vertexIndexKeys(e.graph, vertexID, labels, newProps)
+	if err != nil {
+		return err
+	}
+	for _, key := range oldKeys {
+		e.deleted = append(e.deleted, key.key)
+	}
+	e.indexKeys = append(e.indexKeys, newKeys...)
+	return nil
+}
+
+func (e *UpdateExec) encodeElement(labels []string, props map[string]datum.Datum) ([]byte, error) {
+	labelIDs := make([]int64, 0, len(labels))
+	for _, name := range labels {
+		label := e.graph.Label(name)
+		if label == nil {
+			return nil, errors.Errorf("label %s not exists", name)
+		}
+		labelIDs = append(labelIDs, label.Meta().ID)
+	}
+	propertyIDs := make([]uint16, 0, len(props))
+	values := make([]datum.Datum, 0, len(props))
+	for name, value := range props {
+		property := e.graph.Property(name)
+		if property == nil {
+			return nil, errors.Errorf("property %s not exists", name)
+		}
+		propertyIDs = append(propertyIDs, property.ID)
+		values = append(values, value)
+	}
+	ret, err := e.encoder.Encode(e.buffer, labelIDs, propertyIDs, values)
+	if err != nil {
+		return nil, err
+	}
+	val := make([]byte, len(ret))
+	copy(val, ret)
+	return val, nil
+}
+
+// Close implements the Executor interface.
+func (e *UpdateExec) Close() error {
+	return e.matchExec.Close()
+}
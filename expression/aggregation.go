@@ -0,0 +1,385 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &AggregateExpr{}
+
+// AggregateExpr represents an aggregate function call, e.g: COUNT(DISTINCT n.name).
+// The aggregate expression cannot be evaluated on a single row. The aggregation
+// executor creates an Accumulator for each group and feeds the arguments of all
+// rows of the group into it.
+type AggregateExpr struct {
+	Name     string
+	Args     []Expression
+	Distinct bool
+	Fn       AggregateFunction
+}
+
+func (expr *AggregateExpr) String() string {
+	sb := &strings.Builder{}
+	sb.WriteString(expr.Name)
+	sb.WriteByte('(')
+	if expr.Distinct {
+		sb.WriteString("DISTINCT ")
+	}
+	for i, arg := range expr.Args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(arg.String())
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+func (expr *AggregateExpr) ReturnType() types.T {
+	argTypes := make([]types.T, 0, len(expr.Args))
+	for _, arg := range expr.Args {
+		argTypes = append(argTypes, arg.ReturnType())
+	}
+	return expr.Fn.InferReturnType(argTypes)
+}
+
+func (expr *AggregateExpr) Eval(_ *stmtctx.Context, _ datum.Row) (datum.Datum, error) {
+	return nil, fmt.Errorf("aggregate function %s cannot be evaluated on a single row", expr.Name)
+}
+
+// NewAccumulator returns an accumulator to compute the aggregate function on a group.
+func (expr *AggregateExpr) NewAccumulator() Accumulator {
+	acc := expr.Fn.NewAccumulator()
+	if expr.Distinct {
+		acc = &distinctAccumulator{
+			Accumulator: acc,
+			seen:        make(map[string]struct{}),
+		}
+	}
+	return acc
+}
+
+// Update evaluates the arguments on the input row and feeds them into the accumulator.
+// The rows which have NULL arguments are skipped.
+func (expr *AggregateExpr) Update(stmtCtx *stmtctx.Context, acc Accumulator, input datum.Row) error {
+	args := make([]datum.Datum, 0, len(expr.Args))
+	for _, arg := range expr.Args {
+		d, err := arg.Eval(stmtCtx, input)
+		if err != nil {
+			return err
+		}
+		if d == datum.Null {
+			return nil
+		}
+		args = append(args, d)
+	}
+	return acc.Add(stmtCtx, args)
+}
+
+func NewAggregateExpr(name string, distinct bool, args ...Expression) (*AggregateExpr, error) {
+	name = strings.ToLower(name)
+	// The separator of LISTAGG is optional.
+	if name == "listagg" && len(args) == 1 {
+		args = append(args, &Constant{Value: datum.NewString("")})
+	}
+	fn, ok := aggregateFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("aggregate function %s not found", name)
+	}
+	if fn.NumArgs() != len(args) {
+		return nil, fmt.Errorf("invalid arguments count to call aggregate function %s", name)
+	}
+	return &AggregateExpr{
+		Name:     name,
+		Args:     args,
+		Distinct: distinct,
+		Fn:       fn,
+	}, nil
+}
+
+var _ Expression = &HorizontalAggregateExpr{}
+
+// HorizontalAggregateExpr represents an aggregate function over the elements of
+// group variables, e.g: COUNT(e) and SUM(e.weight) where e is bound to the edges
+// of a variable-length path. Unlike AggregateExpr, it aggregates the elements
+// within a single row.
+type HorizontalAggregateExpr struct {
+	*AggregateExpr
+
+	// ListIndexes are the indexes of the group variable columns referenced by
+	// the arguments. The lists of a row must have the same length.
+	ListIndexes []int
+}
+
+func (expr *HorizontalAggregateExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	lists := make([][]datum.Datum, 0, len(expr.ListIndexes))
+	for _, idx := range expr.ListIndexes {
+		if idx >= len(input) {
+			return nil, fmt.Errorf("column index %d out of input row length %d", idx, len(input))
+		}
+		var list []datum.Datum
+		if input[idx] != datum.Null {
+			var err error
+			list, err = datum.TryAsList(input[idx])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(lists) > 0 && len(list) != len(lists[0]) {
+			return nil, fmt.Errorf("group variables of aggregate function %s have different lengths", expr.Name)
+		}
+		lists = append(lists, list)
+	}
+
+	acc := expr.NewAccumulator()
+	row := append(datum.Row(nil), input...)
+	for i := range lists[0] {
+		for j, idx := range expr.ListIndexes {
+			row[idx] = lists[j][i]
+		}
+		if err := expr.Update(stmtCtx, acc, row); err != nil {
+			return nil, err
+		}
+	}
+	return acc.Result()
+}
+
+// AggregateFunction represents an aggregate function.
+type AggregateFunction interface {
+	NumArgs() int
+	InferReturnType(argTypes []types.T) types.T
+	NewAccumulator() Accumulator
+}
+
+// Accumulator accumulates the values of a group and computes the aggregate result.
+type Accumulator interface {
+	Add(stmtCtx *stmtctx.Context, args []datum.Datum) error
+	Result() (datum.Datum, error)
+}
+
+var aggregateFuncs = map[string]AggregateFunction{
+	"count":     newAggregateFunc(1, func([]types.T) types.T { return types.Int }, newCountAccumulator),
+	"sum":       newAggregateFunc(1, firstArgType, newSumAccumulator),
+	"avg":       newAggregateFunc(1, inferAvgReturnType, newAvgAccumulator),
+	"min":       newAggregateFunc(1, firstArgType, newMinAccumulator),
+	"max":       newAggregateFunc(1, firstArgType, newMaxAccumulator),
+	"array_agg": newAggregateFunc(1, func([]types.T) types.T { return types.List }, newArrayAggAccumulator),
+	"listagg":   newAggregateFunc(2, func([]types.T) types.T { return types.String }, newListAggAccumulator),
+}
+
+type aggregateFunc struct {
+	numArgs         int
+	inferReturnType func(argTypes []types.T) types.T
+	newAccumulator  func() Accumulator
+}
+
+func (f aggregateFunc) NumArgs() int {
+	return f.numArgs
+}
+
+func (f aggregateFunc) InferReturnType(argTypes []types.T) types.T {
+	return f.inferReturnType(argTypes)
+}
+
+func (f aggregateFunc) NewAccumulator() Accumulator {
+	return f.newAccumulator()
+}
+
+func newAggregateFunc(numArgs int, inferReturnType func([]types.T) types.T, newAccumulator func() Accumulator) AggregateFunction {
+	return aggregateFunc{
+		numArgs:         numArgs,
+		inferReturnType: inferReturnType,
+		newAccumulator:  newAccumulator,
+	}
+}
+
+func firstArgType(argTypes []types.T) types.T {
+	return argTypes[0]
+}
+
+func inferAvgReturnType(argTypes []types.T) types.T {
+	if argTypes[0] == types.Decimal {
+		return types.Decimal
+	}
+	return types.Float
+}
+
+type distinctAccumulator struct {
+	Accumulator
+	seen map[string]struct{}
+}
+
+func (a *distinctAccumulator) Add(stmtCtx *stmtctx.Context, args []datum.Datum) error {
+	// Only the first argument takes part in DISTINCT, the others (e.g: the
+	// separator of LISTAGG) are constants.
+	key := datum.HashKey(args[0])
+	if _, ok := a.seen[key]; ok {
+		return nil
+	}
+	a.seen[key] = struct{}{}
+	return a.Accumulator.Add(stmtCtx, args)
+}
+
+type countAccumulator struct {
+	count int64
+}
+
+func newCountAccumulator() Accumulator {
+	return &countAccumulator{}
+}
+
+func (a *countAccumulator) Add(_ *stmtctx.Context, _ []datum.Datum) error {
+	a.count++
+	return nil
+}
+
+func (a *countAccumulator) Result() (datum.Datum, error) {
+	return datum.NewInt(a.count), nil
+}
+
+type sumAccumulator struct {
+	sum datum.Datum
+}
+
+func newSumAccumulator() Accumulator {
+	return &sumAccumulator{sum: datum.Null}
+}
+
+func (a *sumAccumulator) Add(stmtCtx *stmtctx.Context, args []datum.Datum) error {
+	if a.sum == datum.Null {
+		a.sum = args[0]
+		return nil
+	}
+	sum, err := binOps[opcode.Plus].Eval(stmtCtx, a.sum, args[0])
+	if err != nil {
+		return err
+	}
+	a.sum = sum
+	return nil
+}
+
+func (a *sumAccumulator) Result() (datum.Datum, error) {
+	return a.sum, nil
+}
+
+type avgAccumulator struct {
+	sumAccumulator
+	count int64
+}
+
+func newAvgAccumulator() Accumulator {
+	return &avgAccumulator{sumAccumulator: sumAccumulator{sum: datum.Null}}
+}
+
+func (a *avgAccumulator) Add(stmtCtx *stmtctx.Context, args []datum.Datum) error {
+	a.count++
+	return a.sumAccumulator.Add(stmtCtx, args)
+}
+
+func (a *avgAccumulator) Result() (datum.Datum, error) {
+	if a.count == 0 {
+		return datum.Null, nil
+	}
+	sum := a.sum
+	if sum.Type() == types.Int {
+		sum = datum.NewFloat(float64(datum.AsInt(sum)))
+	}
+	return binOps[opcode.Div].Eval(nil, sum, datum.NewInt(a.count))
+}
+
+type minMaxAccumulator struct {
+	// cmp is the comparison operator which reports whether the new value should
+	// replace the current one.
+	cmp    BinaryEvalOp
+	result datum.Datum
+}
+
+func newMinAccumulator() Accumulator {
+	return &minMaxAccumulator{cmp: binOps[opcode.LT], result: datum.Null}
+}
+
+func newMaxAccumulator() Accumulator {
+	return &minMaxAccumulator{cmp: binOps[opcode.GT], result: datum.Null}
+}
+
+func (a *minMaxAccumulator) Add(stmtCtx *stmtctx.Context, args []datum.Datum) error {
+	if a.result == datum.Null {
+		a.result = args[0]
+		return nil
+	}
+	replace, err := a.cmp.Eval(stmtCtx, args[0], a.result)
+	if err != nil {
+		return err
+	}
+	if datum.AsBool(replace) {
+		a.result = args[0]
+	}
+	return nil
+}
+
+func (a *minMaxAccumulator) Result() (datum.Datum, error) {
+	return a.result, nil
+}
+
+// arrayAggAccumulator collects all values of a group into a list.
+type arrayAggAccumulator struct {
+	values []datum.Datum
+}
+
+func newArrayAggAccumulator() Accumulator {
+	return &arrayAggAccumulator{}
+}
+
+func (a *arrayAggAccumulator) Add(_ *stmtctx.Context, args []datum.Datum) error {
+	a.values = append(a.values, args[0])
+	return nil
+}
+
+func (a *arrayAggAccumulator) Result() (datum.Datum, error) {
+	if len(a.values) == 0 {
+		return datum.Null, nil
+	}
+	return datum.NewList(a.values), nil
+}
+
+// listAggAccumulator concatenates all values of a group with the separator.
+type listAggAccumulator struct {
+	values    []string
+	separator string
+}
+
+func newListAggAccumulator() Accumulator {
+	return &listAggAccumulator{}
+}
+
+func (a *listAggAccumulator) Add(_ *stmtctx.Context, args []datum.Datum) error {
+	a.values = append(a.values, args[0].String())
+	a.separator = args[1].String()
+	return nil
+}
+
+func (a *listAggAccumulator) Result() (datum.Datum, error) {
+	if len(a.values) == 0 {
+		return datum.Null, nil
+	}
+	return datum.NewString(strings.Join(a.values, a.separator)), nil
+}
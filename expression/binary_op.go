@@ -610,6 +610,27 @@ func makeFlippedNegateCmpOp(op opcode.Op) flippedNegateCmpOp {
 	return flippedNegateCmpOp{makeCmpOp(op)}
 }
 
+// Compare compares two non-NULL datums and returns -1, 0 or 1 if left is less
+// than, equal to or greater than right.
+func Compare(stmtCtx *stmtctx.Context, left, right datum.Datum) (int, error) {
+	lt := binOps[opcode.LT]
+	res, err := lt.Eval(stmtCtx, left, right)
+	if err != nil {
+		return 0, err
+	}
+	if datum.AsBool(res) {
+		return -1, nil
+	}
+	res, err = lt.Eval(stmtCtx, right, left)
+	if err != nil {
+		return 0, err
+	}
+	if datum.AsBool(res) {
+		return 1, nil
+	}
+	return 0, nil
+}
+
 func cmpEqBool(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
 	return datum.NewBool(datum.AsBool(left) == datum.AsBool(right)), nil
 }
@@ -664,49 +685,50 @@ func cmpLtBytes(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error
 }
 
 func cmpEqDate(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqDate not implemented")
+	return datum.NewBool(datum.AsDate(left).UnixEpochDays() == datum.AsDate(right).UnixEpochDays()), nil
 }
 
 func cmpLtDate(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpLtDate not implemented")
+	return datum.NewBool(datum.AsDate(left).UnixEpochDays() < datum.AsDate(right).UnixEpochDays()), nil
 }
 
 func cmpEqTime(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqTime not implemented")
+	return datum.NewBool(datum.AsTime(left).TimeOfDay == datum.AsTime(right).TimeOfDay), nil
 }
 
 func cmpLtTime(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpLtTime not implemented")
+	return datum.NewBool(datum.AsTime(left).TimeOfDay < datum.AsTime(right).TimeOfDay), nil
 }
 
+// The times with time zone are compared by their UTC times.
 func cmpEqTimeTZ(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqTimeTZ not implemented")
+	return datum.NewBool(utcTimeOfDay(datum.AsTimeTZ(left)) == utcTimeOfDay(datum.AsTimeTZ(right))), nil
 }
 
 func cmpLtTimeTZ(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpLtTimeTZ not implemented")
+	return datum.NewBool(utcTimeOfDay(datum.AsTimeTZ(left)) < utcTimeOfDay(datum.AsTimeTZ(right))), nil
 }
 
 func cmpEqTimestamp(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqTimestamp not implemented")
+	return datum.NewBool(datum.AsTimestamp(left).Equal(datum.AsTimestamp(right).Time)), nil
 }
 
 func cmpLtTimestamp(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpLtTimestamp not implemented")
+	return datum.NewBool(datum.AsTimestamp(left).Before(datum.AsTimestamp(right).Time)), nil
 }
 
 func cmpEqTimestampTZ(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqTimestampTZ not implemented")
+	return datum.NewBool(datum.AsTimestampTZ(left).Equal(datum.AsTimestampTZ(right).Time)), nil
 }
 
 func cmpLtTimestampTZ(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpLtTimestampTZ not implemented")
+	return datum.NewBool(datum.AsTimestampTZ(left).Before(datum.AsTimestampTZ(right).Time)), nil
 }
 
 func cmpEqVertex(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqVertex not implemented")
+	return datum.NewBool(datum.AsVertex(left).ID == datum.AsVertex(right).ID), nil
 }
 
 func cmpEqEdge(_ *stmtctx.Context, left, right datum.Datum) (datum.Datum, error) {
-	return nil, fmt.Errorf("cmpEqEdge not implemented")
+	return datum.NewBool(datum.AsEdge(left).ID == datum.AsEdge(right).ID), nil
 }
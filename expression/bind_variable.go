@@ -0,0 +1,32 @@
+// ---
+
+package expression
+
+import (
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &BindVariable{}
+
+// BindVariable represents a bind variable (?), whose value is provided by the
+// statement context when the statement is executed.
+type BindVariable struct {
+	Order int
+}
+
+// String implements the fmt.Stringer interface.
+func (b *BindVariable) String() string {
+	return "?"
+}
+
+// ReturnType returns types.Unknown since the type of value is not known until
+// the statement is executed.
+func (b *BindVariable) ReturnType() types.T {
+	return types.Unknown
+}
+
+func (b *BindVariable) Eval(stmtCtx *stmtctx.Context, _ datum.Row) (datum.Datum, error) {
+	return stmtCtx.Param(b.Order)
+}
@@ -0,0 +1,347 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+func isNumericType(t types.T) bool {
+	return t == types.Int || t == types.Float || t == types.Decimal
+}
+
+// numericReturnType returns the argument type if it is numeric.
+func numericReturnType(argType types.T) types.T {
+	if isNumericType(argType) {
+		return argType
+	}
+	return types.Unknown
+}
+
+type builtinAbsFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinAbsFunc) InferReturnType(argTypes []types.T) types.T {
+	return numericReturnType(argTypes[0])
+}
+
+func (b builtinAbsFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	switch args[0].Type() {
+	case types.Int:
+		i := datum.AsInt(args[0])
+		if i == math.MinInt64 {
+			return nil, errors.New("integer out of range")
+		}
+		if i < 0 {
+			i = -i
+		}
+		return datum.NewInt(i), nil
+	case types.Float:
+		return datum.NewFloat(math.Abs(datum.AsFloat(args[0]))), nil
+	case types.Decimal:
+		d := (&apd.Decimal{}).Abs(datum.AsDecimal(args[0]))
+		return datum.NewDecimal(d), nil
+	default:
+		return nil, fmt.Errorf("cannot get absolute value of %s", args[0].Type())
+	}
+}
+
+func newBuiltinAbsFunc() Function {
+	return builtinAbsFunc{newBaseBuiltinFunc(1, false)}
+}
+
+// builtinRoundFunc rounds a number to an integral value, which is ceil or floor.
+// The integer is returned as is, and the float or decimal is rounded with the
+// same type.
+type builtinRoundFunc struct {
+	baseBuiltinFunc
+	ceil bool
+}
+
+func (b builtinRoundFunc) InferReturnType(argTypes []types.T) types.T {
+	return numericReturnType(argTypes[0])
+}
+
+func (b builtinRoundFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	switch args[0].Type() {
+	case types.Int:
+		return args[0], nil
+	case types.Float:
+		if b.ceil {
+			return datum.NewFloat(math.Ceil(datum.AsFloat(args[0]))), nil
+		}
+		return datum.NewFloat(math.Floor(datum.AsFloat(args[0]))), nil
+	case types.Decimal:
+		d := &apd.Decimal{}
+		var err error
+		if b.ceil {
+			_, err = apd.BaseContext.Ceil(d, datum.AsDecimal(args[0]))
+		} else {
+			_, err = apd.BaseContext.Floor(d, datum.AsDecimal(args[0]))
+		}
+		if err != nil {
+			return nil, err
+		}
+		return datum.NewDecimal(d), nil
+	default:
+		return nil, fmt.Errorf("cannot round %s", args[0].Type())
+	}
+}
+
+func newBuiltinCeilFunc() Function {
+	return builtinRoundFunc{newBaseBuiltinFunc(1, false), true}
+}
+
+func newBuiltinFloorFunc() Function {
+	return builtinRoundFunc{newBaseBuiltinFunc(1, false), false}
+}
+
+// builtinModFunc is the function form of the modulo operator.
+type builtinModFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinModFunc) InferReturnType(argTypes []types.T) types.T {
+	return binOps[opcode.Mod].InferReturnType(argTypes[0], argTypes[1])
+}
+
+func (b builtinModFunc) Eval(stmtCtx *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	return binOps[opcode.Mod].Eval(stmtCtx, args[0], args[1])
+}
+
+func newBuiltinModFunc() Function {
+	return builtinModFunc{newBaseBuiltinFunc(2, false)}
+}
+
+// builtinCaseFunc converts a string to lower case or upper case.
+type builtinCaseFunc struct {
+	baseBuiltinFunc
+	upper bool
+}
+
+func (b builtinCaseFunc) InferReturnType(_ []types.T) types.T {
+	return types.String
+}
+
+func (b builtinCaseFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	s, err := datum.TryAsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if b.upper {
+		return datum.NewString(strings.ToUpper(s)), nil
+	}
+	return datum.NewString(strings.ToLower(s)), nil
+}
+
+func newBuiltinLowerFunc() Function {
+	return builtinCaseFunc{newBaseBuiltinFunc(1, false), false}
+}
+
+func newBuiltinUpperFunc() Function {
+	return builtinCaseFunc{newBaseBuiltinFunc(1, false), true}
+}
+
+// builtinSubstringFunc returns the substring of a string starting from the
+// 1-based character position, which is substring(str, start[, length]).
+type builtinSubstringFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinSubstringFunc) InferReturnType(_ []types.T) types.T {
+	return types.String
+}
+
+func (b builtinSubstringFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, errors.New("invalid arguments count to call function substring")
+	}
+	s, err := datum.TryAsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	start, err := datum.TryAsInt(args[1])
+	if err != nil {
+		return nil, err
+	}
+	runes := []rune(s)
+	// The positions before the first character are counted in the length as the
+	// SQL standard, e.g: SUBSTRING('abc' FROM 0 FOR 2) is 'a'.
+	begin, end := start-1, int64(len(runes))
+	if len(args) == 3 {
+		length, err := datum.TryAsInt(args[2])
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, errors.New("negative substring length not allowed")
+		}
+		if length < end-begin {
+			end = begin + length
+		}
+	}
+	if begin < 0 {
+		begin = 0
+	}
+	if begin > int64(len(runes)) {
+		begin = int64(len(runes))
+	}
+	if end < begin {
+		end = begin
+	}
+	return datum.NewString(string(runes[begin:end])), nil
+}
+
+func newBuiltinSubstringFunc() Function {
+	return builtinSubstringFunc{newBaseBuiltinFunc(VariadicArgs, false)}
+}
+
+// builtinExtractFunc extracts a field from a datetime value, which is called as
+// extract(field, datetime) and the field is the name of an ast.ExtractField. The
+// seconds are truncated to an integer.
+type builtinExtractFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinExtractFunc) InferReturnType(_ []types.T) types.T {
+	return types.Int
+}
+
+func (b builtinExtractFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	field, err := datum.TryAsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	field = strings.ToUpper(field)
+
+	var (
+		result int
+		ok     bool
+	)
+	switch v := args[1].(type) {
+	case *datum.Date:
+		result, ok = extractDate(field, dateToTime(v))
+	case *datum.Time:
+		result, ok = extractTimeOfDay(field, v.TimeOfDay)
+	case *datum.TimeTZ:
+		result, ok = extractTimeOfDay(field, v.TimeOfDay)
+		if !ok {
+			result, ok = extractTimeZone(field, int(v.OffsetMinutes())*60)
+		}
+	case *datum.Timestamp:
+		result, ok = extractDateTime(field, v.UTC())
+	case *datum.TimestampTZ:
+		result, ok = extractDateTime(field, v.Time)
+		if !ok {
+			_, offset := v.Zone()
+			result, ok = extractTimeZone(field, offset)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("cannot extract %s from %s", field, args[1].Type())
+	}
+	return datum.NewInt(int64(result)), nil
+}
+
+func extractDate(field string, t time.Time) (int, bool) {
+	switch field {
+	case "YEAR":
+		return t.Year(), true
+	case "MONTH":
+		return int(t.Month()), true
+	case "DAY":
+		return t.Day(), true
+	default:
+		return 0, false
+	}
+}
+
+func extractTimeOfDay(field string, t datum.TimeOfDay) (int, bool) {
+	switch field {
+	case "HOUR":
+		return t.Hour(), true
+	case "MINUTE":
+		return t.Minute(), true
+	case "SECOND":
+		return t.Second(), true
+	default:
+		return 0, false
+	}
+}
+
+func extractDateTime(field string, t time.Time) (int, bool) {
+	if result, ok := extractDate(field, t); ok {
+		return result, true
+	}
+	return extractTimeOfDay(field, datum.TimeOfDay(t.Hour()*60*60+t.Minute()*60+t.Second()))
+}
+
+func extractTimeZone(field string, offsetSeconds int) (int, bool) {
+	switch field {
+	case "TIMEZONE_HOUR":
+		return offsetSeconds / (60 * 60), true
+	case "TIMEZONE_MINUTE":
+		return offsetSeconds / 60 % 60, true
+	default:
+		return 0, false
+	}
+}
+
+func newBuiltinExtractFunc() Function {
+	return builtinExtractFunc{newBaseBuiltinFunc(2, false)}
+}
+
+// builtinJavaRegexpLikeFunc reports whether the whole string matches the regular
+// expression. The syntax of regular expression is the RE2 syntax which is mostly
+// compatible with Java, except the backreferences and lookarounds.
+type builtinJavaRegexpLikeFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinJavaRegexpLikeFunc) InferReturnType(_ []types.T) types.T {
+	return types.Bool
+}
+
+func (b builtinJavaRegexpLikeFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	s, err := datum.TryAsString(args[0])
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := datum.TryAsString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %v", pattern, err)
+	}
+	return datum.NewBool(re.MatchString(s)), nil
+}
+
+func newBuiltinJavaRegexpLikeFunc() Function {
+	return builtinJavaRegexpLikeFunc{newBaseBuiltinFunc(2, false)}
+}
@@ -0,0 +1,192 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+// elementLabels returns the lower-case label names of a vertex or edge.
+func elementLabels(d datum.Datum) ([]string, error) {
+	switch x := d.(type) {
+	case *datum.Vertex:
+		return x.Labels, nil
+	case *datum.Edge:
+		return x.Labels, nil
+	default:
+		return nil, fmt.Errorf("cannot get labels from data type %s", d.Type())
+	}
+}
+
+// labelName returns the label name in the original case.
+func labelName(stmtCtx *stmtctx.Context, name string) string {
+	if graph := stmtCtx.CurrentGraph(); graph != nil {
+		if label := graph.Label(name); label != nil {
+			return label.Meta().Name.O
+		}
+	}
+	return name
+}
+
+// builtinLabelFunc returns the label of a vertex or edge which has exactly one
+// label.
+type builtinLabelFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinLabelFunc) InferReturnType(_ []types.T) types.T {
+	return types.String
+}
+
+func (b builtinLabelFunc) Eval(stmtCtx *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	labels, err := elementLabels(args[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) != 1 {
+		return nil, fmt.Errorf("cannot get the label of element with %d labels", len(labels))
+	}
+	return datum.NewString(labelName(stmtCtx, labels[0])), nil
+}
+
+func newBuiltinLabelFunc() Function {
+	return builtinLabelFunc{newBaseBuiltinFunc(1, false)}
+}
+
+// builtinLabelsFunc returns the labels of a vertex or edge as a list, which is
+// sorted by the label names.
+type builtinLabelsFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinLabelsFunc) InferReturnType(_ []types.T) types.T {
+	return types.List
+}
+
+func (b builtinLabelsFunc) Eval(stmtCtx *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	labels, err := elementLabels(args[0])
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, labelName(stmtCtx, label))
+	}
+	sort.Strings(names)
+	elems := make([]datum.Datum, 0, len(names))
+	for _, name := range names {
+		elems = append(elems, datum.NewString(name))
+	}
+	return datum.NewList(elems), nil
+}
+
+func newBuiltinLabelsFunc() Function {
+	return builtinLabelsFunc{newBaseBuiltinFunc(1, false)}
+}
+
+// builtinHasLabelFunc reports whether a vertex or edge has the label, and the
+// label name is case-insensitive.
+type builtinHasLabelFunc struct {
+	baseBuiltinFunc
+}
+
+func (b builtinHasLabelFunc) InferReturnType(_ []types.T) types.T {
+	return types.Bool
+}
+
+func (b builtinHasLabelFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	labels, err := elementLabels(args[0])
+	if err != nil {
+		return nil, err
+	}
+	name, err := datum.TryAsString(args[1])
+	if err != nil {
+		return nil, err
+	}
+	name = strings.ToLower(name)
+	for _, label := range labels {
+		if label == name {
+			return datum.NewBool(true), nil
+		}
+	}
+	return datum.NewBool(false), nil
+}
+
+func newBuiltinHasLabelFunc() Function {
+	return builtinHasLabelFunc{newBaseBuiltinFunc(2, false)}
+}
+
+// builtinDegreeFunc returns the number of incoming or outgoing edges of a vertex,
+// which is read from the degree counter maintained with the edges.
+type builtinDegreeFunc struct {
+	baseBuiltinFunc
+	incoming bool
+}
+
+func (b builtinDegreeFunc) InferReturnType(_ []types.T) types.T {
+	return types.Int
+}
+
+func (b builtinDegreeFunc) Eval(stmtCtx *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	vertex, err := datum.TryAsVertex(args[0])
+	if err != nil {
+		return nil, err
+	}
+	graph := stmtCtx.CurrentGraph()
+	if graph == nil {
+		return nil, errors.New("no graph selected")
+	}
+	txn, err := stmtCtx.Txn().Activate()
+	if err != nil {
+		return nil, err
+	}
+
+	var key kv.Key
+	if b.incoming {
+		key = codec.IncomingDegreeKey(graph.Meta().ID, vertex.ID)
+	} else {
+		key = codec.OutgoingDegreeKey(graph.Meta().ID, vertex.ID)
+	}
+	val, err := txn.Get(context.Background(), key)
+	if err != nil {
+		if kv.IsErrNotFound(err) {
+			return datum.NewInt(0), nil
+		}
+		return nil, err
+	}
+	degree, err := codec.DecodeDegree(val)
+	if err != nil {
+		return nil, err
+	}
+	return datum.NewInt(degree), nil
+}
+
+func newBuiltinInDegreeFunc() Function {
+	return builtinDegreeFunc{newBaseBuiltinFunc(1, false), true}
+}
+
+func newBuiltinOutDegreeFunc() Function {
+	return builtinDegreeFunc{newBaseBuiltinFunc(1, false), false}
+}
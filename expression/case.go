@@ -0,0 +1,112 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &CaseExpr{}
+
+// CaseExpr represents the simple CASE expression if Value is not nil, which
+// compares the value with the WHEN expressions, otherwise it is the searched CASE
+// expression which evaluates the WHEN conditions. The result of the first matched
+// WHEN clause is returned, or the ELSE result (NULL if omitted) if none matches.
+type CaseExpr struct {
+	Value       Expression
+	WhenClauses []*WhenClause
+	ElseClause  Expression
+}
+
+// WhenClause represents a WHEN ... THEN ... clause of CASE expression.
+type WhenClause struct {
+	Expr   Expression
+	Result Expression
+}
+
+func (c *CaseExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString("CASE")
+	if c.Value != nil {
+		sb.WriteString(" " + c.Value.String())
+	}
+	for _, w := range c.WhenClauses {
+		sb.WriteString(" WHEN " + w.Expr.String() + " THEN " + w.Result.String())
+	}
+	if c.ElseClause != nil {
+		sb.WriteString(" ELSE " + c.ElseClause.String())
+	}
+	sb.WriteString(" END")
+	return sb.String()
+}
+
+// ReturnType returns the type of the first result whose type is known.
+func (c *CaseExpr) ReturnType() types.T {
+	for _, w := range c.WhenClauses {
+		if t := w.Result.ReturnType(); t != types.Unknown {
+			return t
+		}
+	}
+	if c.ElseClause != nil {
+		return c.ElseClause.ReturnType()
+	}
+	return types.Unknown
+}
+
+func (c *CaseExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	var value datum.Datum
+	if c.Value != nil {
+		d, err := c.Value.Eval(stmtCtx, input)
+		if err != nil {
+			return nil, err
+		}
+		value = d
+	}
+	for _, w := range c.WhenClauses {
+		d, err := w.Expr.Eval(stmtCtx, input)
+		if err != nil {
+			return nil, err
+		}
+		if c.Value != nil {
+			// The NULL value never matches.
+			if value == datum.Null || d == datum.Null {
+				continue
+			}
+			d, err = binOps[opcode.EQ].Eval(stmtCtx, value, d)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if d == datum.Null {
+			continue
+		}
+		matched, err := datum.TryAsBool(d)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return w.Result.Eval(stmtCtx, input)
+		}
+	}
+	if c.ElseClause != nil {
+		return c.ElseClause.Eval(stmtCtx, input)
+	}
+	return datum.Null, nil
+}
@@ -15,7 +15,12 @@
 package expression
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/apd/v3"
 	"github.com/simbiont-runtime/graphengine/datum"
@@ -25,6 +30,8 @@ import (
 
 var _ Expression = &CastExpr{}
 
+const secondsPerDay = 24 * 60 * 60
+
 type CastExpr struct {
 	Expr Expression
 	Type types.T
@@ -50,31 +57,144 @@ func (c *CastExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum,
 	if err != nil || d == datum.Null {
 		return d, err
 	}
+	if d.Type() == c.Type {
+		return d, nil
+	}
 	// Cast the datum to the desired type.
 	// See https://pgql-lang.org/spec/1.5/#cast for supported casts.
-	switch c.Type {
-	case types.Bool:
-		v, err := datum.TryAsBool(d)
-		if err != nil {
-			return nil, err
-		}
-		return datum.NewBool(v), nil
-	case types.Int:
-	case types.Float:
-	case types.Decimal:
-	case types.String:
-	case types.Date:
-	case types.Time:
-	case types.TimeTZ:
-	case types.Timestamp:
-	case types.TimestampTZ:
-	case types.Interval:
-	}
-	return nil, fmt.Errorf("unsupported cast: %s -> %s", d.Type(), c.Type)
+	cast, ok := castFuncs[typePair{d.Type(), c.Type}]
+	if !ok {
+		return nil, fmt.Errorf("unsupported cast: %s -> %s", d.Type(), c.Type)
+	}
+	return cast(stmtCtx, d)
 }
 
 type castFunc func(stmtCtx *stmtctx.Context, input datum.Datum) (datum.Datum, error)
 
+// castFuncs are the casts between different types, which are keyed by the source
+// type and the target type. The datetime values without time zone are regarded
+// as UTC values.
+var castFuncs = func() map[typePair]castFunc {
+	funcs := map[typePair]castFunc{
+		{types.String, types.Bool}:        castStringAsBool,
+		{types.String, types.Int}:         castStringAsInt,
+		{types.String, types.Float}:       castStringAsFloat,
+		{types.String, types.Decimal}:     castStringAsDecimal,
+		{types.String, types.Bytes}:       castStringAsBytes,
+		{types.String, types.Date}:        castStringAsDate,
+		{types.String, types.Time}:        castStringAsTime,
+		{types.String, types.TimeTZ}:      castStringAsTimeTZ,
+		{types.String, types.Timestamp}:   castStringAsTimestamp,
+		{types.String, types.TimestampTZ}: castStringAsTimestampTZ,
+		{types.String, types.Interval}:    castStringAsInterval,
+		{types.Bool, types.String}:        castBoolAsString,
+		{types.Bytes, types.String}:       castBytesAsString,
+
+		{types.Int, types.Float}:     castIntAsFloat,
+		{types.Int, types.Decimal}:   castIntAsDecimal,
+		{types.Float, types.Int}:     castFloatAsInt,
+		{types.Float, types.Decimal}: castFloatAsDecimal,
+		{types.Decimal, types.Int}:   castDecimalAsInt,
+		{types.Decimal, types.Float}: castDecimalAsFloat,
+
+		{types.Date, types.Timestamp}:        castDateAsTimestamp,
+		{types.Date, types.TimestampTZ}:      castDateAsTimestampTZ,
+		{types.Time, types.TimeTZ}:           castTimeAsTimeTZ,
+		{types.TimeTZ, types.Time}:           castTimeTZAsTime,
+		{types.Timestamp, types.Date}:        castTimestampAsDate,
+		{types.Timestamp, types.Time}:        castTimestampAsTime,
+		{types.Timestamp, types.TimeTZ}:      castTimestampAsTimeTZ,
+		{types.Timestamp, types.TimestampTZ}: castTimestampAsTimestampTZ,
+		{types.TimestampTZ, types.Date}:      castTimestampTZAsDate,
+		{types.TimestampTZ, types.Time}:      castTimestampTZAsTime,
+		{types.TimestampTZ, types.TimeTZ}:    castTimestampTZAsTimeTZ,
+		{types.TimestampTZ, types.Timestamp}: castTimestampTZAsTimestamp,
+	}
+	// All values except NULL can be converted to strings.
+	for _, t := range []types.T{
+		types.Int, types.Float, types.Decimal, types.Date, types.Time, types.TimeTZ, types.Timestamp,
+		types.TimestampTZ, types.Interval, types.Vertex, types.Edge, types.List, types.Map,
+	} {
+		funcs[typePair{t, types.String}] = castAsString
+	}
+	return funcs
+}()
+
+func castAsString(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewString(input.String()), nil
+}
+
+func castBoolAsString(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewString(strconv.FormatBool(datum.AsBool(input))), nil
+}
+
+func castStringAsBool(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	s := strings.TrimSpace(datum.AsString(input))
+	switch strings.ToLower(s) {
+	case "true":
+		return datum.NewBool(true), nil
+	case "false":
+		return datum.NewBool(false), nil
+	default:
+		return nil, fmt.Errorf("could not parse %q as Bool", s)
+	}
+}
+
+func castStringAsInt(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	s := strings.TrimSpace(datum.AsString(input))
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q as Int", s)
+	}
+	return datum.NewInt(i), nil
+}
+
+func castStringAsFloat(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	s := strings.TrimSpace(datum.AsString(input))
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q as Float", s)
+	}
+	return datum.NewFloat(f), nil
+}
+
+func castStringAsDecimal(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	s := strings.TrimSpace(datum.AsString(input))
+	d, err := datum.ParseDecimal(s)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %q as Decimal", s)
+	}
+	return d, nil
+}
+
+func castStringAsBytes(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewBytes([]byte(datum.AsString(input))), nil
+}
+
+func castStringAsDate(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseDate(strings.TrimSpace(datum.AsString(input)))
+}
+
+func castStringAsTime(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseTime(strings.TrimSpace(datum.AsString(input)))
+}
+
+func castStringAsTimeTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseTimeTZ(strings.TrimSpace(datum.AsString(input)))
+}
+
+func castStringAsTimestamp(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseTimestamp(strings.TrimSpace(datum.AsString(input)))
+}
+
+func castStringAsTimestampTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseTimestampTZ(strings.TrimSpace(datum.AsString(input)))
+}
+
+func castStringAsInterval(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.ParseInterval(datum.AsString(input))
+}
+
 func castIntAsFloat(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
 	i := datum.AsInt(input)
 	return datum.NewFloat(float64(i)), nil
@@ -87,22 +207,128 @@ func castIntAsDecimal(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error
 	return datum.NewDecimal(d), nil
 }
 
+// castFloatAsInt truncates the float toward zero.
+func castFloatAsInt(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	f := math.Trunc(datum.AsFloat(input))
+	if math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return nil, errors.New("integer out of range")
+	}
+	return datum.NewInt(int64(f)), nil
+}
+
 func castFloatAsDecimal(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
 	f := datum.AsFloat(input)
 	d := &apd.Decimal{}
-	d.SetInt64(int64(f))
+	if _, err := d.SetFloat64(f); err != nil {
+		return nil, err
+	}
 	return datum.NewDecimal(d), nil
 }
 
+// castDecimalAsInt truncates the decimal toward zero.
+func castDecimalAsInt(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	ctx := apd.BaseContext
+	ctx.Rounding = apd.RoundDown
+	d := &apd.Decimal{}
+	if _, err := ctx.RoundToIntegralValue(d, datum.AsDecimal(input)); err != nil {
+		return nil, err
+	}
+	i, err := d.Int64()
+	if err != nil {
+		return nil, errors.New("integer out of range")
+	}
+	return datum.NewInt(i), nil
+}
+
+func castDecimalAsFloat(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	f, err := datum.AsDecimal(input).Float64()
+	if err != nil {
+		return nil, err
+	}
+	return datum.NewFloat(f), nil
+}
+
 func castBytesAsString(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
 	b := datum.AsBytes(input)
 	return datum.NewString(string(b)), nil
 }
 
+func dateToTime(d *datum.Date) time.Time {
+	return time.Unix(int64(d.UnixEpochDays())*secondsPerDay, 0).UTC()
+}
+
+func timeToDate(t time.Time) *datum.Date {
+	days := t.Unix() / secondsPerDay
+	if t.Unix() < 0 && t.Unix()%secondsPerDay != 0 {
+		days--
+	}
+	return datum.NewDateFromUnixEpochDays(int32(days))
+}
+
+func timeOfDay(t time.Time) datum.TimeOfDay {
+	return datum.TimeOfDay(t.Hour()*60*60 + t.Minute()*60 + t.Second())
+}
+
+func castDateAsTimestamp(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return &datum.Timestamp{Time: dateToTime(datum.AsDate(input))}, nil
+}
+
+func castDateAsTimestampTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return &datum.TimestampTZ{Time: dateToTime(datum.AsDate(input))}, nil
+}
+
 func castTimeAsTimeTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
-	panic("unimplemented")
+	return datum.NewTimeTZ(datum.AsTime(input).TimeOfDay, 0), nil
+}
+
+// castTimeTZAsTime converts the time to UTC and drops the time zone.
+func castTimeTZAsTime(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewTime(utcTimeOfDay(datum.AsTimeTZ(input))), nil
+}
+
+func castTimestampAsDate(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return timeToDate(datum.AsTimestamp(input).UTC()), nil
+}
+
+func castTimestampAsTime(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewTime(timeOfDay(datum.AsTimestamp(input).UTC())), nil
+}
+
+func castTimestampAsTimeTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewTimeTZ(timeOfDay(datum.AsTimestamp(input).UTC()), 0), nil
 }
 
 func castTimestampAsTimestampTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
-	panic("unimplemented")
+	return &datum.TimestampTZ{Time: datum.AsTimestamp(input).UTC()}, nil
+}
+
+// castTimestampTZAsDate returns the date in the time zone of the timestamp.
+func castTimestampTZAsDate(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	t := datum.AsTimestampTZ(input).Time
+	return timeToDate(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)), nil
+}
+
+// castTimestampTZAsTime returns the time of day in the time zone of the timestamp.
+func castTimestampTZAsTime(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return datum.NewTime(timeOfDay(datum.AsTimestampTZ(input).Time)), nil
+}
+
+func castTimestampTZAsTimeTZ(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	t := datum.AsTimestampTZ(input).Time
+	_, offset := t.Zone()
+	return datum.NewTimeTZ(timeOfDay(t), int32(offset/60)), nil
+}
+
+// castTimestampTZAsTimestamp converts the timestamp to UTC and drops the time zone.
+func castTimestampTZAsTimestamp(_ *stmtctx.Context, input datum.Datum) (datum.Datum, error) {
+	return &datum.Timestamp{Time: datum.AsTimestampTZ(input).UTC()}, nil
+}
+
+// utcTimeOfDay returns the time of day in UTC.
+func utcTimeOfDay(t *datum.TimeTZ) datum.TimeOfDay {
+	seconds := (int64(t.TimeOfDay) - int64(t.OffsetMinutes())*60) % secondsPerDay
+	if seconds < 0 {
+		seconds += secondsPerDay
+	}
+	return datum.TimeOfDay(seconds)
 }
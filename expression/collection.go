@@ -0,0 +1,139 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var (
+	_ Expression = &ListExpr{}
+	_ Expression = &MapExpr{}
+	_ Expression = &SubscriptExpr{}
+)
+
+// ListExpr constructs a list from the values of its elements, e.g: [1, 2, 3].
+type ListExpr struct {
+	Elems []Expression
+}
+
+func (e *ListExpr) String() string {
+	elems := make([]string, 0, len(e.Elems))
+	for _, expr := range e.Elems {
+		elems = append(elems, expr.String())
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+func (e *ListExpr) ReturnType() types.T {
+	return types.List
+}
+
+func (e *ListExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	elems := make([]datum.Datum, 0, len(e.Elems))
+	for _, expr := range e.Elems {
+		elem, err := expr.Eval(stmtCtx, input)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return datum.NewList(elems), nil
+}
+
+// MapExpr constructs a map from the keys and the values of its entries, e.g:
+// {'color': 'red', 'size': 3}. The latter value wins if a key is duplicated.
+type MapExpr struct {
+	Keys   []string
+	Values []Expression
+}
+
+func (e *MapExpr) String() string {
+	entries := make([]string, 0, len(e.Keys))
+	for i, key := range e.Keys {
+		entries = append(entries, key+": "+e.Values[i].String())
+	}
+	return "{" + strings.Join(entries, ", ") + "}"
+}
+
+func (e *MapExpr) ReturnType() types.T {
+	return types.Map
+}
+
+func (e *MapExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	entries := make(map[string]datum.Datum, len(e.Keys))
+	for i, key := range e.Keys {
+		value, err := e.Values[i].Eval(stmtCtx, input)
+		if err != nil {
+			return nil, err
+		}
+		entries[key] = value
+	}
+	return datum.NewMap(entries), nil
+}
+
+// SubscriptExpr accesses an element of a list by the 0-based index, or an entry
+// of a map by the key. The result is NULL if the element doesn't exist.
+type SubscriptExpr struct {
+	Expr  Expression
+	Index Expression
+}
+
+func (e *SubscriptExpr) String() string {
+	return fmt.Sprintf("%s[%s]", e.Expr, e.Index)
+}
+
+func (e *SubscriptExpr) ReturnType() types.T {
+	return types.Unknown
+}
+
+func (e *SubscriptExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	d, err := e.Expr.Eval(stmtCtx, input)
+	if err != nil || d == datum.Null {
+		return d, err
+	}
+	index, err := e.Index.Eval(stmtCtx, input)
+	if err != nil || index == datum.Null {
+		return index, err
+	}
+
+	switch d.Type() {
+	case types.List:
+		if index.Type() != types.Int {
+			return nil, fmt.Errorf("cannot access list element by %s", index.Type())
+		}
+		list, i := datum.AsList(d), datum.AsInt(index)
+		if i < 0 || i >= int64(len(list)) {
+			return datum.Null, nil
+		}
+		return list[i], nil
+	case types.Map:
+		if index.Type() != types.String {
+			return nil, fmt.Errorf("cannot access map entry by %s", index.Type())
+		}
+		value, ok := datum.AsMap(d)[datum.AsString(index)]
+		if !ok {
+			return datum.Null, nil
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("cannot access element of %s", d.Type())
+	}
+}
@@ -0,0 +1,55 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &CorrelatedColumn{}
+
+// CorrelatedColumn references a column of an outer query in a correlated
+// subquery. The Depth is the number of query levels to the outer query, and the
+// value is read from the outer row being evaluated instead of the input row.
+type CorrelatedColumn struct {
+	Depth int
+	Index int
+	Name  model.CIStr
+	Type  types.T
+}
+
+func (c *CorrelatedColumn) String() string {
+	return "outer." + c.Name.O
+}
+
+func (c *CorrelatedColumn) ReturnType() types.T {
+	return c.Type
+}
+
+func (c *CorrelatedColumn) Eval(stmtCtx *stmtctx.Context, _ datum.Row) (datum.Datum, error) {
+	row, err := stmtCtx.OuterRow(c.Depth)
+	if err != nil {
+		return nil, err
+	}
+	if c.Index >= len(row) {
+		return nil, fmt.Errorf("column index %d out of outer row length %d", c.Index, len(row))
+	}
+	return row[c.Index], nil
+}
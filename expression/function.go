@@ -17,6 +17,7 @@ package expression
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/stmtctx"
@@ -35,10 +36,11 @@ func (expr *FuncExpr) String() string {
 	sb := &strings.Builder{}
 	sb.WriteString(expr.Name)
 	sb.WriteByte('(')
-	sb.WriteString(expr.Args[0].String())
-	for i := 1; i < len(expr.Args); i++ {
-		sb.WriteString(", ")
-		sb.WriteString(expr.Args[i].String())
+	for i, arg := range expr.Args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(arg.String())
 	}
 	sb.WriteByte(')')
 	return sb.String()
@@ -79,11 +81,11 @@ func (expr *FuncExpr) evalFuncArgs(stmtCtx *stmtctx.Context, input datum.Row) (_
 }
 
 func NewFuncExpr(name string, args ...Expression) (*FuncExpr, error) {
-	fn, ok := builtinFuncs[name]
+	fn, ok := LookupFunction(name)
 	if !ok {
 		return nil, fmt.Errorf("function %s not found", name)
 	}
-	if fn.NumArgs() != len(args) {
+	if numArgs := fn.NumArgs(); numArgs != VariadicArgs && numArgs != len(args) {
 		return nil, fmt.Errorf("invalid arguments count to call function %s", name)
 	}
 	return &FuncExpr{
@@ -93,15 +95,93 @@ func NewFuncExpr(name string, args ...Expression) (*FuncExpr, error) {
 	}, nil
 }
 
+// VariadicArgs is the number of arguments of a function which accepts a variable
+// number of arguments. The function checks the arguments by itself.
+const VariadicArgs = -1
+
+// Function is a scalar function which can be called in queries by name.
 type Function interface {
+	// NumArgs returns the number of arguments, or VariadicArgs.
 	NumArgs() int
+	// InferReturnType returns the result type of calling the function with the
+	// argument types. It returns types.Unknown if the type cannot be inferred.
 	InferReturnType(argTypes []types.T) types.T
+	// CallOnNullInput reports whether the function is called if any argument is
+	// NULL. Otherwise, the result is NULL without calling the function.
 	CallOnNullInput() bool
 	Eval(stmtCtx *stmtctx.Context, args []datum.Datum) (datum.Datum, error)
 }
 
-var builtinFuncs = map[string]Function{
-	"id": newBuiltIDFunc(),
+var (
+	funcsMu sync.RWMutex
+	funcs   = map[string]Function{
+		"id":               newBuiltIDFunc(),
+		"abs":              newBuiltinAbsFunc(),
+		"ceil":             newBuiltinCeilFunc(),
+		"ceiling":          newBuiltinCeilFunc(),
+		"floor":            newBuiltinFloorFunc(),
+		"mod":              newBuiltinModFunc(),
+		"lower":            newBuiltinLowerFunc(),
+		"upper":            newBuiltinUpperFunc(),
+		"substring":        newBuiltinSubstringFunc(),
+		"extract":          newBuiltinExtractFunc(),
+		"java_regexp_like": newBuiltinJavaRegexpLikeFunc(),
+		"label":            newBuiltinLabelFunc(),
+		"labels":           newBuiltinLabelsFunc(),
+		"has_label":        newBuiltinHasLabelFunc(),
+		"in_degree":        newBuiltinInDegreeFunc(),
+		"out_degree":       newBuiltinOutDegreeFunc(),
+	}
+)
+
+// RegisterFunction registers a function which can be called in queries by the
+// name. The name is case-insensitive, and it must be an identifier which is not
+// a keyword. It fails if a function with the same name exists.
+func RegisterFunction(name string, fn Function) error {
+	name = strings.ToLower(name)
+
+	funcsMu.Lock()
+	defer funcsMu.Unlock()
+
+	if _, ok := funcs[name]; ok {
+		return fmt.Errorf("function %s already exists", name)
+	}
+	funcs[name] = fn
+	return nil
+}
+
+// LookupFunction returns the function registered with the name.
+func LookupFunction(name string) (Function, bool) {
+	funcsMu.RLock()
+	defer funcsMu.RUnlock()
+
+	fn, ok := funcs[strings.ToLower(name)]
+	return fn, ok
+}
+
+// NewFunction returns a function which calls the Go function with a fixed number
+// of arguments. The result is NULL without calling the Go function if any argument
+// is NULL.
+func NewFunction(numArgs int, returnType types.T, fn func(args []datum.Datum) (datum.Datum, error)) Function {
+	return goFunc{
+		baseBuiltinFunc: newBaseBuiltinFunc(numArgs, false),
+		returnType:      returnType,
+		fn:              fn,
+	}
+}
+
+type goFunc struct {
+	baseBuiltinFunc
+	returnType types.T
+	fn         func(args []datum.Datum) (datum.Datum, error)
+}
+
+func (f goFunc) InferReturnType(_ []types.T) types.T {
+	return f.returnType
+}
+
+func (f goFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum, error) {
+	return f.fn(args)
 }
 
 type baseBuiltinFunc struct {
@@ -134,8 +214,7 @@ func (b builtinIDFunc) Eval(_ *stmtctx.Context, args []datum.Datum) (datum.Datum
 	case *datum.Vertex:
 		return datum.NewInt(x.ID), nil
 	case *datum.Edge:
-		// TODO: Edge should have a unique id.
-		return datum.NewInt(0), nil
+		return datum.NewInt(x.ID), nil
 	default:
 		return nil, fmt.Errorf("cannot get id from data type %s", args[0].Type())
 	}
@@ -0,0 +1,105 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &InExpr{}
+
+// InExpr represents the [NOT] IN predicate on a list of values, or on the
+// elements of a list value if Sel is not nil. As the SQL standard, the result is
+// NULL if the value is NULL, or the value is not found and the list contains NULL.
+type InExpr struct {
+	Expr Expression
+	List []Expression
+	Sel  Expression
+	Not  bool
+}
+
+func (e *InExpr) String() string {
+	op := " IN "
+	if e.Not {
+		op = " NOT IN "
+	}
+	if e.Sel != nil {
+		return e.Expr.String() + op + e.Sel.String()
+	}
+	elems := make([]string, 0, len(e.List))
+	for _, expr := range e.List {
+		elems = append(elems, expr.String())
+	}
+	return e.Expr.String() + op + "(" + strings.Join(elems, ", ") + ")"
+}
+
+func (e *InExpr) ReturnType() types.T {
+	return types.Bool
+}
+
+func (e *InExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	d, err := e.Expr.Eval(stmtCtx, input)
+	if err != nil || d == datum.Null {
+		return d, err
+	}
+	if e.Sel != nil {
+		sel, err := e.Sel.Eval(stmtCtx, input)
+		if err != nil || sel == datum.Null {
+			return sel, err
+		}
+		list, err := datum.TryAsList(sel)
+		if err != nil {
+			return nil, err
+		}
+		return e.contains(stmtCtx, d, len(list), func(i int) (datum.Datum, error) {
+			return list[i], nil
+		})
+	}
+	return e.contains(stmtCtx, d, len(e.List), func(i int) (datum.Datum, error) {
+		return e.List[i].Eval(stmtCtx, input)
+	})
+}
+
+// contains checks whether the value is equal to one of the n elements, and the
+// elements are evaluated lazily until the value is found.
+func (e *InExpr) contains(stmtCtx *stmtctx.Context, d datum.Datum, n int, elemAt func(i int) (datum.Datum, error)) (datum.Datum, error) {
+	hasNull := false
+	for i := 0; i < n; i++ {
+		elem, err := elemAt(i)
+		if err != nil {
+			return nil, err
+		}
+		if elem == datum.Null {
+			hasNull = true
+			continue
+		}
+		eq, err := binOps[opcode.EQ].Eval(stmtCtx, d, elem)
+		if err != nil {
+			return nil, err
+		}
+		if datum.AsBool(eq) {
+			return datum.NewBool(!e.Not), nil
+		}
+	}
+	if hasNull {
+		return datum.Null, nil
+	}
+	return datum.NewBool(e.Not), nil
+}
@@ -0,0 +1,50 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+var _ Expression = &IsNullExpr{}
+
+// IsNullExpr represents the IS [NOT] NULL predicate.
+type IsNullExpr struct {
+	Expr Expression
+	Not  bool
+}
+
+func (e *IsNullExpr) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s IS NOT NULL", e.Expr)
+	}
+	return fmt.Sprintf("%s IS NULL", e.Expr)
+}
+
+func (e *IsNullExpr) ReturnType() types.T {
+	return types.Bool
+}
+
+func (e *IsNullExpr) Eval(stmtCtx *stmtctx.Context, input datum.Row) (datum.Datum, error) {
+	d, err := e.Expr.Eval(stmtCtx, input)
+	if err != nil {
+		return nil, err
+	}
+	return datum.NewBool((d == datum.Null) != e.Not), nil
+}
@@ -0,0 +1,68 @@
+//  Copyright 2023  GraphEngine Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+// Walk traverses the expression in pre-order, and the children of an expression
+// are skipped if visit returns false.
+func Walk(expr Expression, visit func(expr Expression) bool) {
+	if expr == nil || !visit(expr) {
+		return
+	}
+	for _, child := range children(expr) {
+		Walk(child, visit)
+	}
+}
+
+// children returns the sub-expressions of the expression.
+func children(expr Expression) []Expression {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return []Expression{e.Left, e.Right}
+	case *UnaryExpr:
+		return []Expression{e.Expr}
+	case *PropertyAccess:
+		return []Expression{e.Expr}
+	case *CastExpr:
+		return []Expression{e.Expr}
+	case *IsNullExpr:
+		return []Expression{e.Expr}
+	case *InExpr:
+		result := append([]Expression{e.Expr}, e.List...)
+		if e.Sel != nil {
+			result = append(result, e.Sel)
+		}
+		return result
+	case *ListExpr:
+		return e.Elems
+	case *MapExpr:
+		return e.Values
+	case *SubscriptExpr:
+		return []Expression{e.Expr, e.Index}
+	case *CaseExpr:
+		result := []Expression{e.Value}
+		for _, w := range e.WhenClauses {
+			result = append(result, w.Expr, w.Result)
+		}
+		return append(result, e.ElseClause)
+	case *FuncExpr:
+		return e.Args
+	case *AggregateExpr:
+		return e.Args
+	case *HorizontalAggregateExpr:
+		return e.Args
+	default:
+		return nil
+	}
+}
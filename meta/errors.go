@@ -2,7 +2,13 @@
 
 package meta
 
-import "github.com/pingcap/errors"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
 
 var (
 	// ErrGraphExists is the error for db exists.
@@ -18,3 +24,36 @@ var (
 	ErrPropertyNotExists = errors.New("property not exists")
 	ErrNoGraphSelected   = errors.New("no graph selected")
 )
+
+// ErrDuplicateKey is the error when a vertex violates the unique constraint of
+// an index, which names the label and properties of the index and the vertex
+// which already has the same values.
+type ErrDuplicateKey struct {
+	Index      string
+	Label      string
+	Properties []string
+	VertexID   int64
+}
+
+// NewErrDuplicateKey returns the error of the unique index whose key is already
+// taken by the vertex.
+func NewErrDuplicateKey(index *model.IndexInfo, vertexID int64) *ErrDuplicateKey {
+	props := make([]string, 0, len(index.Properties))
+	for _, prop := range index.Properties {
+		props = append(props, prop.O)
+	}
+	return &ErrDuplicateKey{
+		Index:      index.Name.O,
+		Label:      index.Label.O,
+		Properties: props,
+		VertexID:   vertexID,
+	}
+}
+
+func (e *ErrDuplicateKey) Error() string {
+	target := fmt.Sprintf("(%s)", strings.Join(e.Properties, ", "))
+	if e.Label != "" {
+		target = e.Label + target
+	}
+	return fmt.Sprintf("duplicate key for unique index %s on %s: conflicts with vertex %d", e.Index, target, e.VertexID)
+}
@@ -0,0 +1,21 @@
+// --- #
+
+package meta
+
+import (
+	"strconv"
+
+	"github.com/pingcap/errors"
+)
+
+// StorageFormat gets the version of the storage format of the data, which is
+// zero if the version has not been recorded.
+func (m *Meta) StorageFormat() (int64, error) {
+	version, err := m.txn.GetInt64(mStorageFormat)
+	return version, errors.Trace(err)
+}
+
+// SetStorageFormat records the version of the storage format of the data.
+func (m *Meta) SetStorageFormat(version int64) error {
+	return m.txn.Set(mStorageFormat, []byte(strconv.FormatInt(version, 10)))
+}
@@ -0,0 +1,36 @@
+// --- #
+
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/storage"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageFormat(t *testing.T) {
+	assert := assert.New(t)
+	store, err := storage.Open(t.TempDir())
+	assert.Nil(err)
+
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		version, err := meta.StorageFormat()
+		assert.Nil(err)
+		assert.Zero(version)
+		return meta.SetStorageFormat(2)
+	})
+	assert.Nil(err)
+
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		version, err := meta.StorageFormat()
+		assert.Nil(err)
+		assert.Equal(int64(2), version)
+		return nil
+	})
+	assert.Nil(err)
+}
@@ -21,6 +21,8 @@ var (
 	mGraphPrefix     = "graph"
 	mLabelPrefix     = "label"
 	mPropertyPrefix  = "property"
+	mStatsKey        = []byte("stats")
+	mStorageFormat   = []byte("storage_format")
 )
 
 const (
@@ -0,0 +1,45 @@
+// --- #
+
+package meta
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
+
+// UpdateGraphStats updates the statistics of graph.
+func (m *Meta) UpdateGraphStats(graphID int64, stats *model.GraphStats) error {
+	// Check if graph exists.
+	graphKey := m.graphKey(graphID)
+	if err := m.checkGraphExists(graphKey); err != nil {
+		return errors.Trace(err)
+	}
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return m.txn.HSet(graphKey, mStatsKey, data)
+}
+
+// GetGraphStats gets the statistics of graph, which is nil if the graph has not
+// been analyzed.
+func (m *Meta) GetGraphStats(graphID int64) (*model.GraphStats, error) {
+	// Check if graph exists.
+	graphKey := m.graphKey(graphID)
+	if err := m.checkGraphExists(graphKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	value, err := m.txn.HGet(graphKey, mStatsKey)
+	if err != nil || value == nil {
+		return nil, errors.Trace(err)
+	}
+
+	stats := &model.GraphStats{}
+	err = json.Unmarshal(value, stats)
+	return stats, errors.Trace(err)
+}
@@ -0,0 +1,77 @@
+// --- #
+
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/storage"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphStats(t *testing.T) {
+	assert := assert.New(t)
+	store, err := storage.Open(t.TempDir())
+	assert.Nil(err)
+
+	var graphID = int64(100)
+
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		info := &model.GraphInfo{
+			ID:   graphID,
+			Name: model.NewCIStr("test-graph"),
+		}
+		return meta.CreateGraph(info)
+	})
+	assert.Nil(err)
+
+	// The graph has not been analyzed.
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		stats, err := meta.GetGraphStats(graphID)
+		assert.Nil(err)
+		assert.Nil(stats)
+		return nil
+	})
+	assert.Nil(err)
+
+	stats := &model.GraphStats{
+		VertexCount: 3,
+		EdgeCount:   2,
+		Labels:      []*model.LabelStats{{ID: 1, VertexCount: 2}},
+		LabelPairs:  []*model.LabelPairStats{{SrcID: 1, DstID: 0, Count: 2}},
+		OutDegree:   model.NewHistogram([]float64{0, 1, 1}, 4),
+		VertexProperties: []*model.PropertyStats{
+			{ID: 1, Count: 3, NDV: 2, Histogram: model.NewHistogram([]float64{10, 20, 20}, 4)},
+		},
+	}
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		return meta.UpdateGraphStats(graphID, stats)
+	})
+	assert.Nil(err)
+
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		got, err := meta.GetGraphStats(graphID)
+		assert.Nil(err)
+		assert.Equal(stats, got)
+
+		// The statistics are not listed as labels.
+		labels, err := meta.ListLabels(graphID)
+		assert.Nil(err)
+		assert.Empty(labels)
+		return nil
+	})
+	assert.Nil(err)
+
+	err = kv.TxnContext(context.TODO(), store, func(_ context.Context, txn kv.Transaction) error {
+		meta := New(txn)
+		return meta.UpdateGraphStats(graphID+1, stats)
+	})
+	assert.ErrorIs(err, ErrGraphNotExists)
+}
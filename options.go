@@ -2,6 +2,11 @@
 
 package graphengine
 
+import (
+	"github.com/simbiont-runtime/graphengine/session"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
+)
+
 const defaultConcurrency = 512
 
 //	Options contains some options which is used to customize the  GraphEngine database
@@ -11,6 +16,15 @@ type Options struct {
 	// Concurrency is used to limit the max concurrent sessions count. The NewSession
 	// method will block if the current alive sessions count reach this limitation.
 	Concurrency int64
+
+	// MemQuotaSort is the memory quota (in bytes) of a sort operator. The sort
+	// operator will spill the rows to disk if the quota is exceeded.
+	MemQuotaSort int64
+
+	// PlanCacheSize is the maximum number of compiled plans cached by each session.
+	// The default size is used if it is zero, and the plan cache is disabled if it
+	// is negative.
+	PlanCacheSize int
 }
 
 // SetDefaults sets the missing options into default value.
@@ -18,4 +32,10 @@ func (opt *Options) SetDefaults() {
 	if opt.Concurrency <= 0 {
 		opt.Concurrency = defaultConcurrency
 	}
+	if opt.MemQuotaSort <= 0 {
+		opt.MemQuotaSort = stmtctx.DefaultMemQuotaSort
+	}
+	if opt.PlanCacheSize == 0 {
+		opt.PlanCacheSize = session.DefaultPlanCacheSize
+	}
 }
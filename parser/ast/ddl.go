@@ -3,6 +3,7 @@
 package ast
 
 import (
+	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/parser/format"
 	"github.com/simbiont-runtime/graphengine/parser/model"
 )
@@ -21,11 +22,17 @@ type CreateGraphStmt struct {
 
 	IfNotExists bool
 	Graph       model.CIStr
+	// Strict indicates the graph only accepts the properties declared by the
+	// label schemas.
+	Strict bool
 }
 
 func (n *CreateGraphStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("CREATE GRAPH ")
 	ctx.WriteName(n.Graph.String())
+	if n.Strict {
+		ctx.WriteKeyWord(" STRICT")
+	}
 	return nil
 }
 
@@ -63,6 +70,8 @@ type CreateLabelStmt struct {
 
 	IfNotExists bool
 	Label       model.CIStr
+	// Properties is the optional schema of the label.
+	Properties []*LabelPropertyDef
 }
 
 // Restore implements Node interface.
@@ -73,6 +82,18 @@ func (n *CreateLabelStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
 	ctx.WriteName(n.Label.String())
+	if len(n.Properties) > 0 {
+		ctx.WritePlain(" (")
+		for i, prop := range n.Properties {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := prop.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore CreateLabelStmt.Properties[%d]", i)
+			}
+		}
+		ctx.WritePlain(")")
+	}
 	return nil
 }
 
@@ -83,7 +104,60 @@ func (n *CreateLabelStmt) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 
-	return v.Leave(newNode)
+	n = newNode.(*CreateLabelStmt)
+	for i, prop := range n.Properties {
+		node, ok := prop.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Properties[i] = node.(*LabelPropertyDef)
+	}
+	return v.Leave(n)
+}
+
+// LabelPropertyDef is the definition of a property in the schema of a label.
+type LabelPropertyDef struct {
+	node
+
+	Property model.CIStr
+	Type     DataType
+	NotNull  bool
+	// Default is the default value, which is a literal or nil.
+	Default ExprNode
+}
+
+// Restore implements Node interface.
+func (n *LabelPropertyDef) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteName(n.Property.String())
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Type.String())
+	if n.NotNull {
+		ctx.WriteKeyWord(" NOT NULL")
+	}
+	if n.Default != nil {
+		ctx.WriteKeyWord(" DEFAULT ")
+		if err := n.Default.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore LabelPropertyDef.Default")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *LabelPropertyDef) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*LabelPropertyDef)
+	if n.Default != nil {
+		node, ok := n.Default.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Default = node.(ExprNode)
+	}
+	return v.Leave(n)
 }
 
 type DropLabelStmt struct {
@@ -124,7 +198,10 @@ type CreateIndexStmt struct {
 	KeyType     IndexKeyType
 	IfNotExists bool
 
-	IndexName  model.CIStr
+	IndexName model.CIStr
+	// Label is the label of the vertices covered by the index, which is empty
+	// if the index covers all vertices.
+	Label      model.CIStr
 	Properties []model.CIStr
 }
 
@@ -140,6 +217,10 @@ func (n *CreateIndexStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("IF NOT EXISTS ")
 	}
 	ctx.WriteName(n.IndexName.String())
+	if !n.Label.IsEmpty() {
+		ctx.WriteKeyWord(" ON ")
+		ctx.WriteName(n.Label.String())
+	}
 
 	ctx.WritePlain(" (")
 	for i, propName := range n.Properties {
@@ -690,41 +690,41 @@ func (d *DeleteStmt) Accept(v Visitor) (node Node, ok bool) {
 		}
 	}
 
-	nn, ok := d.From.Accept(v)
+	nn, ok := n.From.Accept(v)
 	if !ok {
 		return n, false
 	}
 	n.From = nn.(*MatchClauseList)
 	if n.Where != nil {
-		nn, ok := d.Where.Accept(v)
+		nn, ok := n.Where.Accept(v)
 		if !ok {
 			return n, false
 		}
 		n.Where = nn.(ExprNode)
 	}
 	if n.GroupBy != nil {
-		nn, ok := d.GroupBy.Accept(v)
+		nn, ok := n.GroupBy.Accept(v)
 		if !ok {
 			return n, false
 		}
 		n.GroupBy = nn.(*GroupByClause)
 	}
 	if n.Having != nil {
-		nn, ok := d.Having.Accept(v)
+		nn, ok := n.Having.Accept(v)
 		if !ok {
 			return n, false
 		}
 		n.Having = nn.(*HavingClause)
 	}
 	if n.OrderBy != nil {
-		nn, ok := d.OrderBy.Accept(v)
+		nn, ok := n.OrderBy.Accept(v)
 		if !ok {
 			return n, false
 		}
 		n.OrderBy = nn.(*OrderByClause)
 	}
 	if n.Limit != nil {
-		nn, ok := d.Limit.Accept(v)
+		nn, ok := n.Limit.Accept(v)
 		if !ok {
 			return n, false
 		}
@@ -1036,6 +1036,14 @@ func (n *SelectStmt) Accept(v Visitor) (node Node, ok bool) {
 		nn.PathPatternMacros[i] = node.(*PathPatternMacro)
 	}
 
+	if nn.Select != nil {
+		node, ok := nn.Select.Accept(v)
+		if !ok {
+			return nn, false
+		}
+		nn.Select = node.(*SelectClause)
+	}
+
 	if nn.From != nil {
 		node, ok := nn.From.Accept(v)
 		if !ok {
@@ -30,6 +30,9 @@ var (
 	_ ExprNode = &CastFuncExpr{}
 	_ ExprNode = &CaseExpr{}
 	_ ExprNode = &PatternInExpr{}
+	_ ExprNode = &ListExpr{}
+	_ ExprNode = &MapExpr{}
+	_ ExprNode = &SubscriptExpr{}
 	_ ExprNode = &SubqueryExpr{}
 	_ ExprNode = &ExistsSubqueryExpr{}
 
@@ -145,8 +148,13 @@ func (n *PropertyAccess) Accept(v Visitor) (node Node, ok bool) {
 	return v.Leave(newNode)
 }
 
+// BindVariable represents a bind variable (?) whose value is provided when the
+// statement is executed.
 type BindVariable struct {
 	exprNode
+
+	// Order is the position of the bind variable in the statement, starting from 0.
+	Order int
 }
 
 func (n *BindVariable) Restore(ctx *format.RestoreCtx) error {
@@ -348,14 +356,52 @@ type SubstrFuncExpr struct {
 	For   ExprNode
 }
 
-func (s *SubstrFuncExpr) Restore(ctx *format.RestoreCtx) error {
-	//TODO implement me
-	panic("implement me")
+// Restore implements Node interface.
+func (n *SubstrFuncExpr) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("SUBSTRING")
+	ctx.WritePlain("(")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SubstrFuncExpr.Expr")
+	}
+	ctx.WriteKeyWord(" FROM ")
+	if err := n.Start.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SubstrFuncExpr.Start")
+	}
+	if n.For != nil {
+		ctx.WriteKeyWord(" FOR ")
+		if err := n.For.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore SubstrFuncExpr.For")
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
 }
 
-func (s *SubstrFuncExpr) Accept(v Visitor) (node Node, ok bool) {
-	//TODO implement me
-	panic("implement me")
+// Accept implements Node Accept interface.
+func (n *SubstrFuncExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SubstrFuncExpr)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	node, ok = n.Start.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Start = node.(ExprNode)
+	if n.For != nil {
+		node, ok = n.For.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.For = node.(ExprNode)
+	}
+	return v.Leave(n)
 }
 
 // AggregateFuncExpr represents aggregate function expression.
@@ -437,6 +483,30 @@ const (
 	ExtractFieldTimezoneMinute
 )
 
+// String implements the fmt.Stringer interface.
+func (f ExtractField) String() string {
+	switch f {
+	case ExtractFieldYear:
+		return "YEAR"
+	case ExtractFieldMonth:
+		return "MONTH"
+	case ExtractFieldDay:
+		return "DAY"
+	case ExtractFieldHour:
+		return "HOUR"
+	case ExtractFieldMinute:
+		return "MINUTE"
+	case ExtractFieldSecond:
+		return "SECOND"
+	case ExtractFieldTimezoneHour:
+		return "TIMEZONE_HOUR"
+	case ExtractFieldTimezoneMinute:
+		return "TIMEZONE_MINUTE"
+	default:
+		return fmt.Sprintf("UNKNOWN<%d>", f)
+	}
+}
+
 // ExtractFuncExpr is for function expression.
 type ExtractFuncExpr struct {
 	exprNode
@@ -445,14 +515,32 @@ type ExtractFuncExpr struct {
 	Expr         ExprNode
 }
 
-func (e *ExtractFuncExpr) Restore(ctx *format.RestoreCtx) error {
-	//TODO implement me
-	panic("implement me")
+// Restore implements Node interface.
+func (n *ExtractFuncExpr) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("EXTRACT")
+	ctx.WritePlain("(")
+	ctx.WriteKeyWord(n.ExtractField.String())
+	ctx.WriteKeyWord(" FROM ")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ExtractFuncExpr.Expr")
+	}
+	ctx.WritePlain(")")
+	return nil
 }
 
-func (e *ExtractFuncExpr) Accept(v Visitor) (node Node, ok bool) {
-	//TODO implement me
-	panic("implement me")
+// Accept implements Node Accept interface.
+func (n *ExtractFuncExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ExtractFuncExpr)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
 }
 
 // IsNullExpr is the expression for null check.
@@ -686,13 +774,16 @@ func (n *WhenClause) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
-// PatternInExpr is the expression for in operator, like "expr in (1, 2, 3)" or "expr in (select c from t)".
+// PatternInExpr is the expression for in operator, like "expr in (1, 2, 3)" or "expr in x.tags".
 type PatternInExpr struct {
 	exprNode
 	// Expr is the value expression to be compared.
 	Expr ExprNode
 	// List is the list expression in compare list.
 	List []ExprNode
+	// Sel is the expression of a list value, which is used instead of List,
+	// e.g: x.tags in "'red' IN x.tags".
+	Sel ExprNode
 	// Not is true, the expression is "not in".
 	Not bool
 }
@@ -708,6 +799,13 @@ func (n *PatternInExpr) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(" IN ")
 	}
 
+	if n.Sel != nil {
+		if err := n.Sel.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore PatternInExpr.Sel")
+		}
+		return nil
+	}
+
 	ctx.WritePlain("(")
 	for i, expr := range n.List {
 		if i != 0 {
@@ -740,6 +838,137 @@ func (n *PatternInExpr) Accept(v Visitor) (Node, bool) {
 		}
 		n.List[i] = node.(ExprNode)
 	}
+	if n.Sel != nil {
+		node, ok = n.Sel.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Sel = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// ListExpr is the list constructor, like "[1, 2, 3]".
+type ListExpr struct {
+	exprNode
+	// Elems are the elements of the list.
+	Elems []ExprNode
+}
+
+// Restore implements Node interface.
+func (n *ListExpr) Restore(ctx *format.RestoreCtx) error {
+	ctx.WritePlain("[")
+	for i, expr := range n.Elems {
+		if i != 0 {
+			ctx.WritePlain(",")
+		}
+		if err := expr.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore ListExpr.Elems[%d]", i)
+		}
+	}
+	ctx.WritePlain("]")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ListExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ListExpr)
+	for i, val := range n.Elems {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Elems[i] = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// MapExpr is the map constructor, like "{'color': 'red', size: 3}". The keys are
+// in the same order as the values.
+type MapExpr struct {
+	exprNode
+	Keys   []string
+	Values []ExprNode
+}
+
+// Restore implements Node interface.
+func (n *MapExpr) Restore(ctx *format.RestoreCtx) error {
+	ctx.WritePlain("{")
+	for i, expr := range n.Values {
+		if i != 0 {
+			ctx.WritePlain(",")
+		}
+		ctx.WriteString(n.Keys[i])
+		ctx.WritePlain(":")
+		if err := expr.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore MapExpr.Values[%d]", i)
+		}
+	}
+	ctx.WritePlain("}")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *MapExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*MapExpr)
+	for i, val := range n.Values {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Values[i] = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// SubscriptExpr is the element access of a list or map, like "x.tags[0]" or
+// "x.attrs['color']".
+type SubscriptExpr struct {
+	exprNode
+	// Expr is the list or map expression.
+	Expr ExprNode
+	// Index is the index of list or the key of map.
+	Index ExprNode
+}
+
+// Restore implements Node interface.
+func (n *SubscriptExpr) Restore(ctx *format.RestoreCtx) error {
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SubscriptExpr.Expr")
+	}
+	ctx.WritePlain("[")
+	if err := n.Index.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore SubscriptExpr.Index")
+	}
+	ctx.WritePlain("]")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *SubscriptExpr) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*SubscriptExpr)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	node, ok = n.Index.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Index = node.(ExprNode)
 	return v.Leave(n)
 }
 
@@ -773,11 +1002,11 @@ func (n *SubqueryExpr) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*SubqueryExpr)
-	//node, ok := n.Query.Accept(v)
-	//if !ok {
-	//	return n, false
-	//}
-	//n.Query = node.(ResultSetNode)
+	node, ok := n.Query.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Query = node.(*SelectStmt)
 	return v.Leave(n)
 }
 
@@ -14,6 +14,7 @@ var (
 	_ Node = &CommitStmt{}
 	_ Node = &ExplainStmt{}
 	_ Node = &ShowStmt{}
+	_ Node = &AnalyzeGraphStmt{}
 )
 
 type UseStmt struct {
@@ -54,7 +55,7 @@ func (b *BeginStmt) Accept(v Visitor) (node Node, ok bool) {
 }
 
 type RollbackStmt struct {
-	node
+	stmtNode
 }
 
 func (r *RollbackStmt) Restore(ctx *format.RestoreCtx) error {
@@ -90,11 +91,17 @@ func (c *CommitStmt) Accept(v Visitor) (node Node, ok bool) {
 type ExplainStmt struct {
 	stmtNode
 
-	Select *SelectStmt
+	// Analyze indicates whether to execute the statement and collect the
+	// runtime statistics (EXPLAIN ANALYZE).
+	Analyze bool
+	Select  *SelectStmt
 }
 
 func (e *ExplainStmt) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("EXPLAIN ")
+	if e.Analyze {
+		ctx.WriteKeyWord("ANALYZE ")
+	}
 	return e.Select.Restore(ctx)
 }
 
@@ -119,6 +126,7 @@ type ShowTarget byte
 const (
 	ShowTargetGraphs ShowTarget = iota + 1
 	ShowTargetLabels
+	ShowTargetStats
 )
 
 type ShowStmt struct {
@@ -139,6 +147,12 @@ func (s *ShowStmt) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteKeyWord(" IN ")
 			ctx.WriteName(s.GraphName.String())
 		}
+	case ShowTargetStats:
+		ctx.WriteKeyWord("STATS")
+		if !s.GraphName.IsEmpty() {
+			ctx.WriteKeyWord(" IN ")
+			ctx.WriteName(s.GraphName.String())
+		}
 	}
 	return nil
 }
@@ -150,3 +164,28 @@ func (s *ShowStmt) Accept(v Visitor) (node Node, ok bool) {
 	}
 	return v.Leave(newNode)
 }
+
+// AnalyzeGraphStmt collects the statistics of a graph, which is the current
+// graph if the graph name is empty.
+type AnalyzeGraphStmt struct {
+	stmtNode
+
+	Graph model.CIStr
+}
+
+func (a *AnalyzeGraphStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ANALYZE GRAPH")
+	if !a.Graph.IsEmpty() {
+		ctx.WritePlain(" ")
+		ctx.WriteName(a.Graph.String())
+	}
+	return nil
+}
+
+func (a *AnalyzeGraphStmt) Accept(v Visitor) (node Node, ok bool) {
+	newNode, skipChildren := v.Enter(a)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	return v.Leave(newNode)
+}
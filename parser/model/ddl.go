@@ -2,22 +2,31 @@
 
 package model
 
+import "github.com/simbiont-runtime/graphengine/types"
+
 // GraphInfo provides meta data describing a graph.
 type GraphInfo struct {
-	ID         int64           `json:"id"`
-	Name       CIStr           `json:"name"`
-	Indexes    []*IndexInfo    `json:"indexes"`
-	NextPropID uint16          `json:"next_prop_id"`
-	Query      string          `json:"query"`
+	ID         int64        `json:"id"`
+	Name       CIStr        `json:"name"`
+	Indexes    []*IndexInfo `json:"indexes"`
+	NextPropID uint16       `json:"next_prop_id"`
+	Query      string       `json:"query"`
+	// Strict indicates the properties not declared by any label schema are
+	// rejected instead of being created at the first time to be used.
+	Strict     bool            `json:"strict,omitempty"`
 	Labels     []*LabelInfo    `json:"-"`
 	Properties []*PropertyInfo `json:"-"`
 }
 
 // IndexInfo provides meta data describing a index.
 type IndexInfo struct {
-	ID         int64   `json:"id"`
-	Name       CIStr   `json:"name"`
+	ID   int64 `json:"id"`
+	Name CIStr `json:"name"`
+	// Label is the label of the vertices covered by the index, which is empty
+	// if the index covers all vertices.
+	Label      CIStr   `json:"label"`
 	Properties []CIStr `json:"properties"`
+	Unique     bool    `json:"unique,omitempty"`
 	Query      string  `json:"query"`
 }
 
@@ -26,6 +35,19 @@ type LabelInfo struct {
 	ID    int64  `json:"id"`
 	Name  CIStr  `json:"name"`
 	Query string `json:"query"`
+	// Properties is the schema of the elements with the label, which is empty
+	// if the label is created without a schema.
+	Properties []*LabelPropertyInfo `json:"properties,omitempty"`
+}
+
+// LabelPropertyInfo provides meta data describing a property in the schema of a label.
+type LabelPropertyInfo struct {
+	Name    CIStr   `json:"name"`
+	Type    types.T `json:"type"`
+	NotNull bool    `json:"not_null,omitempty"`
+	// Default is the default value encoded by the row codec, which is nil if
+	// the property has no default value.
+	Default []byte `json:"default,omitempty"`
 }
 
 type PropertyInfo struct {
@@ -60,6 +82,27 @@ func (info *IndexInfo) Clone() *IndexInfo {
 }
 
 func (info *LabelInfo) Clone() *LabelInfo {
+	cloned := *info
+	if info.Properties != nil {
+		cloned.Properties = make([]*LabelPropertyInfo, len(info.Properties))
+		for i := range info.Properties {
+			cloned.Properties[i] = info.Properties[i].Clone()
+		}
+	}
+	return &cloned
+}
+
+// Property returns the property with the name in the schema of the label.
+func (info *LabelInfo) Property(name string) *LabelPropertyInfo {
+	for _, prop := range info.Properties {
+		if prop.Name.L == name {
+			return prop
+		}
+	}
+	return nil
+}
+
+func (info *LabelPropertyInfo) Clone() *LabelPropertyInfo {
 	cloned := *info
 	return &cloned
 }
@@ -0,0 +1,46 @@
+// ---
+
+package model
+
+import "github.com/twmb/murmur3"
+
+// FMSketch estimates the number of distinct values with bounded memory. It keeps
+// the distinct hashes of the values whose lowest bits masked by the mask are all
+// zeros. The mask is extended by one bit when the hashes exceed the max size,
+// which drops about half of the hashes, so each kept hash stands for mask+1
+// distinct values. The number is exact until the hashes exceed the max size.
+type FMSketch struct {
+	mask    uint64
+	maxSize int
+	hashes  map[uint64]struct{}
+}
+
+// NewFMSketch returns a sketch which keeps at most maxSize hashes.
+func NewFMSketch(maxSize int) *FMSketch {
+	return &FMSketch{
+		maxSize: maxSize,
+		hashes:  make(map[uint64]struct{}),
+	}
+}
+
+// Insert adds the encoded value to the sketch.
+func (s *FMSketch) Insert(value []byte) {
+	hash := murmur3.Sum64(value)
+	if hash&s.mask != 0 {
+		return
+	}
+	s.hashes[hash] = struct{}{}
+	for len(s.hashes) > s.maxSize {
+		s.mask = s.mask<<1 | 1
+		for h := range s.hashes {
+			if h&s.mask != 0 {
+				delete(s.hashes, h)
+			}
+		}
+	}
+}
+
+// NDV returns the estimated number of distinct values.
+func (s *FMSketch) NDV() int64 {
+	return int64(s.mask+1) * int64(len(s.hashes))
+}
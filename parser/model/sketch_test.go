@@ -0,0 +1,27 @@
+// ---
+
+package model
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFMSketch(t *testing.T) {
+	// The number of distinct values is exact if the hashes are not dropped.
+	s := NewFMSketch(100)
+	for i := 0; i < 1000; i++ {
+		s.Insert([]byte(strconv.Itoa(i % 50)))
+	}
+	require.Equal(t, int64(50), s.NDV())
+
+	// The hashes are bounded by the max size and the number is estimated.
+	s = NewFMSketch(100)
+	for i := 0; i < 100000; i++ {
+		s.Insert([]byte(strconv.Itoa(i % 20000)))
+	}
+	require.LessOrEqual(t, len(s.hashes), 100)
+	require.InEpsilon(t, 20000, s.NDV(), 0.3)
+}
@@ -0,0 +1,172 @@
+// ---
+
+package model
+
+import "sort"
+
+// GraphStats provides the statistics of a graph, which are collected by the
+// ANALYZE GRAPH statement and used to estimate the cost of queries.
+type GraphStats struct {
+	VertexCount int64 `json:"vertex_count"`
+	EdgeCount   int64 `json:"edge_count"`
+	// Labels are the numbers of vertices and edges of each label.
+	Labels []*LabelStats `json:"labels"`
+	// LabelPairs are the numbers of edges between the vertices of each pair of
+	// labels.
+	LabelPairs []*LabelPairStats `json:"label_pairs"`
+	// OutDegree and InDegree are the distributions of the numbers of outgoing
+	// and incoming edges of vertices.
+	OutDegree *Histogram `json:"out_degree"`
+	InDegree  *Histogram `json:"in_degree"`
+	// VertexProperties and EdgeProperties are the statistics of the properties
+	// of vertices and edges.
+	VertexProperties []*PropertyStats `json:"vertex_properties"`
+	EdgeProperties   []*PropertyStats `json:"edge_properties"`
+}
+
+// LabelStats provides the numbers of vertices and edges with the label.
+type LabelStats struct {
+	ID          int64 `json:"id"`
+	VertexCount int64 `json:"vertex_count"`
+	EdgeCount   int64 `json:"edge_count"`
+}
+
+// LabelPairStats provides the number of edges from the vertices with the source
+// label to the vertices with the destination label. The label ID 0 matches all
+// vertices, e.g. the pair (0, 0) counts all edges.
+type LabelPairStats struct {
+	SrcID int64 `json:"src_id"`
+	DstID int64 `json:"dst_id"`
+	Count int64 `json:"count"`
+}
+
+// PropertyStats provides the value distribution of a property. Only the numeric
+// values are counted by the histogram.
+type PropertyStats struct {
+	ID uint16 `json:"id"`
+	// Count is the number of vertices or edges which have the property.
+	Count     int64      `json:"count"`
+	NDV       int64      `json:"ndv"`
+	Histogram *Histogram `json:"histogram"`
+}
+
+// Histogram is an equal-depth histogram. The buckets are in ascending order and
+// don't overlap, and the same values are always in the same bucket.
+type Histogram struct {
+	Buckets []*Bucket `json:"buckets"`
+}
+
+// Bucket represents the values in [Lower, Upper] of a histogram.
+type Bucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int64   `json:"count"`
+	NDV   int64   `json:"ndv"`
+}
+
+// Label returns the statistics of the label, or nil if the label has no vertex
+// or edge when analyzed.
+func (s *GraphStats) Label(id int64) *LabelStats {
+	for _, l := range s.Labels {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+// LabelPair returns the number of edges between the vertices of the labels.
+func (s *GraphStats) LabelPair(srcID, dstID int64) int64 {
+	for _, p := range s.LabelPairs {
+		if p.SrcID == srcID && p.DstID == dstID {
+			return p.Count
+		}
+	}
+	return 0
+}
+
+// VertexProperty returns the statistics of the vertex property, or nil if no
+// vertex has the property when analyzed.
+func (s *GraphStats) VertexProperty(id uint16) *PropertyStats {
+	return findPropertyStats(s.VertexProperties, id)
+}
+
+// EdgeProperty returns the statistics of the edge property, or nil if no edge
+// has the property when analyzed.
+func (s *GraphStats) EdgeProperty(id uint16) *PropertyStats {
+	return findPropertyStats(s.EdgeProperties, id)
+}
+
+func findPropertyStats(props []*PropertyStats, id uint16) *PropertyStats {
+	for _, p := range props {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// NewHistogram builds a histogram of the values with at most maxBuckets buckets.
+// The values are sorted in place.
+func NewHistogram(values []float64, maxBuckets int) *Histogram {
+	sort.Float64s(values)
+	depth := (len(values) + maxBuckets - 1) / maxBuckets
+	h := &Histogram{}
+	var bucket *Bucket
+	for i, v := range values {
+		switch {
+		case bucket != nil && v == bucket.Upper:
+			bucket.Count++
+			continue
+		case bucket == nil || bucket.Count >= int64(depth):
+			bucket = &Bucket{Lower: v}
+			h.Buckets = append(h.Buckets, bucket)
+		}
+		if i == 0 || v != values[i-1] {
+			bucket.NDV++
+		}
+		bucket.Upper = v
+		bucket.Count++
+	}
+	return h
+}
+
+// Count returns the number of values in the histogram.
+func (h *Histogram) Count() int64 {
+	var count int64
+	for _, b := range h.Buckets {
+		count += b.Count
+	}
+	return count
+}
+
+// EqualCount estimates the number of values equal to v, which assumes the values
+// in a bucket are evenly distributed.
+func (h *Histogram) EqualCount(v float64) float64 {
+	for _, b := range h.Buckets {
+		if v < b.Lower {
+			break
+		}
+		if v <= b.Upper {
+			return float64(b.Count) / float64(b.NDV)
+		}
+	}
+	return 0
+}
+
+// LessCount estimates the number of values less than v, which assumes the values
+// in a bucket are evenly distributed in [Lower, Upper].
+func (h *Histogram) LessCount(v float64) float64 {
+	var count float64
+	for _, b := range h.Buckets {
+		switch {
+		case v <= b.Lower:
+			return count
+		case v > b.Upper:
+			count += float64(b.Count)
+		default:
+			return count + float64(b.Count)*(v-b.Lower)/(b.Upper-b.Lower)
+		}
+	}
+	return count
+}
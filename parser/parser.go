@@ -11,6 +11,9 @@ type Parser struct {
 	src    string
 	lexer  *Lexer
 	result []ast.StmtNode
+	// bindVars is the number of bind variables parsed in the current statement,
+	// which is used as the order of the next bind variable.
+	bindVars int
 
 	// the following fields are used by yyParse to reduce allocation.
 	cache  []yySymType
@@ -35,6 +38,7 @@ func (p *Parser) Parse(sql string) (stmts []ast.StmtNode, warns []error, err err
 	p.lexer.reset(sql)
 	p.src = sql
 	p.result = p.result[:0]
+	p.bindVars = 0
 	yyParse(p.lexer, p)
 
 	warns, errs := p.lexer.Errors()
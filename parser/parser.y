@@ -148,6 +148,9 @@ import (
 	with                  "WITH"
 	zone                  "ZONE"
 	prefix                "PREFIX"
+	analyze               "ANALYZE"
+	stats                 "STATS"
+	strict                "STRICT"
 
 	/* Functions */
 	lower                 "LOWER"
@@ -165,6 +168,7 @@ import (
 	hasLabel              "HAS_LABEL"
 	id                    "ID"
 	allDifferent          "ALL_DIFFERENT"
+	mod                   "MOD"
 
 %token	<item>
 
@@ -223,19 +227,24 @@ import (
 	IsNullPredicate
 	LengthNum
 	LimitOption
+	ListLiteral
+	ListValue
 	Literal
 	ListaggSeparatorOpt
 	LogicalExpression
+	MapLiteral
 	NotInPredicate
 	PropertyAccess
 	RelationalExpression
 	ScalarSubquery
+	SignedLiteral
 	SimpleCase
 	SearchedCase
 	StartPosition
 	StringConcat
 	StringLiteral
 	Subquery
+	SubscriptExpression
 	ValueExpression
 	VariableReference
 	NumericLiteral
@@ -247,6 +256,7 @@ import (
 
 
 %type	<statement>
+	AnalyzeGraphStmt
 	BeginStmt
 	CommitStmt
 	CreateGraphStmt
@@ -285,6 +295,7 @@ import (
 	ExpAsVar
 	ExtractField
 	SelectEelement
+	StrictOpt
 	FieldAsName
 	FieldAsNameOpt
 	FromClause
@@ -308,6 +319,11 @@ import (
 	IndexName
 	LabelName
 	LabelNameList
+	LabelPropertyDef
+	LabelPropertyDefList
+	LabelPropertyDefListOpt
+	LabelPropertyOption
+	LabelPropertyOptionList
 	LabelNameListWithComma
 	LabelPredicate
 	LabelPredicateOpt
@@ -315,6 +331,8 @@ import (
 	LabelSpecification
 	LabelSpecificationOpt
 	LimitClauseOpt
+	MapEntry
+	MapEntryList
 	MatchClause
 	MatchClauseList
 	Order
@@ -370,6 +388,7 @@ import (
 %left '^'
 %left '~' neg
 %right not
+%left '['
 %precedence ','
 
 %start	Entry
@@ -385,16 +404,19 @@ StatementList:
 		if $1 != nil {
 			parser.result = append(parser.result, $1)
 		}
+		parser.bindVars = 0
 	}
 |	StatementList ';' Statement
 	{
 		if $3 != nil {
 			parser.result = append(parser.result, $3)
 		}
+		parser.bindVars = 0
 	}
 
 Statement:
 	EmptyStmt
+|	AnalyzeGraphStmt
 |	BeginStmt
 |	CommitStmt
 |	CreateGraphStmt
@@ -418,6 +440,18 @@ EmptyStmt:
 		$$ = nil
 	}
 
+AnalyzeGraphStmt:
+	"ANALYZE" "GRAPH"
+	{
+		$$ = &ast.AnalyzeGraphStmt{}
+	}
+|	"ANALYZE" "GRAPH" GraphName
+	{
+		$$ = &ast.AnalyzeGraphStmt{
+			Graph: $3.(model.CIStr),
+		}
+	}
+
 BeginStmt:
 	"BEGIN"
 	{
@@ -431,24 +465,100 @@ CommitStmt:
 	}
 
 CreateGraphStmt:
-	"CREATE" "GRAPH" IfNotExists GraphName
+	"CREATE" "GRAPH" IfNotExists GraphName StrictOpt
 	{
 		$$ = &ast.CreateGraphStmt{
 			IfNotExists: $3.(bool),
 			Graph:       $4.(model.CIStr),
+			Strict:      $5.(bool),
 		}
 	}
 
+StrictOpt:
+	{
+		$$ = false
+	}
+|	"STRICT"
+	{
+		$$ = true
+	}
+
 CreateLabelStmt:
-	"CREATE" "LABEL" IfNotExists LabelName
+	"CREATE" "LABEL" IfNotExists LabelName LabelPropertyDefListOpt
 	{
 		cl := &ast.CreateLabelStmt{
 			IfNotExists: $3.(bool),
 			Label:       $4.(model.CIStr),
 		}
+		if $5 != nil {
+			cl.Properties = $5.([]*ast.LabelPropertyDef)
+		}
 		$$ = cl
 	}
 
+LabelPropertyDefListOpt:
+	{
+		$$ = nil
+	}
+|	'(' LabelPropertyDefList ')'
+	{
+		$$ = $2
+	}
+
+LabelPropertyDefList:
+	LabelPropertyDef
+	{
+		$$ = []*ast.LabelPropertyDef{$1.(*ast.LabelPropertyDef)}
+	}
+|	LabelPropertyDefList ',' LabelPropertyDef
+	{
+		$$ = append($1.([]*ast.LabelPropertyDef), $3.(*ast.LabelPropertyDef))
+	}
+
+LabelPropertyDef:
+	PropertyName DataType LabelPropertyOptionList
+	{
+		def := &ast.LabelPropertyDef{
+			Property: $1.(model.CIStr),
+			Type:     $2.(ast.DataType),
+		}
+		for _, opt := range $3.([]interface{}) {
+			switch o := opt.(type) {
+			case bool:
+				def.NotNull = o
+			case ast.ExprNode:
+				def.Default = o
+			}
+		}
+		$$ = def
+	}
+
+LabelPropertyOptionList:
+	{
+		$$ = []interface{}{}
+	}
+|	LabelPropertyOptionList LabelPropertyOption
+	{
+		$$ = append($1.([]interface{}), $2)
+	}
+
+LabelPropertyOption:
+	"NOT" "NULL"
+	{
+		$$ = true
+	}
+|	"DEFAULT" SignedLiteral
+	{
+		$$ = $2
+	}
+
+SignedLiteral:
+	Literal
+|	'-' NumericLiteral
+	{
+		$$ = &ast.UnaryExpr{Op: opcode.Minus, V: $2}
+	}
+
 CreateIndexStmt:
 	"CREATE" IndexKeyTypeOpt "INDEX" IfNotExists IndexName '(' PropertyNameList ')'
 	{
@@ -459,6 +569,16 @@ CreateIndexStmt:
 			Properties:  $7.([]model.CIStr),
 		}
 	}
+|	"CREATE" IndexKeyTypeOpt "INDEX" IfNotExists IndexName "ON" LabelName '(' PropertyNameList ')'
+	{
+		$$ = &ast.CreateIndexStmt{
+			KeyType:     $2.(ast.IndexKeyType),
+			IfNotExists: $4.(bool),
+			IndexName:   $5.(model.CIStr),
+			Label:       $7.(model.CIStr),
+			Properties:  $9.([]model.CIStr),
+		}
+	}
 
 IndexKeyTypeOpt:
 	{
@@ -537,6 +657,13 @@ ExplainStmt:
 			Select: $2.(*ast.SelectStmt),
 		}
 	}
+|	"EXPLAIN" "ANALYZE" SelectStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Analyze: true,
+			Select:  $3.(*ast.SelectStmt),
+		}
+	}
 
 /******************************************************************************
 
@@ -712,6 +839,9 @@ ValueExpression:
 |	NotInPredicate
 |	ExistsPredicate
 |	ScalarSubquery
+|	ListLiteral
+|	MapLiteral
+|	SubscriptExpression
 
 VariableReference:
 	VariableName
@@ -842,9 +972,10 @@ DateTimeField:
 	}
 
 BindVariable:
-	'?'
+	paramMarker
 	{
-		$$ = &ast.BindVariable{}
+		$$ = &ast.BindVariable{Order: parser.bindVars}
+		parser.bindVars++
 	}
 
 ArithmeticExpression:
@@ -935,6 +1066,52 @@ BracketedValueExpression:
 		$$ = &ast.ParenthesesExpr{Expr: $2}
 	}
 
+ListLiteral:
+	'[' ']'
+	{
+		$$ = &ast.ListExpr{}
+	}
+|	'[' ValueExpressionList ']'
+	{
+		$$ = &ast.ListExpr{Elems: $2.([]ast.ExprNode)}
+	}
+
+MapLiteral:
+	'{' '}'
+	{
+		$$ = &ast.MapExpr{}
+	}
+|	'{' MapEntryList '}'
+	{
+		$$ = $2.(*ast.MapExpr)
+	}
+
+MapEntryList:
+	MapEntry
+|	MapEntryList ',' MapEntry
+	{
+		m, entry := $1.(*ast.MapExpr), $3.(*ast.MapExpr)
+		m.Keys = append(m.Keys, entry.Keys...)
+		m.Values = append(m.Values, entry.Values...)
+		$$ = m
+	}
+
+MapEntry:
+	stringLit ':' ValueExpression
+	{
+		$$ = &ast.MapExpr{Keys: []string{$1}, Values: []ast.ExprNode{$3}}
+	}
+|	Identifier ':' ValueExpression
+	{
+		$$ = &ast.MapExpr{Keys: []string{$1}, Values: []ast.ExprNode{$3}}
+	}
+
+SubscriptExpression:
+	ValueExpression '[' ValueExpression ']'
+	{
+		$$ = &ast.SubscriptExpr{Expr: $1, Index: $3}
+	}
+
 /******************************************************************************
 
  Reference
@@ -985,6 +1162,8 @@ FunctionName:
 |	"IN_DEGREE"
 |	"OUT_DEGREE"
 |	"ALL_DIFFERENT"
+|	"MOD"
+|	identifier
 
 ArgumentList:
 	ValueExpression
@@ -1281,6 +1460,13 @@ InPredicate:
 			List: $3.([]ast.ExprNode),
 		}
 	}
+|	ValueExpression "IN" ListValue
+	{
+		$$ = &ast.PatternInExpr{
+			Expr: $1,
+			Sel:  $3,
+		}
+	}
 
 NotInPredicate:
 	ValueExpression "NOT" "IN" InValueList
@@ -1291,6 +1477,14 @@ NotInPredicate:
 			Not:  true,
 		}
 	}
+|	ValueExpression "NOT" "IN" ListValue
+	{
+		$$ = &ast.PatternInExpr{
+			Expr: $1,
+			Sel:  $4,
+			Not:  true,
+		}
+	}
 
 InValueList:
 	'(' ValueExpressionList ')'
@@ -1298,6 +1492,12 @@ InValueList:
 		$$ = $2
 	}
 
+ListValue:
+	VariableReference
+|	PropertyAccess
+|	BindVariable
+|	ListLiteral
+
 ValueExpressionList:
 	ValueExpression
 	{
@@ -1334,7 +1534,9 @@ ScalarSubquery:
  *****************************************************************************/
 RollbackStmt:
 	"ROLLBACK"
-	{}
+	{
+		$$ = &ast.RollbackStmt{}
+	}
 
 /*************************************Select Statement***************************************/
 SelectStmt:
@@ -1964,7 +2166,8 @@ LimitOption:
 	LengthNum
 |	paramMarker
 	{
-		$$ = &ast.BindVariable{}
+		$$ = &ast.BindVariable{Order: parser.bindVars}
+		parser.bindVars++
 	}
 
 LengthNum:
@@ -2054,6 +2257,19 @@ ShowStmt:
 			GraphName: $4.(model.CIStr),
 		}
 	}
+|	"SHOW" "STATS"
+	{
+		$$ = &ast.ShowStmt{
+			Tp: ast.ShowTargetStats,
+		}
+	}
+|	"SHOW" "STATS" "IN" GraphName
+	{
+		$$ = &ast.ShowStmt{
+			Tp: ast.ShowTargetStats,
+			GraphName: $4.(model.CIStr),
+		}
+	}
 
 IfExists:
 	{
@@ -2144,6 +2360,9 @@ UnReservedKeyword:
 |	"WITH"
 |	"ZONE"
 |	"PREFIX"
+|	"ANALYZE"
+|	"STATS"
+|	"STRICT"
 
 PropertyNameList:
 	PropertyName
@@ -45,30 +45,31 @@ type yyXError struct {
 }
 
 const (
-	yyDefault          = 57493
+	yyDefault          = 57496
 	yyEOFCode          = 57344
-	abs                = 57454
+	abs                = 57457
 	all                = 57418
-	allDifferent       = 57461
-	allProp            = 57476
+	allDifferent       = 57464
+	allProp            = 57480
+	analyze            = 57447
 	and                = 57392
 	andand             = 57351
-	andnot             = 57467
+	andnot             = 57471
 	any                = 57419
 	arrayAgg           = 57432
 	as                 = 57353
 	asc                = 57354
-	assignmentEq       = 57468
+	assignmentEq       = 57472
 	avg                = 57433
 	begin              = 57402
 	between            = 57393
-	bitLit             = 57466
+	bitLit             = 57470
 	booleanType        = 57406
 	by                 = 57355
 	caseKwd            = 57396
 	cast               = 57442
-	ceil               = 57455
-	ceiling            = 57456
+	ceil               = 57458
+	ceiling            = 57459
 	cheapest           = 57421
 	comment            = 57404
 	commit             = 57405
@@ -77,95 +78,95 @@ const (
 	create             = 57356
 	dateType           = 57410
 	day                = 57411
-	decLit             = 57463
+	decLit             = 57467
 	decimalType        = 57407
 	defaultKwd         = 57357
 	deleteKwd          = 57358
 	desc               = 57359
 	distinct           = 57401
-	div                = 57490
+	div                = 57494
 	doubleAtIdentifier = 57349
 	doubleType         = 57360
 	drop               = 57361
 	edge               = 57362
-	edgeIncomingLeft   = 57481
-	edgeIncomingRight  = 57482
-	edgeOutgoingLeft   = 57479
-	edgeOutgoingRight  = 57480
-	elementNumber      = 57457
+	edgeIncomingLeft   = 57485
+	edgeIncomingRight  = 57486
+	edgeOutgoingLeft   = 57483
+	edgeOutgoingRight  = 57484
+	elementNumber      = 57460
 	elseKwd            = 57399
-	empty              = 57487
+	empty              = 57491
 	end                = 57403
-	eq                 = 57469
+	eq                 = 57473
 	yyErrCode          = 57345
 	exists             = 57363
 	explain            = 57408
 	extract            = 57439
 	falseKwd           = 57364
-	floatLit           = 57462
+	floatLit           = 57466
 	floatType          = 57365
-	floor              = 57458
+	floor              = 57461
 	forkKwd            = 57431
 	from               = 57366
-	ge                 = 57470
+	ge                 = 57474
 	graph              = 57416
 	graphs             = 57417
 	group              = 57367
-	hasLabel           = 57459
+	hasLabel           = 57462
 	having             = 57368
-	hexLit             = 57465
+	hexLit             = 57469
 	hour               = 57426
-	id                 = 57460
+	id                 = 57463
 	identifier         = 57346
 	ifKwd              = 57369
 	in                 = 57400
-	inDegree           = 57449
+	inDegree           = 57452
 	index              = 57370
 	insert             = 57371
-	intLit             = 57464
+	intLit             = 57468
 	integerType        = 57372
 	interval           = 57425
 	into               = 57373
 	invalid            = 57350
 	is                 = 57374
-	javaRegexpLike     = 57450
-	label              = 57451
+	javaRegexpLike     = 57453
+	label              = 57454
 	labels             = 57394
-	le                 = 57471
-	leftArrow          = 57477
+	le                 = 57475
+	leftArrow          = 57481
 	limit              = 57375
 	listagg            = 57435
-	lower              = 57447
-	lowerThanOn        = 57488
+	lower              = 57450
+	lowerThanOn        = 57492
 	match              = 57376
-	matchNumber        = 57452
+	matchNumber        = 57455
 	max                = 57436
 	min                = 57437
 	minute             = 57427
-	mod                = 57491
+	mod                = 57465
 	month              = 57428
-	neg                = 57492
-	neq                = 57472
-	neqSynonym         = 57473
+	neg                = 57495
+	neq                = 57476
+	neqSynonym         = 57477
 	not                = 57377
 	null               = 57378
-	nulleq             = 57474
+	nulleq             = 57478
 	offset             = 57415
 	on                 = 57379
 	or                 = 57391
 	order              = 57380
-	outDegree          = 57453
-	paramMarker        = 57475
+	outDegree          = 57456
+	paramMarker        = 57479
 	path               = 57424
 	pipes              = 57352
-	pipesAsOr          = 57489
+	pipesAsOr          = 57493
 	prefix             = 57446
 	properties         = 57395
-	reachIncomingLeft  = 57485
-	reachIncomingRight = 57486
-	reachOutgoingLeft  = 57483
-	reachOutgoingRight = 57484
-	rightArrow         = 57478
+	reachIncomingLeft  = 57489
+	reachIncomingRight = 57490
+	reachOutgoingLeft  = 57487
+	reachOutgoingRight = 57488
+	rightArrow         = 57482
 	rollback           = 57414
 	second             = 57429
 	selectKwd          = 57381
@@ -173,6 +174,8 @@ const (
 	shortest           = 57420
 	show               = 57383
 	singleAtIdentifier = 57348
+	stats              = 57448
+	strict             = 57449
 	stringKwd          = 57443
 	stringLit          = 57347
 	substring          = 57430
@@ -186,7 +189,7 @@ const (
 	trueKwd            = 57384
 	unique             = 57385
 	update             = 57386
-	uppper             = 57448
+	uppper             = 57451
 	use                = 57387
 	vertex             = 57388
 	when               = 57398
@@ -197,320 +200,338 @@ const (
 	zone               = 57445
 
 	yyMaxDepth = 200
-	yyTabOfs   = -365
+	yyTabOfs   = -408
 )
 
 var (
 	yyXLAT = map[int]int{
-		41:    0,   // ')' (280x)
-		57424: 1,   // path (276x)
-		57344: 2,   // $end (275x)
-		59:    3,   // ';' (274x)
-		57423: 4,   // cost (264x)
-		57403: 5,   // end (260x)
-		57431: 6,   // forkKwd (254x)
-		44:    7,   // ',' (247x)
-		45:    8,   // '-' (244x)
-		57377: 9,   // not (237x)
-		57375: 10,  // limit (227x)
-		57380: 11,  // order (222x)
-		57368: 12,  // having (217x)
-		57367: 13,  // group (201x)
-		57366: 14,  // from (198x)
-		42:    15,  // '*' (195x)
-		43:    16,  // '+' (193x)
-		57374: 17,  // is (190x)
-		57400: 18,  // in (181x)
-		57392: 19,  // and (180x)
-		57469: 20,  // eq (180x)
-		37:    21,  // '%' (179x)
-		47:    22,  // '/' (179x)
-		60:    23,  // '<' (179x)
-		62:    24,  // '>' (179x)
-		57470: 25,  // ge (179x)
-		57471: 26,  // le (179x)
-		57473: 27,  // neqSynonym (179x)
-		57391: 28,  // or (179x)
-		57352: 29,  // pipes (179x)
-		57390: 30,  // xor (179x)
-		57381: 31,  // selectKwd (178x)
-		40:    32,  // '(' (177x)
-		57358: 33,  // deleteKwd (174x)
-		57371: 34,  // insert (174x)
-		57386: 35,  // update (174x)
-		57398: 36,  // when (158x)
-		57354: 37,  // asc (157x)
-		57359: 38,  // desc (157x)
-		57399: 39,  // elseKwd (156x)
-		57353: 40,  // as (155x)
-		57397: 41,  // then (152x)
-		57421: 42,  // cheapest (106x)
-		57394: 43,  // labels (106x)
-		57420: 44,  // shortest (106x)
-		57415: 45,  // offset (105x)
-		57418: 46,  // all (104x)
-		57419: 47,  // any (104x)
-		57413: 48,  // timeType (104x)
-		57422: 49,  // top (104x)
-		57402: 50,  // begin (103x)
-		57405: 51,  // commit (103x)
-		57411: 52,  // day (103x)
-		57408: 53,  // explain (103x)
-		57416: 54,  // graph (103x)
-		57426: 55,  // hour (103x)
-		57427: 56,  // minute (103x)
-		57428: 57,  // month (103x)
-		57414: 58,  // rollback (103x)
-		57429: 59,  // second (103x)
-		57444: 60,  // with (103x)
-		57409: 61,  // yearType (103x)
-		57445: 62,  // zone (103x)
-		57406: 63,  // booleanType (102x)
-		57410: 64,  // dateType (102x)
-		57446: 65,  // prefix (102x)
-		57443: 66,  // stringKwd (102x)
-		57412: 67,  // timestampType (102x)
-		57440: 68,  // timezoneHour (102x)
-		57441: 69,  // timezoneMinute (102x)
-		57432: 70,  // arrayAgg (101x)
-		57433: 71,  // avg (101x)
-		57442: 72,  // cast (101x)
-		57434: 73,  // count (101x)
-		57439: 74,  // extract (101x)
-		57346: 75,  // identifier (101x)
-		57425: 76,  // interval (101x)
-		57435: 77,  // listagg (101x)
-		57436: 78,  // max (101x)
-		57437: 79,  // min (101x)
-		57430: 80,  // substring (101x)
-		57438: 81,  // sum (101x)
-		57389: 82,  // where (100x)
-		57548: 83,  // Identifier (82x)
-		57617: 84,  // UnReservedKeyword (82x)
-		57464: 85,  // intLit (65x)
-		46:    86,  // '.' (63x)
-		57347: 87,  // stringLit (62x)
-		57623: 88,  // VariableName (62x)
-		57475: 89,  // paramMarker (61x)
-		57486: 90,  // reachIncomingRight (60x)
-		123:   91,  // '{' (58x)
-		57466: 92,  // bitLit (58x)
-		57363: 93,  // exists (58x)
-		57465: 94,  // hexLit (58x)
-		57451: 95,  // label (58x)
-		57484: 96,  // reachOutgoingRight (58x)
-		57482: 97,  // edgeIncomingRight (57x)
-		58:    98,  // ':' (56x)
-		63:    99,  // '?' (56x)
-		57454: 100, // abs (56x)
-		57461: 101, // allDifferent (56x)
-		57396: 102, // caseKwd (56x)
-		57455: 103, // ceil (56x)
-		57456: 104, // ceiling (56x)
-		57463: 105, // decLit (56x)
-		57457: 106, // elementNumber (56x)
-		57364: 107, // falseKwd (56x)
-		57462: 108, // floatLit (56x)
-		57458: 109, // floor (56x)
-		57459: 110, // hasLabel (56x)
-		57460: 111, // id (56x)
-		57449: 112, // inDegree (56x)
-		57450: 113, // javaRegexpLike (56x)
-		57447: 114, // lower (56x)
-		57452: 115, // matchNumber (56x)
-		57453: 116, // outDegree (56x)
-		57384: 117, // trueKwd (56x)
-		57448: 118, // uppper (56x)
-		57480: 119, // edgeOutgoingRight (55x)
-		57395: 120, // properties (52x)
-		57362: 121, // edge (50x)
-		57590: 122, // PropertyAccess (50x)
-		57388: 123, // vertex (50x)
-		57613: 124, // StringLiteral (49x)
-		124:   125, // '|' (48x)
-		57393: 126, // between (48x)
-		57614: 127, // Subquery (48x)
-		57494: 128, // Aggregation (47x)
-		57497: 129, // ArithmeticExpression (47x)
-		57499: 130, // BindVariable (47x)
-		57500: 131, // BooleanLiteral (47x)
-		57501: 132, // BracketedValueExpression (47x)
-		57504: 133, // CaseExpression (47x)
-		57505: 134, // CastSpecification (47x)
-		57506: 135, // CharacterSubstring (47x)
-		57515: 136, // DateLiteral (47x)
-		57526: 137, // ExistsPredicate (47x)
-		57530: 138, // ExtractFunction (47x)
-		57536: 139, // FunctionInvocation (47x)
-		57537: 140, // FunctionName (47x)
-		57551: 141, // InPredicate (47x)
-		57556: 142, // IntervalLiteral (47x)
-		57559: 143, // IsNotNullPredicate (47x)
-		57560: 144, // IsNullPredicate (47x)
-		57573: 145, // Literal (47x)
-		57574: 146, // LogicalExpression (47x)
-		57577: 147, // NotInPredicate (47x)
-		57578: 148, // NumericLiteral (47x)
-		57597: 149, // RelationalExpression (47x)
-		57600: 150, // ScalarSubquery (47x)
-		57601: 151, // SearchedCase (47x)
-		57607: 152, // SimpleCase (47x)
-		57612: 153, // StringConcat (47x)
-		57615: 154, // TimeLiteral (47x)
-		57616: 155, // TimestampLiteral (47x)
-		57620: 156, // ValueExpression (47x)
-		57626: 157, // VariableReference (47x)
-		57382: 158, // set (46x)
-		57476: 159, // allProp (45x)
-		57628: 160, // VertexPattern (19x)
-		57379: 161, // on (17x)
-		57622: 162, // VariableLengthPathPattern (10x)
-		57481: 163, // edgeIncomingLeft (9x)
-		57479: 164, // edgeOutgoingLeft (9x)
-		57477: 165, // leftArrow (9x)
-		57478: 166, // rightArrow (9x)
-		57401: 167, // distinct (8x)
-		57518: 168, // DistinctOpt (8x)
-		57542: 169, // GraphName (6x)
-		57369: 170, // ifKwd (6x)
-		57561: 171, // LabelName (6x)
-		57583: 172, // PathPatternMacro (6x)
-		57625: 173, // VariableNameOpt (6x)
-		57632: 174, // WhereClauseOpt (6x)
-		57527: 175, // ExpAsVar (5x)
-		57584: 176, // PathPatternMacroList (5x)
-		57585: 177, // PathPatternMacroOpt (5x)
-		57485: 178, // reachIncomingLeft (5x)
-		57483: 179, // reachOutgoingLeft (5x)
-		57605: 180, // SelectStmt (5x)
-		125:   181, // '}' (4x)
-		57534: 182, // FromClause (4x)
-		57546: 183, // GroupByClauseOpt (4x)
-		57547: 184, // HavingClauseOpt (4x)
-		57370: 185, // index (4x)
-		57570: 186, // LimitClauseOpt (4x)
-		57580: 187, // OrderByClauseOpt (4x)
-		57581: 188, // PathPattern (4x)
-		57586: 189, // PatternQuantifier (4x)
-		57587: 190, // PatternQuantifierOpt (4x)
-		57608: 191, // SimplePathPattern (4x)
-		57627: 192, // VariableSpec (4x)
-		57630: 193, // WhenClause (4x)
-		57502: 194, // ByItem (3x)
-		57507: 195, // ColonOrIsKeyword (3x)
-		57522: 196, // EdgePattern (3x)
-		57549: 197, // IfExists (3x)
-		57550: 198, // IfNotExists (3x)
-		57564: 199, // LabelPredicate (3x)
-		57569: 200, // LengthNum (3x)
-		57571: 201, // LimitOption (3x)
-		57591: 202, // PropertyAssignment (3x)
-		57593: 203, // PropertyName (3x)
-		57498: 204, // BeginStmt (2x)
-		57355: 205, // by (2x)
-		57503: 206, // ByList (2x)
-		57508: 207, // CommitStmt (2x)
-		57356: 208, // create (2x)
-		57511: 209, // CreateGraphStmt (2x)
-		57512: 210, // CreateIndexStmt (2x)
-		57513: 211, // CreateLabelStmt (2x)
-		57517: 212, // DeleteStmt (2x)
-		57361: 213, // drop (2x)
-		57519: 214, // DropGraphStmt (2x)
-		57520: 215, // DropIndexStmt (2x)
-		57521: 216, // DropLabelStmt (2x)
-		57523: 217, // ElseClauseOpt (2x)
-		57524: 218, // EmptyStmt (2x)
-		57528: 219, // ExplainStmt (2x)
-		57538: 220, // GraphElementInsertion (2x)
-		57540: 221, // GraphElementUpdate (2x)
-		57555: 222, // InsertStmt (2x)
-		57552: 223, // InValueList (2x)
-		57568: 224, // LabelsAndProperties (2x)
-		57566: 225, // LabelSpecification (2x)
-		57567: 226, // LabelSpecificationOpt (2x)
-		57376: 227, // match (2x)
-		57575: 228, // MatchClause (2x)
-		57378: 229, // null (2x)
-		57592: 230, // PropertyAssignmentList (2x)
-		57598: 231, // RollbackStmt (2x)
-		57602: 232, // SelectClause (2x)
-		57603: 233, // SelectEelement (2x)
-		57383: 234, // show (2x)
-		57606: 235, // ShowStmt (2x)
-		57610: 236, // Statement (2x)
-		57618: 237, // UpdateStmt (2x)
-		57387: 238, // use (2x)
-		57619: 239, // UseStmt (2x)
-		57629: 240, // VertexPatternOpt (2x)
-		57631: 241, // WhenClauseList (2x)
-		57495: 242, // AllPropertiesPrefixOpt (1x)
-		57496: 243, // ArgumentList (1x)
-		57509: 244, // CostClause (1x)
-		57510: 245, // CostClauseOpt (1x)
-		57514: 246, // DataType (1x)
-		57516: 247, // DateTimeField (1x)
-		57407: 248, // decimalType (1x)
-		57360: 249, // doubleType (1x)
-		57525: 250, // Entry (1x)
-		57529: 251, // ExtractField (1x)
-		57531: 252, // FieldAsName (1x)
-		57532: 253, // FieldAsNameOpt (1x)
-		57365: 254, // floatType (1x)
-		57533: 255, // ForStringLengthOpt (1x)
-		57535: 256, // FromClauseOpt (1x)
-		57539: 257, // GraphElementInsertionList (1x)
-		57541: 258, // GraphElementUpdateList (1x)
-		57543: 259, // GraphOnClause (1x)
-		57544: 260, // GraphOnClauseOpt (1x)
-		57545: 261, // GraphPattern (1x)
-		57417: 262, // graphs (1x)
-		57553: 263, // IndexKeyTypeOpt (1x)
-		57554: 264, // IndexName (1x)
-		57372: 265, // integerType (1x)
-		57373: 266, // into (1x)
-		57557: 267, // IntoClause (1x)
-		57558: 268, // IntoClauseOpt (1x)
-		57562: 269, // LabelNameList (1x)
-		57563: 270, // LabelNameListWithComma (1x)
-		57565: 271, // LabelPredicateOpt (1x)
-		57572: 272, // ListaggSeparatorOpt (1x)
-		57576: 273, // MatchClauseList (1x)
-		57579: 274, // Order (1x)
-		57582: 275, // PathPatternList (1x)
-		57588: 276, // PropertiesSpecification (1x)
-		57589: 277, // PropertiesSpecificationOpt (1x)
-		57594: 278, // PropertyNameList (1x)
-		57595: 279, // QuantifiedPathExpr (1x)
-		57596: 280, // ReachabilityPathExpr (1x)
-		57599: 281, // RowsPerMatchOpt (1x)
-		57604: 282, // SelectElementList (1x)
-		57609: 283, // StartPosition (1x)
-		57611: 284, // StatementList (1x)
-		57385: 285, // unique (1x)
-		57621: 286, // ValueExpressionList (1x)
-		57624: 287, // VariableNameList (1x)
-		57493: 288, // $default (0x)
-		38:    289, // '&' (0x)
-		94:    290, // '^' (0x)
-		126:   291, // '~' (0x)
-		57351: 292, // andand (0x)
-		57467: 293, // andnot (0x)
-		57468: 294, // assignmentEq (0x)
-		57404: 295, // comment (0x)
-		57357: 296, // defaultKwd (0x)
-		57490: 297, // div (0x)
-		57349: 298, // doubleAtIdentifier (0x)
-		57487: 299, // empty (0x)
-		57345: 300, // error (0x)
-		57350: 301, // invalid (0x)
-		57488: 302, // lowerThanOn (0x)
-		57491: 303, // mod (0x)
-		57492: 304, // neg (0x)
-		57472: 305, // neq (0x)
-		57474: 306, // nulleq (0x)
-		57489: 307, // pipesAsOr (0x)
-		57348: 308, // singleAtIdentifier (0x)
+		41:    0,   // ')' (309x)
+		57424: 1,   // path (309x)
+		57344: 2,   // $end (304x)
+		59:    3,   // ';' (303x)
+		57423: 4,   // cost (296x)
+		44:    5,   // ',' (293x)
+		57403: 6,   // end (292x)
+		57431: 7,   // forkKwd (286x)
+		57377: 8,   // not (280x)
+		45:    9,   // '-' (270x)
+		91:    10,  // '[' (262x)
+		57375: 11,  // limit (245x)
+		57380: 12,  // order (240x)
+		57368: 13,  // having (235x)
+		57367: 14,  // group (219x)
+		42:    15,  // '*' (216x)
+		57366: 16,  // from (216x)
+		43:    17,  // '+' (214x)
+		57374: 18,  // is (211x)
+		57400: 19,  // in (203x)
+		57392: 20,  // and (201x)
+		57473: 21,  // eq (201x)
+		37:    22,  // '%' (200x)
+		47:    23,  // '/' (200x)
+		60:    24,  // '<' (200x)
+		62:    25,  // '>' (200x)
+		57474: 26,  // ge (200x)
+		57475: 27,  // le (200x)
+		57477: 28,  // neqSynonym (200x)
+		57391: 29,  // or (200x)
+		57352: 30,  // pipes (200x)
+		57390: 31,  // xor (200x)
+		57381: 32,  // selectKwd (197x)
+		57358: 33,  // deleteKwd (192x)
+		57371: 34,  // insert (192x)
+		57386: 35,  // update (192x)
+		40:    36,  // '(' (189x)
+		125:   37,  // '}' (179x)
+		57398: 38,  // when (176x)
+		57354: 39,  // asc (175x)
+		57359: 40,  // desc (175x)
+		93:    41,  // ']' (174x)
+		57399: 42,  // elseKwd (174x)
+		57353: 43,  // as (173x)
+		57397: 44,  // then (170x)
+		57413: 45,  // timeType (168x)
+		57410: 46,  // dateType (166x)
+		57412: 47,  // timestampType (166x)
+		57406: 48,  // booleanType (165x)
+		57443: 49,  // stringKwd (165x)
+		57449: 50,  // strict (164x)
+		57479: 51,  // paramMarker (126x)
+		123:   52,  // '{' (121x)
+		57421: 53,  // cheapest (120x)
+		57420: 54,  // shortest (120x)
+		57415: 55,  // offset (119x)
+		57418: 56,  // all (118x)
+		57447: 57,  // analyze (118x)
+		57419: 58,  // any (118x)
+		57416: 59,  // graph (118x)
+		57422: 60,  // top (118x)
+		57402: 61,  // begin (117x)
+		57405: 62,  // commit (117x)
+		57411: 63,  // day (117x)
+		57408: 64,  // explain (117x)
+		57426: 65,  // hour (117x)
+		57427: 66,  // minute (117x)
+		57428: 67,  // month (117x)
+		57414: 68,  // rollback (117x)
+		57429: 69,  // second (117x)
+		57444: 70,  // with (117x)
+		57409: 71,  // yearType (117x)
+		57445: 72,  // zone (117x)
+		57425: 73,  // interval (116x)
+		57446: 74,  // prefix (116x)
+		57448: 75,  // stats (116x)
+		57440: 76,  // timezoneHour (116x)
+		57441: 77,  // timezoneMinute (116x)
+		57432: 78,  // arrayAgg (115x)
+		57433: 79,  // avg (115x)
+		57442: 80,  // cast (115x)
+		57434: 81,  // count (115x)
+		57439: 82,  // extract (115x)
+		57346: 83,  // identifier (115x)
+		57435: 84,  // listagg (115x)
+		57436: 85,  // max (115x)
+		57437: 86,  // min (115x)
+		57430: 87,  // substring (115x)
+		57438: 88,  // sum (115x)
+		57394: 89,  // labels (113x)
+		57389: 90,  // where (103x)
+		57552: 91,  // Identifier (96x)
+		57634: 92,  // UnReservedKeyword (96x)
+		57468: 93,  // intLit (72x)
+		57347: 94,  // stringLit (72x)
+		57640: 95,  // VariableName (68x)
+		46:    96,  // '.' (67x)
+		57379: 97,  // on (66x)
+		57470: 98,  // bitLit (63x)
+		57469: 99,  // hexLit (63x)
+		57490: 100, // reachIncomingRight (63x)
+		57467: 101, // decLit (62x)
+		57363: 102, // exists (62x)
+		57466: 103, // floatLit (62x)
+		57454: 104, // label (62x)
+		58:    105, // ':' (61x)
+		57364: 106, // falseKwd (61x)
+		57488: 107, // reachOutgoingRight (61x)
+		57384: 108, // trueKwd (61x)
+		57457: 109, // abs (60x)
+		57464: 110, // allDifferent (60x)
+		57396: 111, // caseKwd (60x)
+		57458: 112, // ceil (60x)
+		57459: 113, // ceiling (60x)
+		57486: 114, // edgeIncomingRight (60x)
+		57460: 115, // elementNumber (60x)
+		57461: 116, // floor (60x)
+		57462: 117, // hasLabel (60x)
+		57463: 118, // id (60x)
+		57452: 119, // inDegree (60x)
+		57453: 120, // javaRegexpLike (60x)
+		57450: 121, // lower (60x)
+		57455: 122, // matchNumber (60x)
+		57465: 123, // mod (60x)
+		57456: 124, // outDegree (60x)
+		57451: 125, // uppper (60x)
+		57484: 126, // edgeOutgoingRight (58x)
+		57604: 127, // PropertyAccess (56x)
+		57395: 128, // properties (55x)
+		57629: 129, // StringLiteral (54x)
+		57503: 130, // BindVariable (53x)
+		57362: 131, // edge (53x)
+		57581: 132, // ListLiteral (53x)
+		57592: 133, // NumericLiteral (53x)
+		57643: 134, // VariableReference (53x)
+		57388: 135, // vertex (53x)
+		57504: 136, // BooleanLiteral (52x)
+		57519: 137, // DateLiteral (52x)
+		57560: 138, // IntervalLiteral (52x)
+		57584: 139, // Literal (52x)
+		57630: 140, // Subquery (52x)
+		57632: 141, // TimeLiteral (52x)
+		57633: 142, // TimestampLiteral (52x)
+		124:   143, // '|' (51x)
+		57497: 144, // Aggregation (51x)
+		57501: 145, // ArithmeticExpression (51x)
+		57393: 146, // between (51x)
+		57505: 147, // BracketedValueExpression (51x)
+		57508: 148, // CaseExpression (51x)
+		57509: 149, // CastSpecification (51x)
+		57510: 150, // CharacterSubstring (51x)
+		57530: 151, // ExistsPredicate (51x)
+		57534: 152, // ExtractFunction (51x)
+		57540: 153, // FunctionInvocation (51x)
+		57541: 154, // FunctionName (51x)
+		57555: 155, // InPredicate (51x)
+		57563: 156, // IsNotNullPredicate (51x)
+		57564: 157, // IsNullPredicate (51x)
+		57585: 158, // LogicalExpression (51x)
+		57588: 159, // MapLiteral (51x)
+		57591: 160, // NotInPredicate (51x)
+		57611: 161, // RelationalExpression (51x)
+		57614: 162, // ScalarSubquery (51x)
+		57615: 163, // SearchedCase (51x)
+		57622: 164, // SimpleCase (51x)
+		57628: 165, // StringConcat (51x)
+		57631: 166, // SubscriptExpression (51x)
+		57637: 167, // ValueExpression (51x)
+		57407: 168, // decimalType (50x)
+		57360: 169, // doubleType (50x)
+		57365: 170, // floatType (50x)
+		57372: 171, // integerType (50x)
+		57382: 172, // set (49x)
+		57480: 173, // allProp (48x)
+		57357: 174, // defaultKwd (43x)
+		57645: 175, // VertexPattern (19x)
+		57639: 176, // VariableLengthPathPattern (10x)
+		57485: 177, // edgeIncomingLeft (9x)
+		57483: 178, // edgeOutgoingLeft (9x)
+		57481: 179, // leftArrow (9x)
+		57482: 180, // rightArrow (9x)
+		57401: 181, // distinct (8x)
+		57522: 182, // DistinctOpt (8x)
+		57546: 183, // GraphName (8x)
+		57565: 184, // LabelName (7x)
+		57597: 185, // PathPatternMacro (7x)
+		57369: 186, // ifKwd (6x)
+		57598: 187, // PathPatternMacroList (6x)
+		57599: 188, // PathPatternMacroOpt (6x)
+		57607: 189, // PropertyName (6x)
+		57619: 190, // SelectStmt (6x)
+		57642: 191, // VariableNameOpt (6x)
+		57649: 192, // WhereClauseOpt (6x)
+		57531: 193, // ExpAsVar (5x)
+		57489: 194, // reachIncomingLeft (5x)
+		57487: 195, // reachOutgoingLeft (5x)
+		57538: 196, // FromClause (4x)
+		57550: 197, // GroupByClauseOpt (4x)
+		57551: 198, // HavingClauseOpt (4x)
+		57370: 199, // index (4x)
+		57579: 200, // LimitClauseOpt (4x)
+		57594: 201, // OrderByClauseOpt (4x)
+		57595: 202, // PathPattern (4x)
+		57600: 203, // PatternQuantifier (4x)
+		57601: 204, // PatternQuantifierOpt (4x)
+		57623: 205, // SimplePathPattern (4x)
+		57644: 206, // VariableSpec (4x)
+		57647: 207, // WhenClause (4x)
+		57506: 208, // ByItem (3x)
+		57511: 209, // ColonOrIsKeyword (3x)
+		57526: 210, // EdgePattern (3x)
+		57553: 211, // IfExists (3x)
+		57554: 212, // IfNotExists (3x)
+		57568: 213, // LabelPredicate (3x)
+		57578: 214, // LengthNum (3x)
+		57580: 215, // LimitOption (3x)
+		57378: 216, // null (3x)
+		57605: 217, // PropertyAssignment (3x)
+		57499: 218, // AnalyzeGraphStmt (2x)
+		57502: 219, // BeginStmt (2x)
+		57355: 220, // by (2x)
+		57507: 221, // ByList (2x)
+		57512: 222, // CommitStmt (2x)
+		57356: 223, // create (2x)
+		57515: 224, // CreateGraphStmt (2x)
+		57516: 225, // CreateIndexStmt (2x)
+		57517: 226, // CreateLabelStmt (2x)
+		57518: 227, // DataType (2x)
+		57521: 228, // DeleteStmt (2x)
+		57361: 229, // drop (2x)
+		57523: 230, // DropGraphStmt (2x)
+		57524: 231, // DropIndexStmt (2x)
+		57525: 232, // DropLabelStmt (2x)
+		57527: 233, // ElseClauseOpt (2x)
+		57528: 234, // EmptyStmt (2x)
+		57532: 235, // ExplainStmt (2x)
+		57542: 236, // GraphElementInsertion (2x)
+		57544: 237, // GraphElementUpdate (2x)
+		57559: 238, // InsertStmt (2x)
+		57556: 239, // InValueList (2x)
+		57570: 240, // LabelPropertyDef (2x)
+		57577: 241, // LabelsAndProperties (2x)
+		57575: 242, // LabelSpecification (2x)
+		57576: 243, // LabelSpecificationOpt (2x)
+		57582: 244, // ListValue (2x)
+		57586: 245, // MapEntry (2x)
+		57376: 246, // match (2x)
+		57589: 247, // MatchClause (2x)
+		57606: 248, // PropertyAssignmentList (2x)
+		57608: 249, // PropertyNameList (2x)
+		57612: 250, // RollbackStmt (2x)
+		57616: 251, // SelectClause (2x)
+		57617: 252, // SelectEelement (2x)
+		57383: 253, // show (2x)
+		57620: 254, // ShowStmt (2x)
+		57625: 255, // Statement (2x)
+		57635: 256, // UpdateStmt (2x)
+		57387: 257, // use (2x)
+		57636: 258, // UseStmt (2x)
+		57638: 259, // ValueExpressionList (2x)
+		57646: 260, // VertexPatternOpt (2x)
+		57648: 261, // WhenClauseList (2x)
+		57498: 262, // AllPropertiesPrefixOpt (1x)
+		57500: 263, // ArgumentList (1x)
+		57513: 264, // CostClause (1x)
+		57514: 265, // CostClauseOpt (1x)
+		57520: 266, // DateTimeField (1x)
+		57529: 267, // Entry (1x)
+		57533: 268, // ExtractField (1x)
+		57535: 269, // FieldAsName (1x)
+		57536: 270, // FieldAsNameOpt (1x)
+		57537: 271, // ForStringLengthOpt (1x)
+		57539: 272, // FromClauseOpt (1x)
+		57543: 273, // GraphElementInsertionList (1x)
+		57545: 274, // GraphElementUpdateList (1x)
+		57547: 275, // GraphOnClause (1x)
+		57548: 276, // GraphOnClauseOpt (1x)
+		57549: 277, // GraphPattern (1x)
+		57417: 278, // graphs (1x)
+		57557: 279, // IndexKeyTypeOpt (1x)
+		57558: 280, // IndexName (1x)
+		57373: 281, // into (1x)
+		57561: 282, // IntoClause (1x)
+		57562: 283, // IntoClauseOpt (1x)
+		57566: 284, // LabelNameList (1x)
+		57567: 285, // LabelNameListWithComma (1x)
+		57569: 286, // LabelPredicateOpt (1x)
+		57571: 287, // LabelPropertyDefList (1x)
+		57572: 288, // LabelPropertyDefListOpt (1x)
+		57573: 289, // LabelPropertyOption (1x)
+		57574: 290, // LabelPropertyOptionList (1x)
+		57583: 291, // ListaggSeparatorOpt (1x)
+		57587: 292, // MapEntryList (1x)
+		57590: 293, // MatchClauseList (1x)
+		57593: 294, // Order (1x)
+		57596: 295, // PathPatternList (1x)
+		57602: 296, // PropertiesSpecification (1x)
+		57603: 297, // PropertiesSpecificationOpt (1x)
+		57609: 298, // QuantifiedPathExpr (1x)
+		57610: 299, // ReachabilityPathExpr (1x)
+		57613: 300, // RowsPerMatchOpt (1x)
+		57618: 301, // SelectElementList (1x)
+		57621: 302, // SignedLiteral (1x)
+		57624: 303, // StartPosition (1x)
+		57626: 304, // StatementList (1x)
+		57627: 305, // StrictOpt (1x)
+		57385: 306, // unique (1x)
+		57641: 307, // VariableNameList (1x)
+		57496: 308, // $default (0x)
+		38:    309, // '&' (0x)
+		94:    310, // '^' (0x)
+		126:   311, // '~' (0x)
+		57351: 312, // andand (0x)
+		57471: 313, // andnot (0x)
+		57472: 314, // assignmentEq (0x)
+		57404: 315, // comment (0x)
+		57494: 316, // div (0x)
+		57349: 317, // doubleAtIdentifier (0x)
+		57491: 318, // empty (0x)
+		57345: 319, // error (0x)
+		57350: 320, // invalid (0x)
+		57492: 321, // lowerThanOn (0x)
+		57495: 322, // neg (0x)
+		57476: 323, // neq (0x)
+		57478: 324, // nulleq (0x)
+		57493: 325, // pipesAsOr (0x)
+		57348: 326, // singleAtIdentifier (0x)
 	}
 
 	yySymNames = []string{
@@ -519,17 +540,18 @@ var (
 		"$end",
 		"';'",
 		"cost",
+		"','",
 		"end",
 		"forkKwd",
-		"','",
-		"'-'",
 		"not",
+		"'-'",
+		"'['",
 		"limit",
 		"order",
 		"having",
 		"group",
-		"from",
 		"'*'",
+		"from",
 		"'+'",
 		"is",
 		"in",
@@ -546,29 +568,38 @@ var (
 		"pipes",
 		"xor",
 		"selectKwd",
-		"'('",
 		"deleteKwd",
 		"insert",
 		"update",
+		"'('",
+		"'}'",
 		"when",
 		"asc",
 		"desc",
+		"']'",
 		"elseKwd",
 		"as",
 		"then",
+		"timeType",
+		"dateType",
+		"timestampType",
+		"booleanType",
+		"stringKwd",
+		"strict",
+		"paramMarker",
+		"'{'",
 		"cheapest",
-		"labels",
 		"shortest",
 		"offset",
 		"all",
+		"analyze",
 		"any",
-		"timeType",
+		"graph",
 		"top",
 		"begin",
 		"commit",
 		"day",
 		"explain",
-		"graph",
 		"hour",
 		"minute",
 		"month",
@@ -577,11 +608,9 @@ var (
 		"with",
 		"yearType",
 		"zone",
-		"booleanType",
-		"dateType",
+		"interval",
 		"prefix",
-		"stringKwd",
-		"timestampType",
+		"stats",
 		"timezoneHour",
 		"timezoneMinute",
 		"arrayAgg",
@@ -590,39 +619,38 @@ var (
 		"count",
 		"extract",
 		"identifier",
-		"interval",
 		"listagg",
 		"max",
 		"min",
 		"substring",
 		"sum",
+		"labels",
 		"where",
 		"Identifier",
 		"UnReservedKeyword",
 		"intLit",
-		"'.'",
 		"stringLit",
 		"VariableName",
-		"paramMarker",
-		"reachIncomingRight",
-		"'{'",
+		"'.'",
+		"on",
 		"bitLit",
-		"exists",
 		"hexLit",
+		"reachIncomingRight",
+		"decLit",
+		"exists",
+		"floatLit",
 		"label",
-		"reachOutgoingRight",
-		"edgeIncomingRight",
 		"':'",
-		"'?'",
+		"falseKwd",
+		"reachOutgoingRight",
+		"trueKwd",
 		"abs",
 		"allDifferent",
 		"caseKwd",
 		"ceil",
 		"ceiling",
-		"decLit",
+		"edgeIncomingRight",
 		"elementNumber",
-		"falseKwd",
-		"floatLit",
 		"floor",
 		"hasLabel",
 		"id",
@@ -630,52 +658,59 @@ var (
 		"javaRegexpLike",
 		"lower",
 		"matchNumber",
+		"mod",
 		"outDegree",
-		"trueKwd",
 		"uppper",
 		"edgeOutgoingRight",
+		"PropertyAccess",
 		"properties",
+		"StringLiteral",
+		"BindVariable",
 		"edge",
-		"PropertyAccess",
+		"ListLiteral",
+		"NumericLiteral",
+		"VariableReference",
 		"vertex",
-		"StringLiteral",
-		"'|'",
-		"between",
+		"BooleanLiteral",
+		"DateLiteral",
+		"IntervalLiteral",
+		"Literal",
 		"Subquery",
+		"TimeLiteral",
+		"TimestampLiteral",
+		"'|'",
 		"Aggregation",
 		"ArithmeticExpression",
-		"BindVariable",
-		"BooleanLiteral",
+		"between",
 		"BracketedValueExpression",
 		"CaseExpression",
 		"CastSpecification",
 		"CharacterSubstring",
-		"DateLiteral",
 		"ExistsPredicate",
 		"ExtractFunction",
 		"FunctionInvocation",
 		"FunctionName",
 		"InPredicate",
-		"IntervalLiteral",
 		"IsNotNullPredicate",
 		"IsNullPredicate",
-		"Literal",
 		"LogicalExpression",
+		"MapLiteral",
 		"NotInPredicate",
-		"NumericLiteral",
 		"RelationalExpression",
 		"ScalarSubquery",
 		"SearchedCase",
 		"SimpleCase",
 		"StringConcat",
-		"TimeLiteral",
-		"TimestampLiteral",
+		"SubscriptExpression",
 		"ValueExpression",
-		"VariableReference",
+		"decimalType",
+		"doubleType",
+		"floatType",
+		"integerType",
 		"set",
 		"allProp",
+		"defaultKwd",
 		"VertexPattern",
-		"on",
 		"VariableLengthPathPattern",
 		"edgeIncomingLeft",
 		"edgeOutgoingLeft",
@@ -684,18 +719,18 @@ var (
 		"distinct",
 		"DistinctOpt",
 		"GraphName",
-		"ifKwd",
 		"LabelName",
 		"PathPatternMacro",
+		"ifKwd",
+		"PathPatternMacroList",
+		"PathPatternMacroOpt",
+		"PropertyName",
+		"SelectStmt",
 		"VariableNameOpt",
 		"WhereClauseOpt",
 		"ExpAsVar",
-		"PathPatternMacroList",
-		"PathPatternMacroOpt",
 		"reachIncomingLeft",
 		"reachOutgoingLeft",
-		"SelectStmt",
-		"'}'",
 		"FromClause",
 		"GroupByClauseOpt",
 		"HavingClauseOpt",
@@ -716,8 +751,9 @@ var (
 		"LabelPredicate",
 		"LengthNum",
 		"LimitOption",
+		"null",
 		"PropertyAssignment",
-		"PropertyName",
+		"AnalyzeGraphStmt",
 		"BeginStmt",
 		"by",
 		"ByList",
@@ -726,6 +762,7 @@ var (
 		"CreateGraphStmt",
 		"CreateIndexStmt",
 		"CreateLabelStmt",
+		"DataType",
 		"DeleteStmt",
 		"drop",
 		"DropGraphStmt",
@@ -738,13 +775,16 @@ var (
 		"GraphElementUpdate",
 		"InsertStmt",
 		"InValueList",
+		"LabelPropertyDef",
 		"LabelsAndProperties",
 		"LabelSpecification",
 		"LabelSpecificationOpt",
+		"ListValue",
+		"MapEntry",
 		"match",
 		"MatchClause",
-		"null",
 		"PropertyAssignmentList",
+		"PropertyNameList",
 		"RollbackStmt",
 		"SelectClause",
 		"SelectEelement",
@@ -754,21 +794,18 @@ var (
 		"UpdateStmt",
 		"use",
 		"UseStmt",
+		"ValueExpressionList",
 		"VertexPatternOpt",
 		"WhenClauseList",
 		"AllPropertiesPrefixOpt",
 		"ArgumentList",
 		"CostClause",
 		"CostClauseOpt",
-		"DataType",
 		"DateTimeField",
-		"decimalType",
-		"doubleType",
 		"Entry",
 		"ExtractField",
 		"FieldAsName",
 		"FieldAsNameOpt",
-		"floatType",
 		"ForStringLengthOpt",
 		"FromClauseOpt",
 		"GraphElementInsertionList",
@@ -779,28 +816,32 @@ var (
 		"graphs",
 		"IndexKeyTypeOpt",
 		"IndexName",
-		"integerType",
 		"into",
 		"IntoClause",
 		"IntoClauseOpt",
 		"LabelNameList",
 		"LabelNameListWithComma",
 		"LabelPredicateOpt",
+		"LabelPropertyDefList",
+		"LabelPropertyDefListOpt",
+		"LabelPropertyOption",
+		"LabelPropertyOptionList",
 		"ListaggSeparatorOpt",
+		"MapEntryList",
 		"MatchClauseList",
 		"Order",
 		"PathPatternList",
 		"PropertiesSpecification",
 		"PropertiesSpecificationOpt",
-		"PropertyNameList",
 		"QuantifiedPathExpr",
 		"ReachabilityPathExpr",
 		"RowsPerMatchOpt",
 		"SelectElementList",
+		"SignedLiteral",
 		"StartPosition",
 		"StatementList",
+		"StrictOpt",
 		"unique",
-		"ValueExpressionList",
 		"VariableNameList",
 		"$default",
 		"'&'",
@@ -810,14 +851,12 @@ var (
 		"andnot",
 		"assignmentEq",
 		"comment",
-		"defaultKwd",
 		"div",
 		"doubleAtIdentifier",
 		"empty",
 		"error",
 		"invalid",
 		"lowerThanOn",
-		"mod",
 		"neg",
 		"neq",
 		"nulleq",
@@ -827,1101 +866,1231 @@ var (
 
 	yyReductions = []struct{ xsym, components int }{
 		{0, 1},
-		{250, 1},
-		{284, 1},
-		{284, 3},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{236, 1},
-		{218, 0},
-		{204, 1},
-		{207, 1},
-		{209, 4},
-		{211, 4},
-		{210, 8},
-		{263, 0},
+		{267, 1},
+		{304, 1},
+		{304, 3},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{255, 1},
+		{234, 0},
+		{218, 2},
+		{218, 3},
+		{219, 1},
+		{222, 1},
+		{224, 5},
+		{305, 0},
+		{305, 1},
+		{226, 5},
+		{288, 0},
+		{288, 3},
+		{287, 1},
+		{287, 3},
+		{240, 3},
+		{290, 0},
+		{290, 2},
+		{289, 2},
+		{289, 2},
+		{302, 1},
+		{302, 2},
+		{225, 8},
+		{225, 10},
+		{279, 0},
+		{279, 1},
+		{228, 9},
+		{230, 4},
+		{232, 4},
+		{231, 4},
+		{235, 2},
+		{235, 3},
+		{238, 10},
+		{283, 0},
+		{283, 1},
+		{282, 2},
+		{273, 1},
+		{273, 3},
+		{236, 3},
+		{236, 7},
+		{241, 2},
+		{243, 0},
+		{243, 1},
+		{242, 4},
+		{297, 0},
+		{297, 1},
+		{296, 4},
+		{248, 1},
+		{248, 3},
+		{217, 3},
+		{127, 3},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{167, 1},
+		{134, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{129, 1},
+		{129, 1},
+		{129, 1},
+		{133, 1},
+		{133, 1},
+		{133, 1},
+		{136, 1},
+		{136, 1},
+		{137, 2},
+		{141, 2},
+		{142, 2},
+		{138, 3},
+		{266, 1},
+		{266, 1},
+		{266, 1},
+		{266, 1},
+		{266, 1},
+		{266, 1},
+		{130, 1},
+		{145, 2},
+		{145, 3},
+		{145, 3},
+		{145, 3},
+		{145, 3},
+		{145, 3},
+		{161, 3},
+		{161, 3},
+		{161, 3},
+		{161, 3},
+		{161, 3},
+		{161, 3},
+		{158, 3},
+		{158, 3},
+		{158, 3},
+		{158, 2},
+		{165, 3},
+		{147, 3},
+		{132, 2},
+		{132, 3},
+		{159, 2},
+		{159, 3},
+		{292, 1},
+		{292, 3},
+		{245, 3},
+		{245, 3},
+		{166, 4},
+		{153, 4},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
+		{154, 1},
 		{263, 1},
-		{212, 9},
-		{214, 4},
-		{216, 4},
-		{215, 4},
-		{219, 2},
-		{222, 10},
-		{268, 0},
+		{263, 3},
+		{150, 7},
+		{303, 1},
+		{271, 0},
+		{271, 2},
+		{144, 4},
+		{144, 5},
+		{144, 5},
+		{144, 5},
+		{144, 5},
+		{144, 5},
+		{144, 5},
+		{144, 6},
+		{182, 0},
+		{182, 1},
+		{291, 0},
+		{291, 2},
+		{152, 6},
 		{268, 1},
-		{267, 2},
-		{257, 1},
-		{257, 3},
-		{220, 3},
-		{220, 7},
-		{224, 2},
-		{226, 0},
-		{226, 1},
-		{225, 4},
-		{277, 0},
-		{277, 1},
-		{276, 4},
-		{230, 1},
-		{230, 3},
-		{202, 3},
-		{122, 3},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{156, 1},
-		{157, 1},
-		{145, 1},
-		{145, 1},
-		{145, 1},
-		{145, 1},
-		{145, 1},
-		{145, 1},
-		{145, 1},
-		{124, 1},
-		{124, 1},
-		{124, 1},
-		{148, 1},
+		{268, 1},
+		{268, 1},
+		{268, 1},
+		{268, 1},
+		{268, 1},
+		{268, 1},
+		{268, 1},
+		{157, 3},
+		{156, 4},
+		{149, 6},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 1},
+		{227, 4},
+		{227, 1},
+		{227, 4},
 		{148, 1},
 		{148, 1},
-		{131, 1},
-		{131, 1},
-		{136, 2},
-		{154, 2},
-		{155, 2},
-		{142, 3},
-		{247, 1},
-		{247, 1},
-		{247, 1},
-		{247, 1},
-		{247, 1},
-		{247, 1},
-		{130, 1},
-		{129, 2},
-		{129, 3},
-		{129, 3},
-		{129, 3},
-		{129, 3},
-		{129, 3},
-		{149, 3},
-		{149, 3},
-		{149, 3},
-		{149, 3},
-		{149, 3},
-		{149, 3},
-		{146, 3},
-		{146, 3},
-		{146, 3},
-		{146, 2},
-		{153, 3},
-		{132, 3},
-		{139, 4},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{140, 1},
-		{243, 1},
-		{243, 3},
-		{135, 7},
-		{283, 1},
-		{255, 0},
-		{255, 2},
-		{128, 4},
-		{128, 5},
-		{128, 5},
-		{128, 5},
-		{128, 5},
-		{128, 5},
-		{128, 5},
-		{128, 6},
-		{168, 0},
-		{168, 1},
+		{164, 5},
+		{163, 4},
+		{261, 1},
+		{261, 2},
+		{207, 4},
+		{233, 0},
+		{233, 2},
+		{155, 3},
+		{155, 3},
+		{160, 4},
+		{160, 4},
+		{239, 3},
+		{244, 1},
+		{244, 1},
+		{244, 1},
+		{244, 1},
+		{259, 1},
+		{259, 3},
+		{151, 2},
+		{140, 3},
+		{162, 1},
+		{250, 1},
+		{190, 8},
+		{251, 3},
+		{251, 2},
+		{301, 1},
+		{301, 3},
+		{252, 1},
+		{252, 3},
+		{193, 2},
+		{262, 0},
+		{262, 2},
+		{270, 0},
+		{270, 1},
+		{269, 2},
+		{269, 2},
+		{196, 2},
 		{272, 0},
-		{272, 2},
-		{138, 6},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{251, 1},
-		{144, 3},
-		{143, 4},
-		{134, 6},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 1},
-		{246, 4},
-		{246, 1},
-		{246, 4},
-		{133, 1},
-		{133, 1},
-		{152, 5},
-		{151, 4},
-		{241, 1},
-		{241, 2},
-		{193, 4},
-		{217, 0},
-		{217, 2},
-		{141, 3},
-		{147, 4},
-		{223, 3},
-		{286, 1},
-		{286, 3},
-		{137, 2},
-		{127, 3},
-		{150, 1},
-		{231, 1},
-		{180, 8},
-		{232, 3},
-		{232, 2},
-		{282, 1},
-		{282, 3},
-		{233, 1},
-		{233, 3},
-		{175, 2},
-		{242, 0},
-		{242, 2},
-		{253, 0},
-		{253, 1},
-		{252, 2},
-		{252, 2},
-		{182, 2},
-		{256, 0},
-		{256, 1},
-		{273, 1},
-		{273, 3},
-		{228, 4},
-		{259, 2},
+		{272, 1},
+		{293, 1},
+		{293, 3},
+		{247, 4},
+		{275, 2},
+		{276, 0},
+		{276, 1},
+		{300, 0},
+		{277, 1},
+		{277, 3},
+		{295, 1},
+		{295, 3},
+		{202, 1},
+		{202, 2},
+		{202, 3},
+		{202, 3},
+		{202, 4},
+		{202, 3},
+		{202, 3},
+		{202, 4},
+		{202, 2},
+		{205, 1},
+		{205, 3},
+		{205, 3},
+		{176, 3},
+		{299, 4},
+		{299, 4},
+		{299, 4},
+		{175, 3},
 		{260, 0},
 		{260, 1},
-		{281, 0},
-		{261, 1},
-		{261, 3},
-		{275, 1},
-		{275, 3},
-		{188, 1},
-		{188, 2},
-		{188, 3},
-		{188, 3},
-		{188, 4},
-		{188, 3},
-		{188, 3},
-		{188, 4},
-		{188, 2},
+		{210, 3},
+		{210, 1},
+		{210, 3},
+		{210, 1},
+		{210, 3},
+		{210, 1},
+		{206, 2},
+		{95, 1},
+		{191, 0},
 		{191, 1},
-		{191, 3},
-		{191, 3},
-		{162, 3},
-		{280, 4},
-		{280, 4},
-		{280, 4},
-		{160, 3},
-		{240, 0},
-		{240, 1},
-		{196, 3},
-		{196, 1},
-		{196, 3},
-		{196, 1},
-		{196, 3},
-		{196, 1},
+		{307, 1},
+		{307, 3},
+		{213, 2},
+		{286, 0},
+		{286, 1},
+		{209, 1},
+		{209, 1},
+		{285, 1},
+		{285, 3},
+		{284, 1},
+		{284, 3},
+		{298, 2},
+		{298, 8},
+		{264, 2},
+		{265, 0},
+		{265, 1},
+		{203, 1},
+		{203, 1},
+		{203, 1},
+		{203, 3},
+		{203, 4},
+		{203, 5},
+		{203, 4},
+		{204, 0},
+		{204, 1},
+		{188, 0},
+		{188, 1},
+		{187, 1},
+		{187, 2},
+		{185, 5},
+		{192, 0},
 		{192, 2},
-		{88, 1},
-		{173, 0},
-		{173, 1},
-		{287, 1},
-		{287, 3},
-		{199, 2},
-		{271, 0},
-		{271, 1},
-		{195, 1},
-		{195, 1},
-		{270, 1},
-		{270, 3},
-		{269, 1},
-		{269, 3},
-		{279, 2},
-		{279, 8},
-		{244, 2},
-		{245, 0},
-		{245, 1},
-		{189, 1},
-		{189, 1},
-		{189, 1},
-		{189, 3},
-		{189, 4},
-		{189, 5},
-		{189, 4},
-		{190, 0},
-		{190, 1},
-		{177, 0},
-		{177, 1},
-		{176, 1},
-		{176, 2},
-		{172, 5},
-		{174, 0},
-		{174, 2},
-		{183, 0},
-		{183, 3},
-		{206, 1},
-		{206, 3},
-		{194, 1},
-		{194, 2},
-		{274, 1},
-		{274, 1},
-		{184, 0},
-		{184, 2},
-		{187, 0},
-		{187, 3},
-		{186, 0},
-		{186, 2},
-		{186, 4},
-		{186, 4},
-		{201, 1},
-		{201, 1},
-		{200, 1},
-		{237, 9},
-		{258, 1},
-		{258, 3},
-		{221, 5},
-		{239, 2},
-		{235, 2},
-		{235, 2},
-		{235, 4},
 		{197, 0},
-		{197, 2},
+		{197, 3},
+		{221, 1},
+		{221, 3},
+		{208, 1},
+		{208, 2},
+		{294, 1},
+		{294, 1},
 		{198, 0},
-		{198, 3},
-		{169, 1},
-		{203, 1},
-		{264, 1},
-		{171, 1},
-		{83, 1},
-		{83, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{84, 1},
-		{278, 1},
-		{278, 3},
+		{198, 2},
+		{201, 0},
+		{201, 3},
+		{200, 0},
+		{200, 2},
+		{200, 4},
+		{200, 4},
+		{215, 1},
+		{215, 1},
+		{214, 1},
+		{256, 9},
+		{274, 1},
+		{274, 3},
+		{237, 5},
+		{258, 2},
+		{254, 2},
+		{254, 2},
+		{254, 4},
+		{254, 2},
+		{254, 4},
+		{211, 0},
+		{211, 2},
+		{212, 0},
+		{212, 3},
+		{183, 1},
+		{189, 1},
+		{280, 1},
+		{184, 1},
+		{91, 1},
+		{91, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{92, 1},
+		{249, 1},
+		{249, 3},
 	}
 
 	yyXErrors = map[yyXError]string{}
 
-	yyParseTab = [605][]uint16{
+	yyParseTab = [677][]uint16{
 		// 0
-		{1: 395, 344, 344, 31: 88, 33: 88, 88, 88, 50: 386, 387, 53: 391, 58: 392, 172: 394, 176: 393, 389, 180: 382, 204: 370, 207: 371, 388, 372, 374, 373, 375, 390, 376, 378, 377, 218: 369, 379, 222: 380, 231: 381, 234: 397, 385, 368, 383, 396, 384, 250: 366, 284: 367},
-		{2: 365},
-		{2: 364, 968},
-		{2: 363, 363},
-		{2: 361, 361},
+		{1: 440, 386, 386, 32: 93, 93, 93, 93, 57: 430, 61: 431, 432, 64: 436, 68: 437, 185: 439, 187: 438, 434, 190: 426, 218: 413, 414, 222: 415, 433, 416, 418, 417, 228: 419, 435, 420, 422, 421, 234: 412, 423, 238: 424, 250: 425, 253: 442, 429, 411, 427, 441, 428, 267: 409, 304: 410},
+		{2: 408},
+		{2: 407, 1083},
+		{2: 406, 406},
+		{2: 404, 404},
 		// 5
-		{2: 360, 360},
-		{2: 359, 359},
-		{2: 358, 358},
-		{2: 357, 357},
-		{2: 356, 356},
+		{2: 403, 403},
+		{2: 402, 402},
+		{2: 401, 401},
+		{2: 400, 400},
+		{2: 399, 399},
 		// 10
-		{2: 355, 355},
-		{2: 354, 354},
-		{2: 353, 353},
-		{2: 352, 352},
-		{2: 351, 351},
+		{2: 398, 398},
+		{2: 397, 397},
+		{2: 396, 396},
+		{2: 395, 395},
+		{2: 394, 394},
 		// 15
-		{2: 350, 350},
-		{2: 349, 349},
-		{2: 348, 348},
-		{2: 347, 347},
-		{2: 346, 346},
+		{2: 393, 393},
+		{2: 392, 392},
+		{2: 391, 391},
+		{2: 390, 390},
+		{2: 389, 389},
 		// 20
-		{2: 345, 345},
-		{2: 343, 343},
-		{2: 342, 342},
-		{54: 947, 95: 948, 185: 338, 263: 949, 285: 950},
-		{31: 543, 33: 872, 873, 874, 232: 542},
+		{2: 388, 388},
+		{2: 387, 387},
+		{59: 1081},
+		{2: 383, 383},
+		{2: 382, 382},
 		// 25
-		{54: 861, 95: 862, 185: 863},
-		{1: 395, 31: 88, 172: 394, 176: 393, 541, 180: 860},
-		{2: 171, 171},
-		{1: 395, 31: 87, 33: 87, 87, 87, 172: 859},
-		{1: 86, 31: 86, 33: 86, 86, 86},
+		{59: 1031, 104: 1032, 199: 364, 279: 1033, 306: 1034},
+		{32: 601, 956, 957, 958, 251: 600},
+		{59: 945, 104: 946, 199: 947},
+		{1: 440, 32: 93, 57: 943, 185: 439, 187: 438, 599, 190: 942},
+		{2: 176, 176},
 		// 30
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 448, 404},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 447},
-		{43: 399, 262: 398},
-		{2: 57, 57},
-		{2: 56, 56, 18: 400},
+		{1: 440, 32: 92, 92, 92, 92, 185: 941},
+		{1: 91, 32: 91, 91, 91, 91},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 499, 450},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 498},
+		{75: 445, 89: 444, 278: 443},
 		// 35
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 401},
-		{2: 55, 55},
-		{50, 2: 50, 50, 7: 50, 10: 50, 50, 50, 50, 82: 50, 121: 50, 123: 50},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 43: 46, 82: 46, 86: 46, 89: 46, 46, 46, 96: 46, 46, 46, 119: 46, 46, 46, 123: 46, 125: 46, 46, 158: 46, 46},
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 43: 45, 82: 45, 86: 45, 89: 45, 45, 45, 96: 45, 45, 45, 119: 45, 45, 45, 123: 45, 125: 45, 45, 158: 45, 45},
+		{2: 62, 62},
+		{2: 61, 61, 19: 496},
+		{2: 59, 59, 19: 446},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 447},
+		{2: 58, 58},
 		// 40
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 43: 44, 82: 44, 86: 44, 89: 44, 44, 44, 96: 44, 44, 44, 119: 44, 44, 44, 123: 44, 125: 44, 44, 158: 44, 44},
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43: 43, 82: 43, 86: 43, 89: 43, 43, 43, 96: 43, 43, 43, 119: 43, 43, 43, 123: 43, 125: 43, 43, 158: 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 43: 42, 82: 42, 86: 42, 89: 42, 42, 42, 96: 42, 42, 42, 119: 42, 42, 42, 123: 42, 125: 42, 42, 158: 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 43: 41, 82: 41, 86: 41, 89: 41, 41, 41, 96: 41, 41, 41, 119: 41, 41, 41, 123: 41, 125: 41, 41, 158: 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 43: 40, 82: 40, 86: 40, 89: 40, 40, 40, 96: 40, 40, 40, 119: 40, 40, 40, 123: 40, 125: 40, 40, 158: 40, 40},
+		{53, 2: 53, 53, 5: 53, 11: 53, 53, 53, 53, 50: 53, 90: 53, 131: 53, 135: 53},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 89: 49, 49, 96: 49, 49, 100: 49, 105: 49, 107: 49, 114: 49, 126: 49, 128: 49, 131: 49, 135: 49, 143: 49, 146: 49, 168: 49, 49, 49, 49, 49},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 89: 48, 48, 96: 48, 48, 100: 48, 105: 48, 107: 48, 114: 48, 126: 48, 128: 48, 131: 48, 135: 48, 143: 48, 146: 48, 168: 48, 48, 48, 48, 48, 48},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 89: 47, 47, 96: 47, 47, 100: 47, 105: 47, 107: 47, 114: 47, 126: 47, 128: 47, 131: 47, 135: 47, 143: 47, 146: 47, 168: 47, 47, 47, 47, 47, 47},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 89: 46, 46, 96: 46, 46, 100: 46, 105: 46, 107: 46, 114: 46, 126: 46, 128: 46, 131: 46, 135: 46, 143: 46, 146: 46, 168: 46, 46, 46, 46, 46, 46},
 		// 45
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 43: 39, 82: 39, 86: 39, 89: 39, 39, 39, 96: 39, 39, 39, 119: 39, 39, 39, 123: 39, 125: 39, 39, 158: 39, 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 43: 38, 82: 38, 86: 38, 89: 38, 38, 38, 96: 38, 38, 38, 119: 38, 38, 38, 123: 38, 125: 38, 38, 158: 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 43: 37, 82: 37, 86: 37, 89: 37, 37, 37, 96: 37, 37, 37, 119: 37, 37, 37, 123: 37, 125: 37, 37, 158: 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 43: 36, 82: 36, 86: 36, 89: 36, 36, 36, 96: 36, 36, 36, 119: 36, 36, 36, 123: 36, 125: 36, 36, 158: 36},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 43: 35, 82: 35, 86: 35, 89: 35, 35, 35, 96: 35, 35, 35, 119: 35, 35, 35, 123: 35, 125: 35, 35, 158: 35},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 89: 45, 45, 96: 45, 45, 100: 45, 105: 45, 107: 45, 114: 45, 126: 45, 128: 45, 131: 45, 135: 45, 143: 45, 146: 45, 168: 45, 45, 45, 45, 45, 45},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 89: 44, 44, 96: 44, 44, 100: 44, 105: 44, 107: 44, 114: 44, 126: 44, 128: 44, 131: 44, 135: 44, 143: 44, 146: 44, 168: 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 89: 43, 43, 96: 43, 43, 100: 43, 105: 43, 107: 43, 114: 43, 126: 43, 128: 43, 131: 43, 135: 43, 143: 43, 146: 43, 168: 43, 43, 43, 43, 43, 43},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 89: 42, 42, 96: 42, 42, 100: 42, 105: 42, 107: 42, 114: 42, 126: 42, 128: 42, 131: 42, 135: 42, 143: 42, 146: 42, 168: 42, 42, 42, 42, 42, 42},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 89: 41, 41, 96: 41, 41, 100: 41, 105: 41, 107: 41, 114: 41, 126: 41, 128: 41, 131: 41, 135: 41, 143: 41, 146: 41, 168: 41, 41, 41, 41, 41},
 		// 50
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 43: 34, 82: 34, 86: 34, 89: 34, 34, 34, 96: 34, 34, 34, 119: 34, 34, 34, 123: 34, 125: 34, 34, 158: 34, 34},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 43: 33, 82: 33, 86: 33, 89: 33, 33, 33, 96: 33, 33, 33, 119: 33, 33, 33, 123: 33, 125: 33, 33, 158: 33, 33},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 43: 32, 82: 32, 86: 32, 89: 32, 32, 32, 96: 32, 32, 32, 119: 32, 32, 32, 123: 32, 125: 32, 32, 158: 32, 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 43: 31, 82: 31, 86: 31, 89: 31, 31, 31, 96: 31, 31, 31, 119: 31, 31, 31, 123: 31, 125: 31, 31, 158: 31, 31},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 43: 30, 82: 30, 86: 30, 89: 30, 30, 30, 96: 30, 30, 30, 119: 30, 30, 30, 123: 30, 125: 30, 30, 158: 30, 30},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 89: 40, 40, 96: 40, 40, 100: 40, 105: 40, 107: 40, 114: 40, 126: 40, 128: 40, 131: 40, 135: 40, 143: 40, 146: 40, 168: 40, 40, 40, 40, 40, 40},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 89: 39, 39, 96: 39, 39, 100: 39, 105: 39, 107: 39, 114: 39, 126: 39, 128: 39, 131: 39, 135: 39, 143: 39, 146: 39, 168: 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 89: 38, 38, 96: 38, 38, 100: 38, 105: 38, 107: 38, 114: 38, 126: 38, 128: 38, 131: 38, 135: 38, 143: 38, 146: 38, 168: 38, 38, 38, 38, 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 89: 37, 37, 96: 37, 37, 100: 37, 105: 37, 107: 37, 114: 37, 126: 37, 128: 37, 131: 37, 135: 37, 143: 37, 146: 37, 168: 37, 37, 37, 37, 37, 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 89: 36, 36, 96: 36, 36, 100: 36, 105: 36, 107: 36, 114: 36, 126: 36, 128: 36, 131: 36, 135: 36, 143: 36, 146: 36, 168: 36, 36, 36, 36, 36, 36},
 		// 55
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 43: 29, 82: 29, 86: 29, 89: 29, 29, 29, 96: 29, 29, 29, 119: 29, 29, 29, 123: 29, 125: 29, 29, 158: 29, 29},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 43: 28, 82: 28, 86: 28, 89: 28, 28, 28, 96: 28, 28, 28, 119: 28, 28, 28, 123: 28, 125: 28, 28, 158: 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 43: 27, 82: 27, 86: 27, 89: 27, 27, 27, 96: 27, 27, 27, 119: 27, 27, 27, 123: 27, 125: 27, 27, 158: 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 43: 26, 82: 26, 86: 26, 89: 26, 26, 26, 96: 26, 26, 26, 119: 26, 26, 26, 123: 26, 125: 26, 26, 158: 26, 26},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 43: 25, 82: 25, 86: 25, 89: 25, 25, 25, 96: 25, 25, 25, 119: 25, 25, 25, 123: 25, 125: 25, 25, 158: 25, 25},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 89: 35, 35, 96: 35, 35, 100: 35, 105: 35, 107: 35, 114: 35, 126: 35, 128: 35, 131: 35, 135: 35, 143: 35, 146: 35, 168: 35, 35, 35, 35, 35, 35},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 89: 34, 34, 96: 34, 34, 100: 34, 105: 34, 107: 34, 114: 34, 126: 34, 128: 34, 131: 34, 135: 34, 143: 34, 146: 34, 168: 34, 34, 34, 34, 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 89: 33, 33, 96: 33, 33, 100: 33, 105: 33, 107: 33, 114: 33, 126: 33, 128: 33, 131: 33, 135: 33, 143: 33, 146: 33, 168: 33, 33, 33, 33, 33, 33},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 89: 32, 32, 96: 32, 32, 100: 32, 105: 32, 107: 32, 114: 32, 126: 32, 128: 32, 131: 32, 135: 32, 143: 32, 146: 32, 168: 32, 32, 32, 32, 32, 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 89: 31, 31, 96: 31, 31, 100: 31, 105: 31, 107: 31, 114: 31, 126: 31, 128: 31, 131: 31, 135: 31, 143: 31, 146: 31, 168: 31, 31, 31, 31, 31, 31},
 		// 60
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 43: 24, 82: 24, 86: 24, 89: 24, 24, 24, 96: 24, 24, 24, 119: 24, 24, 24, 123: 24, 125: 24, 24, 158: 24},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 43: 23, 82: 23, 86: 23, 89: 23, 23, 23, 96: 23, 23, 23, 119: 23, 23, 23, 123: 23, 125: 23, 23, 158: 23, 23},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 43: 22, 82: 22, 86: 22, 89: 22, 22, 22, 96: 22, 22, 22, 119: 22, 22, 22, 123: 22, 125: 22, 22, 158: 22, 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 43: 21, 82: 21, 86: 21, 89: 21, 21, 21, 96: 21, 21, 21, 119: 21, 21, 21, 123: 21, 125: 21, 21, 158: 21, 21},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 43: 20, 82: 20, 86: 20, 89: 20, 20, 20, 96: 20, 20, 20, 119: 20, 20, 20, 123: 20, 125: 20, 20, 158: 20, 20},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 89: 30, 30, 96: 30, 30, 100: 30, 105: 30, 107: 30, 114: 30, 126: 30, 128: 30, 131: 30, 135: 30, 143: 30, 146: 30, 168: 30, 30, 30, 30, 30, 30},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 89: 29, 29, 96: 29, 29, 100: 29, 105: 29, 107: 29, 114: 29, 126: 29, 128: 29, 131: 29, 135: 29, 143: 29, 146: 29, 168: 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 89: 28, 28, 96: 28, 28, 100: 28, 105: 28, 107: 28, 114: 28, 126: 28, 128: 28, 131: 28, 135: 28, 143: 28, 146: 28, 168: 28, 28, 28, 28, 28, 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 89: 27, 27, 96: 27, 27, 100: 27, 105: 27, 107: 27, 114: 27, 126: 27, 128: 27, 131: 27, 135: 27, 143: 27, 146: 27, 168: 27, 27, 27, 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 89: 26, 26, 96: 26, 26, 100: 26, 105: 26, 107: 26, 114: 26, 126: 26, 128: 26, 131: 26, 135: 26, 143: 26, 146: 26, 168: 26, 26, 26, 26, 26, 26},
 		// 65
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 43: 19, 82: 19, 86: 19, 89: 19, 19, 19, 96: 19, 19, 19, 119: 19, 19, 19, 123: 19, 125: 19, 19, 158: 19},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 43: 18, 82: 18, 86: 18, 89: 18, 18, 18, 96: 18, 18, 18, 119: 18, 18, 18, 123: 18, 125: 18, 18, 158: 18, 18},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 43: 17, 82: 17, 86: 17, 89: 17, 17, 17, 96: 17, 17, 17, 119: 17, 17, 17, 123: 17, 125: 17, 17, 158: 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 43: 16, 82: 16, 86: 16, 89: 16, 16, 16, 96: 16, 16, 16, 119: 16, 16, 16, 123: 16, 125: 16, 16, 158: 16},
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 43: 15, 82: 15, 86: 15, 89: 15, 15, 15, 96: 15, 15, 15, 119: 15, 15, 15, 123: 15, 125: 15, 15, 158: 15},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 89: 25, 25, 96: 25, 25, 100: 25, 105: 25, 107: 25, 114: 25, 126: 25, 128: 25, 131: 25, 135: 25, 143: 25, 146: 25, 168: 25, 25, 25, 25, 25, 25},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 89: 24, 24, 96: 24, 24, 100: 24, 105: 24, 107: 24, 114: 24, 126: 24, 128: 24, 131: 24, 135: 24, 143: 24, 146: 24, 168: 24, 24, 24, 24, 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 89: 23, 23, 96: 23, 23, 100: 23, 105: 23, 107: 23, 114: 23, 126: 23, 128: 23, 131: 23, 135: 23, 143: 23, 146: 23, 168: 23, 23, 23, 23, 23, 23},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 89: 22, 22, 96: 22, 22, 100: 22, 105: 22, 107: 22, 114: 22, 126: 22, 128: 22, 131: 22, 135: 22, 143: 22, 146: 22, 168: 22, 22, 22, 22, 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 89: 21, 21, 96: 21, 21, 100: 21, 105: 21, 107: 21, 114: 21, 126: 21, 128: 21, 131: 21, 135: 21, 143: 21, 146: 21, 168: 21, 21, 21, 21, 21, 21},
 		// 70
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 43: 14, 82: 14, 86: 14, 89: 14, 14, 14, 96: 14, 14, 14, 119: 14, 14, 14, 123: 14, 125: 14, 14, 158: 14},
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 43: 13, 82: 13, 86: 13, 89: 13, 13, 13, 96: 13, 13, 13, 119: 13, 13, 13, 123: 13, 125: 13, 13, 158: 13},
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 43: 12, 82: 12, 86: 12, 89: 12, 12, 12, 96: 12, 12, 12, 119: 12, 12, 12, 123: 12, 125: 12, 12, 158: 12},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 43: 11, 82: 11, 86: 11, 89: 11, 11, 11, 96: 11, 11, 11, 119: 11, 11, 11, 123: 11, 125: 11, 11, 158: 11},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 43: 10, 82: 10, 86: 10, 89: 10, 10, 10, 96: 10, 10, 10, 119: 10, 10, 10, 123: 10, 125: 10, 10, 158: 10},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 89: 20, 20, 96: 20, 20, 100: 20, 105: 20, 107: 20, 114: 20, 126: 20, 128: 20, 131: 20, 135: 20, 143: 20, 146: 20, 168: 20, 20, 20, 20, 20},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 89: 19, 19, 96: 19, 19, 100: 19, 105: 19, 107: 19, 114: 19, 126: 19, 128: 19, 131: 19, 135: 19, 143: 19, 146: 19, 168: 19, 19, 19, 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 89: 18, 18, 96: 18, 18, 100: 18, 105: 18, 107: 18, 114: 18, 126: 18, 128: 18, 131: 18, 135: 18, 143: 18, 146: 18, 168: 18, 18, 18, 18, 18},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 89: 17, 17, 96: 17, 17, 100: 17, 105: 17, 107: 17, 114: 17, 126: 17, 128: 17, 131: 17, 135: 17, 143: 17, 146: 17, 168: 17, 17, 17, 17, 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 89: 16, 16, 96: 16, 16, 100: 16, 105: 16, 107: 16, 114: 16, 126: 16, 128: 16, 131: 16, 135: 16, 143: 16, 146: 16, 168: 16, 16, 16, 16, 16},
 		// 75
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 43: 9, 82: 9, 86: 9, 89: 9, 9, 9, 96: 9, 9, 9, 119: 9, 9, 9, 123: 9, 125: 9, 9, 158: 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 43: 8, 82: 8, 86: 8, 89: 8, 8, 8, 96: 8, 8, 8, 119: 8, 8, 8, 123: 8, 125: 8, 8, 158: 8, 8},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 43: 7, 82: 7, 86: 7, 89: 7, 7, 7, 96: 7, 7, 7, 119: 7, 7, 7, 123: 7, 125: 7, 7, 158: 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 43: 6, 82: 6, 86: 6, 89: 6, 6, 6, 96: 6, 6, 6, 119: 6, 6, 6, 123: 6, 125: 6, 6, 158: 6, 6},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 43: 5, 82: 5, 86: 5, 89: 5, 5, 5, 96: 5, 5, 5, 119: 5, 5, 5, 123: 5, 125: 5, 5, 158: 5, 5},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 89: 15, 15, 96: 15, 15, 100: 15, 105: 15, 107: 15, 114: 15, 126: 15, 128: 15, 131: 15, 135: 15, 143: 15, 146: 15, 168: 15, 15, 15, 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 89: 14, 14, 96: 14, 14, 100: 14, 105: 14, 107: 14, 114: 14, 126: 14, 128: 14, 131: 14, 135: 14, 143: 14, 146: 14, 168: 14, 14, 14, 14, 14},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 89: 13, 13, 96: 13, 13, 100: 13, 105: 13, 107: 13, 114: 13, 126: 13, 128: 13, 131: 13, 135: 13, 143: 13, 146: 13, 168: 13, 13, 13, 13, 13},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 89: 12, 12, 96: 12, 12, 100: 12, 105: 12, 107: 12, 114: 12, 126: 12, 128: 12, 131: 12, 135: 12, 143: 12, 146: 12, 168: 12, 12, 12, 12, 12, 12},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 89: 11, 11, 96: 11, 11, 100: 11, 105: 11, 107: 11, 114: 11, 126: 11, 128: 11, 131: 11, 135: 11, 143: 11, 146: 11, 168: 11, 11, 11, 11, 11, 11},
 		// 80
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 43: 4, 82: 4, 86: 4, 89: 4, 4, 4, 96: 4, 4, 4, 119: 4, 4, 4, 123: 4, 125: 4, 4, 158: 4, 4},
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 43: 3, 82: 3, 86: 3, 89: 3, 3, 3, 96: 3, 3, 3, 119: 3, 3, 3, 123: 3, 125: 3, 3, 158: 3, 3},
-		{2: 58, 58},
-		{40: 449},
-		{32: 455, 46: 452, 451, 49: 453, 160: 454, 188: 456, 191: 450},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 89: 10, 10, 96: 10, 10, 100: 10, 105: 10, 107: 10, 114: 10, 126: 10, 128: 10, 131: 10, 135: 10, 143: 10, 146: 10, 168: 10, 10, 10, 10, 10},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 89: 9, 9, 96: 9, 9, 100: 9, 105: 9, 107: 9, 114: 9, 126: 9, 128: 9, 131: 9, 135: 9, 143: 9, 146: 9, 168: 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 89: 8, 8, 96: 8, 8, 100: 8, 105: 8, 107: 8, 114: 8, 126: 8, 128: 8, 131: 8, 135: 8, 143: 8, 146: 8, 168: 8, 8, 8, 8, 8, 8},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 89: 7, 7, 96: 7, 7, 100: 7, 105: 7, 107: 7, 114: 7, 126: 7, 128: 7, 131: 7, 135: 7, 143: 7, 146: 7, 168: 7, 7, 7, 7, 7, 7},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 89: 6, 6, 96: 6, 6, 100: 6, 105: 6, 107: 6, 114: 6, 126: 6, 128: 6, 131: 6, 135: 6, 143: 6, 146: 6, 168: 6, 6, 6, 6, 6, 6},
 		// 85
-		{142, 142, 142, 142, 7: 142, 645, 10: 142, 142, 142, 142, 31: 142, 33: 142, 142, 142, 82: 142, 161: 142, 163: 643, 641, 644, 642, 178: 849, 848, 196: 847, 280: 846},
-		{32: 455, 42: 688, 44: 687, 160: 639, 162: 686},
-		{32: 455, 42: 682, 44: 681, 160: 639, 162: 683},
-		{85: 635},
-		{133, 133, 133, 133, 7: 133, 133, 10: 133, 133, 133, 133, 31: 133, 33: 133, 133, 133, 82: 133, 161: 133, 163: 133, 133, 133, 133, 178: 133, 133},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 89: 5, 5, 96: 5, 5, 100: 5, 105: 5, 107: 5, 114: 5, 126: 5, 128: 5, 131: 5, 135: 5, 143: 5, 146: 5, 168: 5, 5, 5, 5, 5, 5},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 89: 4, 4, 96: 4, 4, 100: 4, 105: 4, 107: 4, 114: 4, 126: 4, 128: 4, 131: 4, 135: 4, 143: 4, 146: 4, 168: 4, 4, 4, 4, 4, 4},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 89: 3, 3, 96: 3, 3, 100: 3, 105: 3, 107: 3, 114: 3, 126: 3, 128: 3, 131: 3, 135: 3, 143: 3, 146: 3, 168: 3, 3, 3, 3, 3, 3},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 497},
+		{2: 60, 60},
 		// 90
-		{115, 424, 4: 423, 406, 431, 17: 115, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 623, 98: 115, 173: 622, 192: 621},
-		{1: 83, 31: 83, 33: 83, 83, 83, 82: 458, 174: 457},
-		{1: 84, 31: 84, 33: 84, 84, 84},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 502, 460},
-		{291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 33: 291, 291, 291, 291, 291, 291, 291, 291, 291, 86: 843},
+		{2: 63, 63},
+		{43: 500},
+		{36: 506, 56: 503, 58: 502, 60: 504, 175: 505, 202: 507, 205: 501},
+		{147, 147, 147, 147, 5: 147, 9: 712, 11: 147, 147, 147, 147, 32: 147, 147, 147, 147, 90: 147, 97: 147, 177: 710, 708, 711, 709, 194: 931, 930, 210: 929, 299: 928},
+		{36: 506, 53: 755, 754, 175: 706, 753},
 		// 95
-		{312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 33: 312, 312, 312, 312, 312, 312, 312, 312, 312},
-		{311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 33: 311, 311, 311, 311, 311, 311, 311, 311, 311},
-		{310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 33: 310, 310, 310, 310, 310, 310, 310, 310, 310},
-		{309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 33: 309, 309, 309, 309, 309, 309, 309, 309, 309},
-		{308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 33: 308, 308, 308, 308, 308, 308, 308, 308, 308},
+		{36: 506, 53: 749, 748, 175: 706, 750},
+		{93: 702},
+		{138, 138, 138, 138, 5: 138, 9: 138, 11: 138, 138, 138, 138, 32: 138, 138, 138, 138, 90: 138, 97: 138, 177: 138, 138, 138, 138, 194: 138, 138},
+		{120, 470, 4: 469, 6: 452, 477, 18: 120, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 690, 105: 120, 191: 689, 206: 688},
+		{1: 88, 32: 88, 88, 88, 88, 90: 509, 192: 508},
 		// 100
-		{307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 33: 307, 307, 307, 307, 307, 307, 307, 307, 307},
-		{306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 33: 306, 306, 306, 306, 306, 306, 306, 306, 306},
-		{305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 33: 305, 305, 305, 305, 305, 305, 305, 305, 305},
-		{304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 33: 304, 304, 304, 304, 304, 304, 304, 304, 304},
-		{303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 33: 303, 303, 303, 303, 303, 303, 303, 303, 303},
+		{1: 89, 32: 89, 89, 89, 89},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 556},
+		{313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 37: 313, 313, 313, 313, 313, 313, 313, 313, 96: 925},
+		{337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 37: 337, 337, 337, 337, 337, 337, 337, 337},
+		{336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 336, 37: 336, 336, 336, 336, 336, 336, 336, 336},
 		// 105
-		{302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 33: 302, 302, 302, 302, 302, 302, 302, 302, 302},
-		{301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 33: 301, 301, 301, 301, 301, 301, 301, 301, 301},
-		{300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 33: 300, 300, 300, 300, 300, 300, 300, 300, 300},
-		{299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 33: 299, 299, 299, 299, 299, 299, 299, 299, 299},
-		{298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 33: 298, 298, 298, 298, 298, 298, 298, 298, 298},
+		{335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 335, 37: 335, 335, 335, 335, 335, 335, 335, 335},
+		{334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 334, 37: 334, 334, 334, 334, 334, 334, 334, 334},
+		{333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 333, 37: 333, 333, 333, 333, 333, 333, 333, 333},
+		{332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 332, 37: 332, 332, 332, 332, 332, 332, 332, 332},
+		{331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 331, 37: 331, 331, 331, 331, 331, 331, 331, 331},
 		// 110
-		{297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 33: 297, 297, 297, 297, 297, 297, 297, 297, 297},
-		{296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 33: 296, 296, 296, 296, 296, 296, 296, 296, 296},
-		{295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 33: 295, 295, 295, 295, 295, 295, 295, 295, 295},
-		{294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 33: 294, 294, 294, 294, 294, 294, 294, 294, 294},
-		{293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 33: 293, 293, 293, 293, 293, 293, 293, 293, 293},
+		{330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 330, 37: 330, 330, 330, 330, 330, 330, 330, 330},
+		{329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 329, 37: 329, 329, 329, 329, 329, 329, 329, 329},
+		{328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 328, 37: 328, 328, 328, 328, 328, 328, 328, 328},
+		{327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 327, 37: 327, 327, 327, 327, 327, 327, 327, 327},
+		{326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 326, 37: 326, 326, 326, 326, 326, 326, 326, 326},
 		// 115
-		{292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 33: 292, 292, 292, 292, 292, 292, 292, 292, 292},
-		{290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 33: 290, 290, 290, 290, 290, 290, 290, 290, 290},
-		{289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 33: 289, 289, 289, 289, 289, 289, 289, 289, 289},
-		{288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 33: 288, 288, 288, 288, 288, 288, 288, 288, 288},
-		{287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 33: 287, 287, 287, 287, 287, 287, 287, 287, 287},
+		{325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 325, 37: 325, 325, 325, 325, 325, 325, 325, 325},
+		{324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 324, 37: 324, 324, 324, 324, 324, 324, 324, 324},
+		{323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 323, 37: 323, 323, 323, 323, 323, 323, 323, 323},
+		{322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 322, 37: 322, 322, 322, 322, 322, 322, 322, 322},
+		{321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 321, 37: 321, 321, 321, 321, 321, 321, 321, 321},
 		// 120
-		{286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 33: 286, 286, 286, 286, 286, 286, 286, 286, 286},
-		{285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 33: 285, 285, 285, 285, 285, 285, 285, 285, 285},
-		{284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 33: 284, 284, 284, 284, 284, 284, 284, 284, 284},
-		{283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 33: 283, 283, 283, 283, 283, 283, 283, 283, 283},
-		{282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 33: 282, 282, 282, 282, 282, 282, 282, 282, 282},
+		{320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 320, 37: 320, 320, 320, 320, 320, 320, 320, 320},
+		{319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 319, 37: 319, 319, 319, 319, 319, 319, 319, 319},
+		{318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 318, 37: 318, 318, 318, 318, 318, 318, 318, 318},
+		{317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 317, 37: 317, 317, 317, 317, 317, 317, 317, 317},
+		{316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 316, 37: 316, 316, 316, 316, 316, 316, 316, 316},
 		// 125
-		{281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 33: 281, 281, 281, 281, 281, 281, 281, 281, 281},
-		{280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 33: 280, 280, 280, 280, 280, 280, 280, 280, 280},
-		{279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 33: 279, 279, 279, 279, 279, 279, 279, 279, 279},
-		{278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 33: 278, 278, 278, 278, 278, 278, 278, 278, 278},
-		{277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 33: 277, 277, 277, 277, 277, 277, 277, 277, 277},
+		{315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 315, 37: 315, 315, 315, 315, 315, 315, 315, 315},
+		{314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 314, 37: 314, 314, 314, 314, 314, 314, 314, 314},
+		{312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 312, 37: 312, 312, 312, 312, 312, 312, 312, 312, 174: 312},
+		{311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 311, 37: 311, 311, 311, 311, 311, 311, 311, 311, 174: 311},
+		{310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 310, 37: 310, 310, 310, 310, 310, 310, 310, 310, 174: 310},
 		// 130
-		{276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 33: 276, 276, 276, 276, 276, 276, 276, 276, 276},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 33: 38, 38, 38, 38, 38, 38, 38, 38, 38, 86: 38, 842, 159: 38},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 33: 35, 35, 35, 35, 35, 35, 35, 35, 35, 86: 35, 841, 159: 35},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 33: 36, 36, 36, 36, 36, 36, 36, 36, 36, 86: 36, 840, 159: 36},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 33: 24, 24, 24, 24, 24, 24, 24, 24, 24, 85: 832, 24, 159: 24},
+		{309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 309, 37: 309, 309, 309, 309, 309, 309, 309, 309, 174: 309},
+		{308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 308, 37: 308, 308, 308, 308, 308, 308, 308, 308, 174: 308},
+		{307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 307, 37: 307, 307, 307, 307, 307, 307, 307, 307, 174: 307},
+		{306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 306, 37: 306, 306, 306, 306, 306, 306, 306, 306, 174: 306},
+		{305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 305, 37: 305, 305, 305, 305, 305, 305, 305, 305, 174: 305},
 		// 135
-		{265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 33: 265, 265, 265, 265, 265, 265, 265, 265, 265},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 831, 460},
-		{82, 82, 82, 82, 82, 8: 562, 575, 82, 82, 82, 82, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 82, 33: 82, 82, 82},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 830, 460},
-		{1: 828, 4: 423, 406, 431, 8: 501, 503, 31: 88, 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 827, 460, 172: 394, 176: 393, 541, 180: 540},
+		{304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 304, 37: 304, 304, 304, 304, 304, 304, 304, 304, 174: 304},
+		{303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 303, 37: 303, 303, 303, 303, 303, 303, 303, 303, 174: 303},
+		{302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 302, 37: 302, 302, 302, 302, 302, 302, 302, 302, 174: 302},
+		{301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 301, 37: 301, 301, 301, 301, 301, 301, 301, 301, 174: 301},
+		{300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 300, 37: 300, 300, 300, 300, 300, 300, 300, 300, 174: 300},
 		// 140
-		{32: 821},
-		{32: 245},
-		{32: 244},
-		{32: 243},
-		{32: 242},
+		{299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 299, 37: 299, 299, 299, 299, 299, 299, 299, 299, 174: 299},
+		{298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 298, 37: 298, 298, 298, 298, 298, 298, 298, 298, 174: 298},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 37: 41, 41, 41, 41, 41, 41, 41, 41, 94: 924, 96: 41, 173: 41},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 37: 38, 38, 38, 38, 38, 38, 38, 38, 94: 923, 96: 38, 173: 38},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 37: 39, 39, 39, 39, 39, 39, 39, 39, 94: 922, 96: 39, 173: 39},
 		// 145
-		{32: 241},
-		{32: 240},
-		{32: 239},
-		{32: 238},
-		{32: 237},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 37: 27, 27, 27, 27, 27, 27, 27, 27, 93: 914, 96: 27, 173: 27},
+		{287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 287, 37: 287, 287, 287, 287, 287, 287, 287, 287},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 913},
+		{87, 87, 87, 87, 87, 8: 634, 620, 631, 87, 87, 87, 87, 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 87, 87, 87, 87},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 912},
 		// 150
-		{32: 236},
-		{32: 235},
-		{32: 234},
-		{32: 233},
-		{32: 232},
+		{1: 910, 4: 469, 6: 452, 477, 557, 555, 559, 32: 93, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 909, 185: 439, 187: 438, 599, 190: 598},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 41: 906, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 648, 259: 907},
+		{1: 470, 4: 469, 6: 452, 477, 37: 894, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 898, 450, 94: 897, 245: 896, 292: 895},
+		{36: 888},
+		{36: 258},
 		// 155
-		{32: 231},
-		{32: 230},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 812, 19, 19, 19, 19, 19, 19, 19, 19, 19, 86: 19, 159: 19},
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 806, 15, 15, 15, 15, 15, 15, 15, 15, 15, 86: 15, 159: 15},
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 802, 12, 12, 12, 12, 12, 12, 12, 12, 12, 86: 12, 159: 12},
+		{36: 257},
+		{36: 256},
+		{36: 255},
+		{36: 254},
+		{36: 253},
 		// 160
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 798, 13, 13, 13, 13, 13, 13, 13, 13, 13, 86: 13, 159: 13},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 794, 16, 16, 16, 16, 16, 16, 16, 16, 16, 86: 16, 159: 16},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 790, 11, 11, 11, 11, 11, 11, 11, 11, 11, 86: 11, 159: 11},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 786, 17, 17, 17, 17, 17, 17, 17, 17, 17, 86: 17, 159: 17},
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 779, 14, 14, 14, 14, 14, 14, 14, 14, 14, 86: 14, 159: 14},
+		{36: 252},
+		{36: 251},
+		{36: 250},
+		{36: 249},
+		{36: 248},
 		// 165
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 766, 10, 10, 10, 10, 10, 10, 10, 10, 10, 86: 10, 159: 10},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 746, 7, 7, 7, 7, 7, 7, 7, 7, 7, 86: 7, 159: 7},
-		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 33: 188, 188, 188, 188, 188, 188, 188, 188, 188},
-		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 33: 187, 187, 187, 187, 187, 187, 187, 187, 187},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 36: 734, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 731, 460, 193: 733, 241: 732},
+		{36: 247},
+		{36: 246},
+		{36: 245},
+		{36: 244},
+		{36: 243},
 		// 170
-		{32: 539, 127: 538},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 33: 172, 172, 172, 172, 172, 172, 172, 172, 172},
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 33: 116, 116, 116, 116, 116, 116, 116, 116, 116, 43: 116, 82: 116, 86: 116, 97: 116, 116, 119: 116, 116, 126: 116, 158: 116},
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 33: 174, 174, 174, 174, 174, 174, 174, 174, 174},
-		{1: 395, 31: 88, 172: 394, 176: 393, 541, 180: 540},
+		{36: 242},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 241, 49, 49, 49, 49, 49, 49, 49, 49, 96: 49, 173: 49},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 879, 22, 22, 22, 22, 22, 22, 22, 22, 96: 22, 173: 22},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 873, 18, 18, 18, 18, 18, 18, 18, 18, 96: 18, 173: 18},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 869, 15, 15, 15, 15, 15, 15, 15, 15, 96: 15, 173: 15},
 		// 175
-		{730},
-		{31: 543, 232: 542},
-		{14: 609, 182: 608},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 15: 546, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 545},
-		{1: 214, 4: 214, 214, 214, 8: 214, 214, 32: 214, 42: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 85: 214, 87: 214, 92: 214, 214, 214, 214, 99: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 865, 16, 16, 16, 16, 16, 16, 16, 16, 96: 16, 173: 16},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 861, 19, 19, 19, 19, 19, 19, 19, 19, 96: 19, 173: 19},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 857, 14, 14, 14, 14, 14, 14, 14, 14, 96: 14, 173: 14},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 853, 20, 20, 20, 20, 20, 20, 20, 20, 96: 20, 173: 20},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 846, 17, 17, 17, 17, 17, 17, 17, 17, 96: 17, 173: 17},
 		// 180
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 551, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 547, 460, 175: 550, 233: 549, 282: 548},
-		{14: 168},
-		{160, 2: 160, 160, 7: 160, 562, 575, 160, 160, 160, 14: 160, 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 37: 160, 160, 40: 578, 252: 577, 576},
-		{7: 556, 14: 169},
-		{7: 167, 14: 167},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 833, 13, 13, 13, 13, 13, 13, 13, 13, 96: 13, 173: 13},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 813, 10, 10, 10, 10, 10, 10, 10, 10, 96: 10, 173: 10},
+		{199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 37: 199, 199, 199, 199, 199, 199, 199, 199},
+		{198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 37: 198, 198, 198, 198, 198, 198, 198, 198},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 38: 801, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 798, 207: 800, 261: 799},
 		// 185
-		{7: 165, 14: 165},
-		{7: 116, 116, 116, 14: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 40: 116, 86: 116, 159: 552},
-		{7: 162, 14: 162, 65: 554, 242: 553},
-		{7: 164, 14: 164},
-		{87: 488, 92: 490, 94: 489, 124: 555},
+		{36: 597, 140: 596},
+		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 37: 177, 177, 177, 177, 177, 177, 177, 177},
+		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 37: 121, 121, 121, 121, 121, 121, 121, 121, 89: 121, 121, 96: 121, 105: 121, 114: 121, 126: 121, 128: 121, 146: 121, 172: 121},
+		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 37: 179, 179, 179, 179, 179, 179, 179, 179},
+		{1: 440, 32: 93, 185: 439, 187: 438, 599, 190: 598},
 		// 190
-		{7: 161, 14: 161},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 551, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 547, 460, 175: 550, 233: 557},
-		{7: 166, 14: 166},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 607, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 606, 460},
+		{797},
+		{32: 601, 251: 600},
+		{16: 676, 196: 675},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 15: 604, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 603},
+		{1: 225, 4: 225, 6: 225, 225, 225, 225, 225, 36: 225, 45: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 93: 225, 225, 98: 225, 225, 101: 225, 225, 225, 225, 106: 225, 108: 225, 225, 225, 225, 225, 225, 115: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225},
 		// 195
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 605, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 604, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 603, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 602, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 601, 460},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 609, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 605, 193: 608, 252: 607, 301: 606},
+		{16: 173},
+		{165, 2: 165, 165, 5: 165, 8: 634, 620, 631, 165, 165, 165, 15: 616, 165, 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 39: 165, 165, 43: 637, 269: 636, 635},
+		{5: 614, 16: 174},
+		{5: 172, 16: 172},
 		// 200
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 600, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 599, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 598, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 597, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 596, 460},
+		{5: 170, 16: 170},
+		{5: 121, 8: 121, 121, 121, 15: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 43: 121, 96: 121, 173: 610},
+		{5: 167, 16: 167, 74: 612, 262: 611},
+		{5: 169, 16: 169},
+		{94: 542, 98: 544, 543, 129: 613},
 		// 205
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 595, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 594, 460},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 593, 460},
-		{9: 591, 229: 590},
-		{32: 583, 223: 589},
+		{5: 166, 16: 166},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 609, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 605, 193: 608, 252: 615},
+		{5: 171, 16: 171},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 674},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 673},
 		// 210
-		{18: 581},
-		{163, 2: 163, 163, 7: 163, 10: 163, 163, 163, 14: 163, 37: 163, 163},
-		{159, 2: 159, 159, 7: 159, 10: 159, 159, 159, 14: 159, 37: 159, 159},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 579, 404, 87: 580},
-		{158, 2: 158, 158, 7: 158, 10: 158, 158, 158, 14: 158, 37: 158, 158},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 672},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 671},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 670},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 669},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 668},
 		// 215
-		{157, 2: 157, 157, 7: 157, 10: 157, 157, 157, 14: 157, 37: 157, 157},
-		{32: 583, 223: 582},
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 33: 178, 178, 178, 178, 178, 178, 178, 178, 178},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 584, 460, 286: 585},
-		{176, 7: 176, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 667},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 666},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 665},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 664},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 663},
 		// 220
-		{586, 7: 587},
-		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 33: 177, 177, 177, 177, 177, 177, 177, 177, 177},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 588, 460},
-		{175, 7: 175, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 33: 179, 179, 179, 179, 179, 179, 179, 179, 179},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 662},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 661},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 660},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 658},
+		{8: 656, 216: 655},
 		// 225
-		{202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 33: 202, 202, 202, 202, 202, 202, 202, 202, 202},
-		{229: 592},
-		{201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 33: 201, 201, 201, 201, 201, 201, 201, 201, 201},
-		{248, 248, 248, 248, 248, 248, 248, 248, 562, 575, 248, 248, 248, 248, 248, 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 248, 248, 570, 248, 33: 248, 248, 248, 248, 248, 248, 248, 248, 248},
-		{250, 250, 250, 250, 250, 250, 250, 250, 562, 575, 250, 250, 250, 250, 250, 558, 561, 573, 574, 250, 563, 560, 559, 566, 565, 567, 568, 564, 250, 250, 250, 250, 33: 250, 250, 250, 250, 250, 250, 250, 250, 250},
+		{1: 470, 4: 469, 6: 452, 477, 10: 559, 36: 643, 45: 460, 457, 459, 454, 489, 495, 554, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 510, 127: 645, 130: 646, 132: 647, 134: 644, 239: 653, 244: 654},
+		{19: 640},
+		{168, 2: 168, 168, 5: 168, 11: 168, 168, 168, 16: 168, 39: 168, 168},
+		{164, 2: 164, 164, 5: 164, 11: 164, 164, 164, 16: 164, 39: 164, 164},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 638, 450, 94: 639},
 		// 230
-		{251, 251, 251, 251, 251, 251, 251, 251, 562, 575, 251, 251, 251, 251, 251, 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 251, 251, 251, 251, 33: 251, 251, 251, 251, 251, 251, 251, 251, 251},
-		{252, 252, 252, 252, 252, 252, 252, 252, 562, 575, 252, 252, 252, 252, 252, 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 252, 252, 570, 252, 33: 252, 252, 252, 252, 252, 252, 252, 252, 252},
-		{253, 253, 253, 253, 253, 253, 253, 253, 562, 575, 253, 253, 253, 253, 253, 558, 561, 253, 253, 253, 253, 560, 559, 253, 253, 253, 253, 253, 253, 253, 253, 253, 33: 253, 253, 253, 253, 253, 253, 253, 253, 253},
-		{254, 254, 254, 254, 254, 254, 254, 254, 562, 575, 254, 254, 254, 254, 254, 558, 561, 254, 254, 254, 254, 560, 559, 254, 254, 254, 254, 254, 254, 254, 254, 254, 33: 254, 254, 254, 254, 254, 254, 254, 254, 254},
-		{255, 255, 255, 255, 255, 255, 255, 255, 562, 575, 255, 255, 255, 255, 255, 558, 561, 255, 255, 255, 255, 560, 559, 255, 255, 255, 255, 255, 255, 255, 255, 255, 33: 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		{163, 2: 163, 163, 5: 163, 11: 163, 163, 163, 16: 163, 39: 163, 163},
+		{162, 2: 162, 162, 5: 162, 11: 162, 162, 162, 16: 162, 39: 162, 162},
+		{1: 470, 4: 469, 6: 452, 477, 10: 559, 36: 643, 45: 460, 457, 459, 454, 489, 495, 554, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 510, 127: 645, 130: 646, 132: 647, 134: 644, 239: 641, 244: 642},
+		{188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 37: 188, 188, 188, 188, 188, 188, 188, 188},
+		{187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 37: 187, 187, 187, 187, 187, 187, 187, 187},
 		// 235
-		{256, 256, 256, 256, 256, 256, 256, 256, 562, 575, 256, 256, 256, 256, 256, 558, 561, 256, 256, 256, 256, 560, 559, 256, 256, 256, 256, 256, 256, 256, 256, 256, 33: 256, 256, 256, 256, 256, 256, 256, 256, 256},
-		{257, 257, 257, 257, 257, 257, 257, 257, 562, 575, 257, 257, 257, 257, 257, 558, 561, 257, 257, 257, 257, 560, 559, 257, 257, 257, 257, 257, 257, 257, 257, 257, 33: 257, 257, 257, 257, 257, 257, 257, 257, 257},
-		{258, 258, 258, 258, 258, 258, 258, 258, 562, 575, 258, 258, 258, 258, 258, 558, 561, 258, 258, 258, 258, 560, 559, 258, 258, 258, 258, 258, 258, 258, 258, 258, 33: 258, 258, 258, 258, 258, 258, 258, 258, 258},
-		{259, 259, 259, 259, 259, 259, 259, 259, 259, 575, 259, 259, 259, 259, 259, 558, 259, 259, 259, 259, 259, 560, 559, 259, 259, 259, 259, 259, 259, 259, 259, 259, 33: 259, 259, 259, 259, 259, 259, 259, 259, 259},
-		{260, 260, 260, 260, 260, 260, 260, 260, 260, 575, 260, 260, 260, 260, 260, 558, 260, 260, 260, 260, 260, 560, 559, 260, 260, 260, 260, 260, 260, 260, 260, 260, 33: 260, 260, 260, 260, 260, 260, 260, 260, 260},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 648, 259: 649},
+		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 37: 185, 185, 185, 185, 185, 185, 185, 185},
+		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 37: 184, 184, 184, 184, 184, 184, 184, 184},
+		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 37: 183, 183, 183, 183, 183, 183, 183, 183},
+		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 37: 182, 182, 182, 182, 182, 182, 182, 182},
 		// 240
-		{261, 261, 261, 261, 261, 261, 261, 261, 261, 575, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 33: 261, 261, 261, 261, 261, 261, 261, 261, 261},
-		{262, 262, 262, 262, 262, 262, 262, 262, 262, 575, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 33: 262, 262, 262, 262, 262, 262, 262, 262, 262},
-		{263, 263, 263, 263, 263, 263, 263, 263, 263, 575, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 33: 263, 263, 263, 263, 263, 263, 263, 263, 263},
-		{83, 2: 83, 83, 10: 83, 83, 83, 83, 82: 458, 174: 701},
-		{227: 612, 611, 273: 610},
+		{181, 5: 181, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 41: 181},
+		{650, 5: 651},
+		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 37: 186, 186, 186, 186, 186, 186, 186, 186},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 652},
+		{180, 5: 180, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 41: 180},
 		// 245
-		{156, 2: 156, 156, 7: 699, 10: 156, 156, 156, 156, 82: 156},
-		{153, 2: 153, 153, 7: 153, 10: 153, 153, 153, 153, 82: 153},
-		{32: 615, 46: 452, 451, 49: 453, 160: 454, 188: 614, 191: 450, 261: 613},
-		{149, 2: 149, 149, 7: 149, 10: 149, 149, 149, 149, 82: 149, 161: 695, 259: 696, 694},
-		{146, 2: 146, 146, 7: 146, 10: 146, 146, 146, 146, 82: 146, 161: 146},
+		{190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 37: 190, 190, 190, 190, 190, 190, 190, 190},
+		{189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 37: 189, 189, 189, 189, 189, 189, 189, 189},
+		{213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 37: 213, 213, 213, 213, 213, 213, 213, 213},
+		{216: 657},
+		{212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 37: 212, 212, 212, 212, 212, 212, 212, 212},
 		// 250
-		{115, 424, 4: 423, 406, 431, 17: 115, 32: 455, 42: 421, 44: 420, 416, 619, 618, 414, 620, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 623, 98: 115, 160: 454, 173: 622, 188: 617, 191: 450, 621, 275: 616},
-		{691, 7: 692},
-		{144, 7: 144},
-		{30, 17: 30, 32: 455, 42: 688, 44: 687, 98: 30, 160: 639, 162: 686},
-		{31, 17: 31, 32: 455, 42: 682, 44: 681, 98: 31, 160: 639, 162: 683},
+		{8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 41: 659},
+		{260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 37: 260, 260, 260, 260, 260, 260, 260, 260},
+		{270, 270, 270, 270, 270, 270, 270, 270, 634, 620, 631, 270, 270, 270, 270, 616, 270, 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 270, 270, 628, 270, 270, 270, 270, 37: 270, 270, 270, 270, 270, 270, 270, 270},
+		{272, 272, 272, 272, 272, 272, 272, 272, 634, 620, 631, 272, 272, 272, 272, 616, 272, 619, 632, 633, 272, 621, 618, 617, 624, 623, 625, 626, 622, 272, 272, 272, 272, 272, 272, 272, 37: 272, 272, 272, 272, 272, 272, 272, 272},
+		{273, 273, 273, 273, 273, 273, 273, 273, 634, 620, 631, 273, 273, 273, 273, 616, 273, 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 273, 273, 273, 273, 273, 273, 273, 37: 273, 273, 273, 273, 273, 273, 273, 273},
 		// 255
-		{27, 17: 27, 85: 635, 98: 27},
-		{634},
-		{110, 17: 628, 97: 110, 627, 119: 110, 195: 625, 199: 626, 271: 624},
-		{114, 2: 114, 114, 7: 114, 10: 114, 114, 114, 114, 114, 17: 114, 43: 114, 82: 114, 97: 114, 114, 119: 114, 114, 126: 114},
-		{117, 97: 117, 119: 117},
+		{274, 274, 274, 274, 274, 274, 274, 274, 634, 620, 631, 274, 274, 274, 274, 616, 274, 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 274, 274, 628, 274, 274, 274, 274, 37: 274, 274, 274, 274, 274, 274, 274, 274},
+		{275, 275, 275, 275, 275, 275, 275, 275, 634, 620, 631, 275, 275, 275, 275, 616, 275, 619, 275, 275, 275, 275, 618, 617, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 37: 275, 275, 275, 275, 275, 275, 275, 275},
+		{276, 276, 276, 276, 276, 276, 276, 276, 634, 620, 631, 276, 276, 276, 276, 616, 276, 619, 276, 276, 276, 276, 618, 617, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 37: 276, 276, 276, 276, 276, 276, 276, 276},
+		{277, 277, 277, 277, 277, 277, 277, 277, 634, 620, 631, 277, 277, 277, 277, 616, 277, 619, 277, 277, 277, 277, 618, 617, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 37: 277, 277, 277, 277, 277, 277, 277, 277},
+		{278, 278, 278, 278, 278, 278, 278, 278, 634, 620, 631, 278, 278, 278, 278, 616, 278, 619, 278, 278, 278, 278, 618, 617, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 37: 278, 278, 278, 278, 278, 278, 278, 278},
 		// 260
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 630, 269: 629},
-		{109, 97: 109, 119: 109},
-		{1: 108, 4: 108, 108, 108, 42: 108, 44: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
-		{1: 107, 4: 107, 107, 107, 42: 107, 44: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
-		{111, 15: 111, 111, 89: 111, 111, 111, 96: 111, 111, 119: 111, 125: 632},
+		{279, 279, 279, 279, 279, 279, 279, 279, 634, 620, 631, 279, 279, 279, 279, 616, 279, 619, 279, 279, 279, 279, 618, 617, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 37: 279, 279, 279, 279, 279, 279, 279, 279},
+		{280, 280, 280, 280, 280, 280, 280, 280, 634, 620, 631, 280, 280, 280, 280, 616, 280, 619, 280, 280, 280, 280, 618, 617, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 37: 280, 280, 280, 280, 280, 280, 280, 280},
+		{281, 281, 281, 281, 281, 281, 281, 281, 634, 281, 631, 281, 281, 281, 281, 616, 281, 281, 281, 281, 281, 281, 618, 617, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 281, 37: 281, 281, 281, 281, 281, 281, 281, 281},
+		{282, 282, 282, 282, 282, 282, 282, 282, 634, 282, 631, 282, 282, 282, 282, 616, 282, 282, 282, 282, 282, 282, 618, 617, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 282, 37: 282, 282, 282, 282, 282, 282, 282, 282},
+		{283, 283, 283, 283, 283, 283, 283, 283, 634, 283, 631, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 283, 37: 283, 283, 283, 283, 283, 283, 283, 283},
 		// 265
-		{104, 15: 104, 104, 89: 104, 104, 104, 96: 104, 104, 119: 104, 125: 104},
-		{47, 2: 47, 47, 7: 47, 15: 47, 47, 89: 47, 47, 47, 96: 47, 47, 119: 47, 125: 47},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 633},
-		{103, 15: 103, 103, 89: 103, 103, 103, 96: 103, 103, 119: 103, 125: 103},
-		{126, 126, 126, 126, 126, 7: 126, 126, 10: 126, 126, 126, 126, 31: 126, 126, 126, 126, 126, 82: 126, 161: 126, 163: 126, 126, 126, 126, 178: 126, 126},
+		{284, 284, 284, 284, 284, 284, 284, 284, 634, 284, 631, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 284, 37: 284, 284, 284, 284, 284, 284, 284, 284},
+		{285, 285, 285, 285, 285, 285, 285, 285, 634, 285, 631, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 285, 37: 285, 285, 285, 285, 285, 285, 285, 285},
+		{88, 2: 88, 88, 11: 88, 88, 88, 88, 90: 509, 192: 768},
+		{246: 679, 678, 293: 677},
+		{161, 2: 161, 161, 5: 766, 11: 161, 161, 161, 161, 90: 161},
 		// 270
-		{42: 637, 44: 636},
-		{32: 455, 160: 639, 162: 680},
-		{32: 455, 160: 639, 162: 638},
-		{135, 135, 135, 135, 7: 135, 10: 135, 135, 135, 135, 31: 135, 33: 135, 135, 135, 82: 135, 161: 135},
-		{8: 645, 32: 647, 163: 643, 641, 644, 642, 196: 646, 279: 640},
+		{158, 2: 158, 158, 5: 158, 11: 158, 158, 158, 158, 90: 158},
+		{36: 682, 56: 503, 58: 502, 60: 504, 175: 505, 202: 681, 205: 501, 277: 680},
+		{154, 2: 154, 154, 5: 154, 11: 154, 154, 154, 154, 90: 154, 97: 762, 275: 763, 761},
+		{151, 2: 151, 151, 5: 151, 11: 151, 151, 151, 151, 90: 151, 97: 151},
+		{120, 470, 4: 469, 6: 452, 477, 18: 120, 36: 506, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 686, 493, 685, 463, 687, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 690, 105: 120, 175: 505, 191: 689, 202: 684, 205: 501, 688, 295: 683},
 		// 275
-		{32: 455, 160: 679},
-		{1: 424, 4: 423, 406, 431, 17: 115, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 623, 97: 115, 115, 119: 115, 173: 622, 192: 676},
-		{122, 4: 122, 15: 122, 122, 32: 122, 82: 122, 89: 122, 91: 122},
-		{1: 424, 4: 423, 406, 431, 17: 115, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 623, 97: 115, 115, 173: 622, 192: 674},
-		{120, 4: 120, 15: 120, 120, 32: 120, 82: 120, 89: 120, 91: 120},
+		{758, 5: 759},
+		{149, 5: 149},
+		{33, 18: 33, 36: 506, 53: 755, 754, 105: 33, 175: 706, 753},
+		{34, 18: 34, 36: 506, 53: 749, 748, 105: 34, 175: 706, 750},
+		{30, 18: 30, 93: 702, 105: 30},
 		// 280
-		{118, 4: 118, 15: 118, 118, 32: 118, 82: 118, 89: 118, 91: 118},
-		{15: 659, 660, 32: 90, 89: 661, 91: 662, 189: 663, 673},
-		{8: 125, 32: 455, 160: 648, 163: 125, 125, 125, 125, 240: 649},
-		{124, 4: 124, 8: 124, 82: 124, 163: 124, 124, 124, 124},
-		{8: 645, 163: 643, 641, 644, 642, 196: 650},
+		{701},
+		{115, 18: 695, 105: 694, 114: 115, 126: 115, 209: 692, 213: 693, 286: 691},
+		{119, 2: 119, 119, 5: 119, 11: 119, 119, 119, 119, 16: 119, 18: 119, 89: 119, 119, 105: 119, 114: 119, 126: 119, 128: 119, 146: 119},
+		{122, 114: 122, 126: 122},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 697, 284: 696},
 		// 285
-		{125, 4: 125, 32: 455, 82: 125, 160: 648, 240: 651},
-		{83, 4: 83, 82: 458, 174: 652},
-		{99, 4: 654, 244: 655, 653},
-		{657},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 656, 460},
+		{114, 114: 114, 126: 114},
+		{1: 113, 4: 113, 6: 113, 113, 45: 113, 113, 113, 113, 113, 113, 53: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
+		{1: 112, 4: 112, 6: 112, 112, 45: 112, 112, 112, 112, 112, 112, 53: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{116, 15: 116, 17: 116, 51: 116, 116, 100: 116, 107: 116, 114: 116, 126: 116, 143: 699},
+		{109, 15: 109, 17: 109, 51: 109, 109, 100: 109, 107: 109, 114: 109, 126: 109, 143: 109},
 		// 290
-		{98},
-		{100, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{15: 659, 660, 32: 90, 89: 661, 91: 662, 189: 663, 658},
-		{32: 101},
-		{32: 97, 90: 97, 96: 97},
+		{50, 2: 50, 50, 5: 50, 15: 50, 17: 50, 36: 50, 51: 50, 50, 100: 50, 107: 50, 114: 50, 126: 50, 143: 50},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 700},
+		{108, 15: 108, 17: 108, 51: 108, 108, 100: 108, 107: 108, 114: 108, 126: 108, 143: 108},
+		{131, 131, 131, 131, 131, 131, 9: 131, 11: 131, 131, 131, 131, 32: 131, 131, 131, 131, 131, 90: 131, 97: 131, 177: 131, 131, 131, 131, 194: 131, 131},
+		{53: 704, 703},
 		// 295
-		{32: 96, 90: 96, 96: 96},
-		{32: 95, 90: 95, 96: 95},
-		{7: 665, 85: 664},
-		{32: 89, 90: 89, 96: 89},
-		{7: 669, 181: 668},
+		{36: 506, 175: 706, 747},
+		{36: 506, 175: 706, 705},
+		{140, 140, 140, 140, 5: 140, 11: 140, 140, 140, 140, 32: 140, 140, 140, 140, 90: 140, 97: 140},
+		{9: 712, 36: 714, 177: 710, 708, 711, 709, 210: 713, 298: 707},
+		{36: 506, 175: 746},
 		// 300
-		{85: 666},
-		{181: 667},
-		{32: 91, 90: 91, 96: 91},
-		{32: 94, 90: 94, 96: 94},
-		{85: 671, 181: 670},
+		{1: 470, 4: 469, 6: 452, 477, 18: 120, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 690, 105: 120, 114: 120, 126: 120, 191: 689, 206: 743},
+		{127, 4: 127, 15: 127, 17: 127, 36: 127, 51: 127, 127, 90: 127},
+		{1: 470, 4: 469, 6: 452, 477, 18: 120, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 690, 105: 120, 114: 120, 191: 689, 206: 741},
+		{125, 4: 125, 15: 125, 17: 125, 36: 125, 51: 125, 125, 90: 125},
+		{123, 4: 123, 15: 123, 17: 123, 36: 123, 51: 123, 123, 90: 123},
 		// 305
-		{32: 93, 90: 93, 96: 93},
-		{181: 672},
-		{32: 92, 90: 92, 96: 92},
-		{32: 102},
-		{97: 675},
+		{15: 726, 17: 727, 36: 95, 51: 728, 729, 203: 730, 740},
+		{9: 130, 36: 506, 175: 715, 177: 130, 130, 130, 130, 260: 716},
+		{129, 4: 129, 9: 129, 90: 129, 177: 129, 129, 129, 129},
+		{9: 712, 177: 710, 708, 711, 709, 210: 717},
+		{130, 4: 130, 36: 506, 90: 130, 175: 715, 260: 718},
 		// 310
-		{121, 4: 121, 15: 121, 121, 32: 121, 82: 121, 89: 121, 91: 121},
-		{97: 678, 119: 677},
-		{123, 4: 123, 15: 123, 123, 32: 123, 82: 123, 89: 123, 91: 123},
-		{119, 4: 119, 15: 119, 119, 32: 119, 82: 119, 89: 119, 91: 119},
-		{130, 130, 130, 130, 7: 130, 10: 130, 130, 130, 130, 31: 130, 33: 130, 130, 130, 82: 130, 161: 130},
+		{88, 4: 88, 90: 509, 192: 719},
+		{104, 4: 721, 264: 722, 720},
+		{724},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 723},
+		{103},
 		// 315
-		{138, 138, 138, 138, 7: 138, 10: 138, 138, 138, 138, 31: 138, 33: 138, 138, 138, 82: 138, 161: 138},
-		{32: 455, 160: 639, 162: 685},
-		{32: 455, 160: 639, 162: 684},
-		{134, 134, 134, 134, 7: 134, 10: 134, 134, 134, 134, 31: 134, 33: 134, 134, 134, 82: 134, 161: 134},
-		{136, 136, 136, 136, 7: 136, 10: 136, 136, 136, 136, 31: 136, 33: 136, 136, 136, 82: 136, 161: 136},
+		{105, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{15: 726, 17: 727, 36: 95, 51: 728, 729, 203: 730, 725},
+		{36: 106},
+		{36: 102, 100: 102, 107: 102},
+		{36: 101, 100: 101, 107: 101},
 		// 320
-		{139, 139, 139, 139, 7: 139, 10: 139, 139, 139, 139, 31: 139, 33: 139, 139, 139, 82: 139, 161: 139},
-		{141, 141, 141, 141, 7: 141, 10: 141, 141, 141, 141, 31: 141, 33: 141, 141, 141, 82: 141, 161: 141},
-		{32: 455, 160: 639, 162: 690},
-		{32: 455, 160: 639, 162: 689},
-		{137, 137, 137, 137, 7: 137, 10: 137, 137, 137, 137, 31: 137, 33: 137, 137, 137, 82: 137, 161: 137},
+		{36: 100, 100: 100, 107: 100},
+		{5: 732, 93: 731},
+		{36: 94, 100: 94, 107: 94},
+		{5: 736, 37: 735},
+		{93: 733},
 		// 325
-		{140, 140, 140, 140, 7: 140, 10: 140, 140, 140, 140, 31: 140, 33: 140, 140, 140, 82: 140, 161: 140},
-		{145, 2: 145, 145, 7: 145, 10: 145, 145, 145, 145, 82: 145, 161: 145},
-		{32: 455, 46: 452, 451, 49: 453, 160: 454, 188: 693, 191: 450},
-		{143, 7: 143},
-		{147, 2: 147, 147, 7: 147, 10: 147, 147, 147, 147, 82: 147, 281: 698},
+		{37: 734},
+		{36: 96, 100: 96, 107: 96},
+		{36: 99, 100: 99, 107: 99},
+		{37: 737, 93: 738},
+		{36: 98, 100: 98, 107: 98},
 		// 330
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 697},
-		{148, 2: 148, 148, 7: 148, 10: 148, 148, 148, 148, 82: 148},
-		{150, 2: 150, 150, 7: 150, 10: 150, 150, 150, 150, 82: 150},
-		{151, 2: 151, 151, 7: 151, 10: 151, 151, 151, 151, 82: 151},
-		{227: 612, 700},
+		{37: 739},
+		{36: 97, 100: 97, 107: 97},
+		{36: 107},
+		{114: 742},
+		{126, 4: 126, 15: 126, 17: 126, 36: 126, 51: 126, 126, 90: 126},
 		// 335
-		{152, 2: 152, 152, 7: 152, 10: 152, 152, 152, 152, 82: 152},
-		{81, 2: 81, 81, 10: 81, 81, 81, 703, 183: 702},
-		{73, 2: 73, 73, 10: 73, 73, 714, 184: 713},
-		{205: 704},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 547, 460, 175: 707, 194: 706, 206: 705},
+		{114: 745, 126: 744},
+		{128, 4: 128, 15: 128, 17: 128, 36: 128, 51: 128, 128, 90: 128},
+		{124, 4: 124, 15: 124, 17: 124, 36: 124, 51: 124, 124, 90: 124},
+		{135, 135, 135, 135, 5: 135, 11: 135, 135, 135, 135, 32: 135, 135, 135, 135, 90: 135, 97: 135},
+		{143, 143, 143, 143, 5: 143, 11: 143, 143, 143, 143, 32: 143, 143, 143, 143, 90: 143, 97: 143},
 		// 340
-		{80, 2: 80, 80, 7: 711, 10: 80, 80, 80},
-		{79, 2: 79, 79, 7: 79, 10: 79, 79, 79},
-		{77, 2: 77, 77, 7: 77, 10: 77, 77, 77, 37: 709, 710, 274: 708},
-		{76, 2: 76, 76, 7: 76, 10: 76, 76, 76},
-		{75, 2: 75, 75, 7: 75, 10: 75, 75, 75},
+		{36: 506, 175: 706, 752},
+		{36: 506, 175: 706, 751},
+		{139, 139, 139, 139, 5: 139, 11: 139, 139, 139, 139, 32: 139, 139, 139, 139, 90: 139, 97: 139},
+		{141, 141, 141, 141, 5: 141, 11: 141, 141, 141, 141, 32: 141, 141, 141, 141, 90: 141, 97: 141},
+		{144, 144, 144, 144, 5: 144, 11: 144, 144, 144, 144, 32: 144, 144, 144, 144, 90: 144, 97: 144},
 		// 345
-		{74, 2: 74, 74, 7: 74, 10: 74, 74, 74},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 547, 460, 175: 707, 194: 712},
-		{78, 2: 78, 78, 7: 78, 10: 78, 78, 78},
-		{71, 2: 71, 71, 10: 71, 717, 187: 716},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 715, 460},
+		{146, 146, 146, 146, 5: 146, 11: 146, 146, 146, 146, 32: 146, 146, 146, 146, 90: 146, 97: 146},
+		{36: 506, 175: 706, 757},
+		{36: 506, 175: 706, 756},
+		{142, 142, 142, 142, 5: 142, 11: 142, 142, 142, 142, 32: 142, 142, 142, 142, 90: 142, 97: 142},
+		{145, 145, 145, 145, 5: 145, 11: 145, 145, 145, 145, 32: 145, 145, 145, 145, 90: 145, 97: 145},
 		// 350
-		{72, 2: 72, 72, 8: 562, 575, 72, 72, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{69, 2: 69, 69, 10: 721, 186: 720},
-		{205: 718},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 547, 460, 175: 707, 194: 706, 206: 719},
-		{70, 2: 70, 70, 7: 711, 10: 70},
+		{150, 2: 150, 150, 5: 150, 11: 150, 150, 150, 150, 90: 150, 97: 150},
+		{36: 506, 56: 503, 58: 502, 60: 504, 175: 505, 202: 760, 205: 501},
+		{148, 5: 148},
+		{152, 2: 152, 152, 5: 152, 11: 152, 152, 152, 152, 90: 152, 300: 765},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 764},
 		// 355
-		{170, 2: 170, 170},
-		{85: 725, 89: 724, 200: 723, 722},
-		{68, 2: 68, 68, 7: 726, 45: 727},
-		{65, 2: 65, 65, 7: 65, 45: 65},
-		{64, 2: 64, 64, 7: 64, 45: 64},
+		{153, 2: 153, 153, 5: 153, 11: 153, 153, 153, 153, 90: 153},
+		{155, 2: 155, 155, 5: 155, 11: 155, 155, 155, 155, 90: 155},
+		{156, 2: 156, 156, 5: 156, 11: 156, 156, 156, 156, 90: 156},
+		{246: 679, 767},
+		{157, 2: 157, 157, 5: 157, 11: 157, 157, 157, 157, 90: 157},
 		// 360
-		{63, 2: 63, 63, 7: 63, 45: 63},
-		{85: 725, 89: 724, 200: 723, 729},
-		{85: 725, 89: 724, 200: 723, 728},
-		{66, 2: 66, 66},
-		{67, 2: 67, 67},
+		{86, 2: 86, 86, 11: 86, 86, 86, 770, 197: 769},
+		{78, 2: 78, 78, 11: 78, 78, 781, 198: 780},
+		{220: 771},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 605, 193: 774, 208: 773, 221: 772},
+		{85, 2: 85, 85, 5: 778, 11: 85, 85, 85},
 		// 365
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 33: 173, 173, 173, 173, 173, 173, 173, 173, 173},
-		{8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 36: 734, 193: 733, 241: 743},
-		{5: 181, 36: 734, 39: 740, 193: 739, 217: 738},
-		{5: 184, 36: 184, 39: 184},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 735, 460},
+		{84, 2: 84, 84, 5: 84, 11: 84, 84, 84},
+		{82, 2: 82, 82, 5: 82, 11: 82, 82, 82, 39: 776, 777, 294: 775},
+		{81, 2: 81, 81, 5: 81, 11: 81, 81, 81},
+		{80, 2: 80, 80, 5: 80, 11: 80, 80, 80},
+		{79, 2: 79, 79, 5: 79, 11: 79, 79, 79},
 		// 370
-		{8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 41: 736},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 737, 460},
-		{5: 182, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 36: 182, 39: 182},
-		{5: 742},
-		{5: 183, 36: 183, 39: 183},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 605, 193: 774, 208: 779},
+		{83, 2: 83, 83, 5: 83, 11: 83, 83, 83},
+		{76, 2: 76, 76, 11: 76, 784, 201: 783},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 782},
+		{77, 2: 77, 77, 8: 634, 620, 631, 77, 77, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
 		// 375
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 741, 460},
-		{5: 180, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 33: 185, 185, 185, 185, 185, 185, 185, 185, 185},
-		{5: 181, 36: 734, 39: 740, 193: 739, 217: 744},
-		{5: 745},
+		{74, 2: 74, 74, 11: 788, 200: 787},
+		{220: 785},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 605, 193: 774, 208: 773, 221: 786},
+		{75, 2: 75, 75, 5: 778, 11: 75},
+		{175, 2: 175, 175},
 		// 380
-		{186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 33: 186, 186, 186, 186, 186, 186, 186, 186, 186},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 747, 460},
-		{8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 40: 748},
-		{48: 757, 63: 751, 756, 66: 750, 758, 246: 749, 248: 755, 754, 254: 753, 265: 752},
-		{765},
+		{51: 791, 93: 792, 214: 790, 789},
+		{73, 2: 73, 73, 5: 793, 55: 794},
+		{70, 2: 70, 70, 5: 70, 55: 70},
+		{69, 2: 69, 69, 5: 69, 55: 69},
+		{68, 2: 68, 68, 5: 68, 55: 68},
 		// 385
-		{199},
-		{198},
-		{197},
-		{196},
-		{195},
+		{51: 791, 93: 792, 214: 790, 796},
+		{51: 791, 93: 792, 214: 790, 795},
+		{71, 2: 71, 71},
+		{72, 2: 72, 72},
+		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 37: 178, 178, 178, 178, 178, 178, 178, 178},
 		// 390
-		{194},
-		{193},
-		{192, 60: 762},
-		{190, 60: 759},
-		{48: 760},
+		{8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 38: 801, 207: 800, 261: 810},
+		{6: 192, 38: 801, 42: 807, 207: 806, 233: 805},
+		{6: 195, 38: 195, 42: 195},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 802},
+		{8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 44: 803},
 		// 395
-		{62: 761},
-		{189},
-		{48: 763},
-		{62: 764},
-		{191},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 804},
+		{6: 193, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 38: 193, 42: 193},
+		{6: 809},
+		{6: 194, 38: 194, 42: 194},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 808},
 		// 400
-		{200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 33: 200, 200, 200, 200, 200, 200, 200, 200, 200},
-		{52: 770, 55: 771, 772, 769, 59: 773, 61: 768, 68: 774, 775, 251: 767},
-		{14: 776},
-		{14: 210},
-		{14: 209},
+		{6: 191, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 37: 196, 196, 196, 196, 196, 196, 196, 196},
+		{6: 192, 38: 801, 42: 807, 207: 806, 233: 811},
+		{6: 812},
+		{197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 37: 197, 197, 197, 197, 197, 197, 197, 197},
 		// 405
-		{14: 208},
-		{14: 207},
-		{14: 206},
-		{14: 205},
-		{14: 204},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 814},
+		{8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 43: 815},
+		{45: 824, 823, 825, 818, 817, 168: 822, 821, 820, 819, 227: 816},
+		{832},
+		{210, 5: 210, 8: 210, 174: 210},
 		// 410
-		{14: 203},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 777, 460},
-		{778, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 33: 211, 211, 211, 211, 211, 211, 211, 211, 211},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 780},
+		{209, 5: 209, 8: 209, 174: 209},
+		{208, 5: 208, 8: 208, 174: 208},
+		{207, 5: 207, 8: 207, 174: 207},
+		{206, 5: 206, 8: 206, 174: 206},
+		{205, 5: 205, 8: 205, 174: 205},
 		// 415
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 781, 460},
-		{213, 7: 783, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570, 272: 782},
-		{785},
-		{87: 488, 92: 490, 94: 489, 124: 784},
-		{212},
+		{204, 5: 204, 8: 204, 174: 204},
+		{203, 5: 203, 8: 203, 70: 829, 174: 203},
+		{201, 5: 201, 8: 201, 70: 826, 174: 201},
+		{45: 827},
+		{72: 828},
 		// 420
-		{216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 33: 216, 216, 216, 216, 216, 216, 216, 216, 216},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 787},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 788, 460},
-		{789, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 33: 217, 217, 217, 217, 217, 217, 217, 217, 217},
+		{200, 5: 200, 8: 200, 174: 200},
+		{45: 830},
+		{72: 831},
+		{202, 5: 202, 8: 202, 174: 202},
+		{211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 37: 211, 211, 211, 211, 211, 211, 211, 211},
 		// 425
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 791},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 792, 460},
-		{793, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 33: 218, 218, 218, 218, 218, 218, 218, 218, 218},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 795},
+		{63: 837, 65: 838, 839, 836, 69: 840, 71: 835, 76: 841, 842, 268: 834},
+		{16: 843},
+		{16: 221},
+		{16: 220},
+		{16: 219},
 		// 430
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 796, 460},
-		{797, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 33: 219, 219, 219, 219, 219, 219, 219, 219, 219},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 799},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 800, 460},
+		{16: 218},
+		{16: 217},
+		{16: 216},
+		{16: 215},
+		{16: 214},
 		// 435
-		{801, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 33: 220, 220, 220, 220, 220, 220, 220, 220, 220},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 803},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 804, 460},
-		{805, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 844},
+		{845, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 37: 222, 222, 222, 222, 222, 222, 222, 222},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 847},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 848},
 		// 440
-		{221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 33: 221, 221, 221, 221, 221, 221, 221, 221, 221},
-		{1: 215, 4: 215, 215, 215, 8: 215, 215, 15: 807, 32: 215, 42: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 87: 215, 92: 215, 215, 215, 215, 99: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 167: 544, 808},
-		{811},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 809, 460},
-		{810, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
+		{224, 5: 850, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 291: 849},
+		{852},
+		{94: 542, 98: 544, 543, 129: 851},
+		{223},
+		{227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 37: 227, 227, 227, 227, 227, 227, 227, 227},
 		// 445
-		{222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 33: 222, 222, 222, 222, 222, 222, 222, 222, 222},
-		{223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 33: 223, 223, 223, 223, 223, 223, 223, 223, 223},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 813, 460},
-		{8: 562, 575, 14: 814, 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 815, 460, 283: 816},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 854},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 855},
+		{856, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 228, 37: 228, 228, 228, 228, 228, 228, 228, 228},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 858},
 		// 450
-		{226, 6: 226, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{225, 6: 818, 255: 817},
-		{820},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 819, 460},
-		{224, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 859},
+		{860, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 37: 229, 229, 229, 229, 229, 229, 229, 229},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 862},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 863},
 		// 455
-		{227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 33: 227, 227, 227, 227, 227, 227, 227, 227, 227},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 822, 460, 243: 823},
-		{229, 7: 229, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{824, 7: 825},
-		{246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 33: 246, 246, 246, 246, 246, 246, 246, 246, 246},
+		{864, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 37: 230, 230, 230, 230, 230, 230, 230, 230},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 866},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 867},
+		{868, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
 		// 460
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 826, 460},
-		{228, 7: 228, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{829, 8: 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{25, 424, 4: 423, 406, 431, 8: 25, 25, 15: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 448, 404, 86: 25},
-		{247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 33: 247, 247, 247, 247, 247, 247, 247, 247, 247},
+		{231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 231, 37: 231, 231, 231, 231, 231, 231, 231, 231},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 870},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 871},
+		{872, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 232, 37: 232, 232, 232, 232, 232, 232, 232, 232},
 		// 465
-		{249, 249, 249, 249, 249, 249, 249, 249, 249, 575, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 33: 249, 249, 249, 249, 249, 249, 249, 249, 249},
-		{264, 264, 264, 264, 264, 264, 264, 264, 264, 575, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 33: 264, 264, 264, 264, 264, 264, 264, 264, 264},
-		{52: 836, 55: 837, 838, 835, 59: 839, 61: 834, 247: 833},
-		{272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 33: 272, 272, 272, 272, 272, 272, 272, 272, 272},
-		{271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 33: 271, 271, 271, 271, 271, 271, 271, 271, 271},
+		{1: 226, 4: 226, 6: 226, 226, 226, 226, 226, 15: 874, 36: 226, 45: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 93: 226, 226, 98: 226, 226, 101: 226, 226, 226, 226, 106: 226, 108: 226, 226, 226, 226, 226, 226, 115: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 181: 602, 875},
+		{878},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 876},
+		{877, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 233, 37: 233, 233, 233, 233, 233, 233, 233, 233},
 		// 470
-		{270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 33: 270, 270, 270, 270, 270, 270, 270, 270, 270},
-		{269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 33: 269, 269, 269, 269, 269, 269, 269, 269, 269},
-		{268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 33: 268, 268, 268, 268, 268, 268, 268, 268, 268},
-		{267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 33: 267, 267, 267, 267, 267, 267, 267, 267, 267},
-		{266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 33: 266, 266, 266, 266, 266, 266, 266, 266, 266},
+		{234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 234, 37: 234, 234, 234, 234, 234, 234, 234, 234},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 880},
+		{8: 634, 620, 631, 15: 616, 881, 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 882, 303: 883},
+		{237, 7: 237, 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
 		// 475
-		{273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 33: 273, 273, 273, 273, 273, 273, 273, 273, 273},
-		{274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 33: 274, 274, 274, 274, 274, 274, 274, 274, 274},
-		{275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 33: 275, 275, 275, 275, 275, 275, 275, 275, 275},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 845, 404, 203: 844},
-		{313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 313, 33: 313, 313, 313, 313, 313, 313, 313, 313, 313},
+		{236, 7: 885, 271: 884},
+		{887},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 886},
+		{235, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 37: 238, 238, 238, 238, 238, 238, 238, 238},
 		// 480
-		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 33: 49, 49, 49, 49, 49, 49, 49, 49, 49},
-		{32: 455, 160: 858},
-		{32: 455, 160: 857},
-		{17: 628, 98: 627, 195: 625, 199: 853},
-		{17: 628, 98: 627, 195: 625, 199: 850},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 889, 263: 890},
+		{240, 5: 240, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{891, 5: 892},
+		{259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 37: 259, 259, 259, 259, 259, 259, 259, 259},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 893},
 		// 485
-		{15: 659, 660, 89: 661, 90, 662, 189: 663, 851},
-		{90: 852},
-		{32: 128},
-		{15: 659, 660, 89: 661, 90, 662, 96: 90, 189: 663, 854},
-		{90: 856, 96: 855},
+		{239, 5: 239, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 37: 266, 266, 266, 266, 266, 266, 266, 266},
+		{5: 904, 37: 903},
+		{5: 264, 37: 264},
+		{105: 901},
 		// 490
-		{32: 129},
-		{32: 127},
-		{131, 131, 131, 131, 7: 131, 131, 10: 131, 131, 131, 131, 31: 131, 33: 131, 131, 131, 82: 131, 161: 131, 163: 131, 131, 131, 131, 178: 131, 131},
-		{132, 132, 132, 132, 7: 132, 132, 10: 132, 132, 132, 132, 31: 132, 33: 132, 132, 132, 82: 132, 161: 132, 163: 132, 132, 132, 132, 178: 132, 132},
-		{1: 85, 31: 85, 33: 85, 85, 85},
+		{105: 899},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 900},
+		{5: 261, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 37: 261},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 902},
+		{5: 262, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628, 37: 262},
 		// 495
-		{2: 332, 332},
-		{1: 54, 4: 54, 54, 54, 42: 54, 44: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 170: 865, 197: 870},
-		{1: 54, 4: 54, 54, 54, 42: 54, 44: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 170: 865, 197: 868},
-		{1: 54, 4: 54, 54, 54, 42: 54, 44: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 170: 865, 197: 864},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 867, 404},
+		{265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 37: 265, 265, 265, 265, 265, 265, 265, 265},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 898, 450, 94: 897, 245: 905},
+		{5: 263, 37: 263},
+		{268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 37: 268, 268, 268, 268, 268, 268, 268, 268},
+		{5: 651, 41: 908},
 		// 500
-		{93: 866},
-		{1: 53, 4: 53, 53, 53, 42: 53, 44: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{2: 333, 333},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 869},
-		{2: 334, 334},
+		{267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 37: 267, 267, 267, 267, 267, 267, 267, 267},
+		{911, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{28, 470, 4: 469, 6: 452, 477, 28, 28, 28, 15: 28, 17: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 499, 450, 96: 28},
+		{269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 37: 269, 269, 269, 269, 269, 269, 269, 269},
+		{271, 271, 271, 271, 271, 271, 271, 271, 634, 271, 631, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 37: 271, 271, 271, 271, 271, 271, 271, 271},
 		// 505
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 871},
-		{2: 335, 335},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 938, 287: 937},
-		{121: 330, 123: 330, 266: 899, 898, 897},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 877, 221: 876, 258: 875},
+		{286, 286, 286, 286, 286, 286, 286, 286, 634, 286, 631, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 286, 37: 286, 286, 286, 286, 286, 286, 286, 286},
+		{63: 918, 65: 919, 920, 917, 69: 921, 71: 916, 266: 915},
+		{294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 294, 37: 294, 294, 294, 294, 294, 294, 294, 294, 174: 294},
+		{293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 293, 37: 293, 293, 293, 293, 293, 293, 293, 293, 174: 293},
+		{292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 292, 37: 292, 292, 292, 292, 292, 292, 292, 292, 174: 292},
 		// 510
-		{7: 890, 14: 609, 182: 889},
-		{7: 61, 14: 61},
-		{158: 878},
-		{32: 879},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 883, 122: 882, 202: 880, 230: 881},
+		{291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 291, 37: 291, 291, 291, 291, 291, 291, 291, 291, 174: 291},
+		{290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 290, 37: 290, 290, 290, 290, 290, 290, 290, 290, 174: 290},
+		{289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 289, 37: 289, 289, 289, 289, 289, 289, 289, 289, 174: 289},
+		{288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 288, 37: 288, 288, 288, 288, 288, 288, 288, 288, 174: 288},
+		{295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 295, 37: 295, 295, 295, 295, 295, 295, 295, 295, 174: 295},
 		// 515
-		{316, 7: 316},
-		{887, 7: 886},
-		{20: 884},
-		{86: 843},
-		{1: 424, 4: 423, 406, 431, 8: 501, 503, 32: 504, 42: 421, 515, 420, 416, 418, 419, 497, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 496, 446, 443, 498, 440, 441, 528, 526, 531, 523, 530, 403, 499, 529, 525, 524, 522, 527, 83: 537, 404, 491, 87: 488, 459, 92: 490, 535, 489, 514, 99: 500, 509, 521, 534, 510, 511, 492, 518, 494, 493, 512, 516, 513, 519, 508, 506, 517, 520, 495, 507, 122: 461, 124: 481, 127: 536, 471, 464, 463, 483, 468, 476, 475, 470, 484, 479, 472, 469, 505, 477, 487, 474, 473, 462, 466, 478, 482, 465, 480, 533, 532, 467, 485, 486, 885, 460},
+		{296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 296, 37: 296, 296, 296, 296, 296, 296, 296, 296, 174: 296},
+		{297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 297, 37: 297, 297, 297, 297, 297, 297, 297, 297, 174: 297},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 926},
+		{338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 37: 338, 338, 338, 338, 338, 338, 338, 338},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 37: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 168: 52, 52, 52, 52},
 		// 520
-		{314, 7: 314, 562, 575, 15: 558, 561, 573, 574, 571, 563, 560, 559, 566, 565, 567, 568, 564, 569, 572, 570},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 883, 122: 882, 202: 888},
-		{7: 59, 14: 59},
-		{315, 7: 315},
-		{2: 83, 83, 10: 83, 83, 83, 83, 82: 458, 174: 892},
+		{36: 506, 175: 940},
+		{36: 506, 175: 939},
+		{18: 695, 105: 694, 209: 692, 213: 935},
+		{18: 695, 105: 694, 209: 692, 213: 932},
+		{15: 726, 17: 727, 51: 728, 729, 100: 95, 203: 730, 933},
 		// 525
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 877, 221: 891},
-		{7: 60, 14: 60},
-		{2: 81, 81, 10: 81, 81, 81, 703, 183: 893},
-		{2: 73, 73, 10: 73, 73, 714, 184: 894},
-		{2: 71, 71, 10: 71, 717, 187: 895},
+		{100: 934},
+		{36: 133},
+		{15: 726, 17: 727, 51: 728, 729, 100: 95, 107: 95, 203: 730, 936},
+		{100: 938, 107: 937},
+		{36: 134},
 		// 530
-		{2: 69, 69, 10: 721, 186: 896},
-		{2: 62, 62},
-		{121: 904, 123: 903, 220: 902, 257: 901},
-		{121: 329, 123: 329},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 900},
+		{36: 132},
+		{136, 136, 136, 136, 5: 136, 9: 136, 11: 136, 136, 136, 136, 32: 136, 136, 136, 136, 90: 136, 97: 136, 177: 136, 136, 136, 136, 194: 136, 136},
+		{137, 137, 137, 137, 5: 137, 9: 137, 11: 137, 137, 137, 137, 32: 137, 137, 137, 137, 90: 137, 97: 137, 177: 137, 137, 137, 137, 194: 137, 137},
+		{1: 90, 32: 90, 90, 90, 90},
+		{2: 358, 358},
 		// 535
-		{121: 328, 123: 328},
-		{2: 155, 155, 7: 929, 10: 155, 155, 155, 155, 609, 82: 155, 182: 930, 256: 928},
-		{2: 327, 327, 7: 327, 10: 327, 327, 327, 327, 327, 82: 327},
-		{1: 424, 115, 115, 423, 406, 431, 115, 10: 115, 115, 115, 115, 115, 42: 421, 115, 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 115, 537, 404, 88: 623, 120: 115, 173: 926},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 623, 126: 115, 173: 905},
+		{1: 440, 32: 93, 185: 439, 187: 438, 599, 190: 944},
+		{2: 357, 357},
+		{1: 57, 4: 57, 6: 57, 57, 45: 57, 57, 57, 57, 57, 57, 53: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 186: 949, 211: 954},
+		{1: 57, 4: 57, 6: 57, 57, 45: 57, 57, 57, 57, 57, 57, 53: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 186: 949, 211: 952},
+		{1: 57, 4: 57, 6: 57, 57, 45: 57, 57, 57, 57, 57, 57, 53: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 186: 949, 211: 948},
 		// 540
-		{126: 906},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 907},
-		{19: 908},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 909},
-		{2: 322, 322, 7: 322, 10: 322, 322, 322, 322, 322, 43: 913, 82: 322, 120: 322, 224: 910, 912, 911},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 951, 450},
+		{102: 950},
+		{1: 56, 4: 56, 6: 56, 56, 45: 56, 56, 56, 56, 56, 56, 53: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{2: 359, 359},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 953},
 		// 545
-		{2: 324, 324, 7: 324, 10: 324, 324, 324, 324, 324, 82: 324},
-		{2: 319, 319, 7: 319, 10: 319, 319, 319, 319, 319, 82: 319, 120: 922, 276: 921, 920},
-		{2: 321, 321, 7: 321, 10: 321, 321, 321, 321, 321, 82: 321, 120: 321},
-		{32: 914},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 916, 270: 915},
+		{2: 360, 360},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 955},
+		{2: 361, 361},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 1022, 307: 1021},
+		{131: 355, 135: 355, 281: 983, 982, 981},
 		// 550
-		{917, 7: 918},
-		{106, 7: 106},
-		{2: 320, 320, 7: 320, 10: 320, 320, 320, 320, 320, 82: 320, 120: 320},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 919},
-		{105, 7: 105},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 961, 237: 960, 274: 959},
+		{5: 974, 16: 676, 196: 973},
+		{5: 66, 16: 66},
+		{172: 962},
+		{36: 963},
 		// 555
-		{2: 323, 323, 7: 323, 10: 323, 323, 323, 323, 323, 82: 323},
-		{2: 318, 318, 7: 318, 10: 318, 318, 318, 318, 318, 82: 318},
-		{32: 923},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 883, 122: 882, 202: 880, 230: 924},
-		{925, 7: 886},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 967, 127: 966, 217: 964, 248: 965},
+		{341, 5: 341},
+		{971, 5: 970},
+		{21: 968},
+		{96: 925},
 		// 560
-		{2: 317, 317, 7: 317, 10: 317, 317, 317, 317, 317, 82: 317},
-		{2: 322, 322, 7: 322, 10: 322, 322, 322, 322, 322, 43: 913, 82: 322, 120: 322, 224: 927, 912, 911},
-		{2: 325, 325, 7: 325, 10: 325, 325, 325, 325, 325, 82: 325},
-		{2: 83, 83, 10: 83, 83, 83, 83, 82: 458, 174: 932},
-		{121: 904, 123: 903, 220: 931},
+		{1: 470, 4: 469, 6: 452, 477, 557, 555, 559, 36: 558, 45: 551, 550, 552, 454, 489, 495, 554, 560, 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 553, 492, 494, 486, 487, 586, 584, 589, 581, 588, 579, 587, 583, 582, 580, 585, 571, 91: 595, 450, 545, 542, 510, 98: 544, 543, 101: 546, 593, 547, 570, 106: 548, 108: 549, 565, 577, 592, 566, 567, 115: 574, 568, 572, 569, 575, 564, 562, 573, 578, 576, 563, 127: 512, 129: 535, 514, 132: 532, 536, 511, 136: 537, 538, 541, 513, 594, 539, 540, 144: 522, 515, 147: 519, 527, 526, 521, 530, 523, 520, 561, 528, 525, 524, 517, 533, 529, 516, 531, 591, 590, 518, 534, 969},
+		{339, 5: 339, 8: 634, 620, 631, 15: 616, 17: 619, 632, 633, 629, 621, 618, 617, 624, 623, 625, 626, 622, 627, 630, 628},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 967, 127: 966, 217: 972},
+		{5: 64, 16: 64},
+		{340, 5: 340},
 		// 565
-		{2: 154, 154, 10: 154, 154, 154, 154, 82: 154},
-		{2: 326, 326, 7: 326, 10: 326, 326, 326, 326, 326, 82: 326},
-		{2: 81, 81, 10: 81, 81, 81, 703, 183: 933},
-		{2: 73, 73, 10: 73, 73, 714, 184: 934},
-		{2: 71, 71, 10: 71, 717, 187: 935},
+		{2: 88, 88, 11: 88, 88, 88, 88, 90: 509, 192: 976},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 961, 237: 975},
+		{5: 65, 16: 65},
+		{2: 86, 86, 11: 86, 86, 86, 770, 197: 977},
+		{2: 78, 78, 11: 78, 78, 781, 198: 978},
 		// 570
-		{2: 69, 69, 10: 721, 186: 936},
-		{2: 331, 331},
-		{7: 940, 14: 609, 182: 939},
-		{7: 113, 14: 113},
-		{2: 83, 83, 10: 83, 83, 83, 83, 82: 458, 174: 942},
+		{2: 76, 76, 11: 76, 784, 201: 979},
+		{2: 74, 74, 11: 788, 200: 980},
+		{2: 67, 67},
+		{131: 988, 135: 987, 236: 986, 273: 985},
+		{131: 354, 135: 354},
 		// 575
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 537, 404, 88: 941},
-		{7: 112, 14: 112},
-		{2: 81, 81, 10: 81, 81, 81, 703, 183: 943},
-		{2: 73, 73, 10: 73, 73, 714, 184: 944},
-		{2: 71, 71, 10: 71, 717, 187: 945},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 984},
+		{131: 353, 135: 353},
+		{2: 160, 160, 5: 1013, 11: 160, 160, 160, 160, 16: 676, 90: 160, 196: 1014, 272: 1012},
+		{2: 352, 352, 5: 352, 11: 352, 352, 352, 352, 16: 352, 90: 352},
+		{1: 470, 120, 120, 469, 120, 452, 477, 11: 120, 120, 120, 120, 16: 120, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 120, 120, 595, 450, 95: 690, 128: 120, 191: 1010},
 		// 580
-		{2: 69, 69, 10: 721, 186: 946},
-		{2: 336, 336},
-		{1: 52, 4: 52, 52, 52, 42: 52, 44: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 170: 953, 198: 966},
-		{1: 52, 4: 52, 52, 52, 42: 52, 44: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 170: 953, 198: 964},
-		{185: 951},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 690, 146: 120, 191: 989},
+		{146: 990},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 991},
+		{20: 992},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 993},
 		// 585
-		{185: 337},
-		{1: 52, 4: 52, 52, 52, 42: 52, 44: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 170: 953, 198: 952},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 957, 404, 264: 956},
-		{9: 954},
-		{93: 955},
+		{2: 347, 347, 5: 347, 11: 347, 347, 347, 347, 16: 347, 89: 997, 347, 128: 347, 241: 994, 996, 995},
+		{2: 349, 349, 5: 349, 11: 349, 349, 349, 349, 16: 349, 90: 349},
+		{2: 344, 344, 5: 344, 11: 344, 344, 344, 344, 16: 344, 90: 344, 128: 1006, 296: 1005, 1004},
+		{2: 346, 346, 5: 346, 11: 346, 346, 346, 346, 16: 346, 90: 346, 128: 346},
+		{36: 998},
 		// 590
-		{1: 51, 4: 51, 51, 51, 42: 51, 44: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{32: 958},
-		{32: 48},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 845, 404, 203: 960, 278: 959},
-		{961, 7: 962},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 1000, 285: 999},
+		{1001, 5: 1002},
+		{111, 5: 111},
+		{2: 345, 345, 5: 345, 11: 345, 345, 345, 345, 16: 345, 90: 345, 128: 345},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 1003},
 		// 595
-		{2, 7: 2},
-		{2: 339, 339},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 845, 404, 203: 963},
-		{1, 7: 1},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 631, 404, 171: 965},
+		{110, 5: 110},
+		{2: 348, 348, 5: 348, 11: 348, 348, 348, 348, 16: 348, 90: 348},
+		{2: 343, 343, 5: 343, 11: 343, 343, 343, 343, 16: 343, 90: 343},
+		{36: 1007},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 967, 127: 966, 217: 964, 248: 1008},
 		// 600
-		{2: 340, 340},
-		{1: 424, 4: 423, 406, 431, 42: 421, 44: 420, 416, 418, 419, 414, 422, 405, 407, 412, 409, 417, 426, 427, 428, 415, 429, 444, 410, 445, 408, 411, 446, 443, 413, 440, 441, 432, 433, 442, 434, 439, 403, 425, 435, 436, 437, 430, 438, 83: 402, 404, 169: 967},
-		{2: 341, 341},
-		{1: 395, 344, 344, 31: 88, 33: 88, 88, 88, 50: 386, 387, 53: 391, 58: 392, 172: 394, 176: 393, 389, 180: 382, 204: 370, 207: 371, 388, 372, 374, 373, 375, 390, 376, 378, 377, 218: 369, 379, 222: 380, 231: 381, 234: 397, 385, 969, 383, 396, 384},
+		{1009, 5: 970},
+		{2: 342, 342, 5: 342, 11: 342, 342, 342, 342, 16: 342, 90: 342},
+		{2: 347, 347, 5: 347, 11: 347, 347, 347, 347, 16: 347, 89: 997, 347, 128: 347, 241: 1011, 996, 995},
+		{2: 350, 350, 5: 350, 11: 350, 350, 350, 350, 16: 350, 90: 350},
+		{2: 88, 88, 11: 88, 88, 88, 88, 90: 509, 192: 1016},
+		// 605
+		{131: 988, 135: 987, 236: 1015},
+		{2: 159, 159, 11: 159, 159, 159, 159, 90: 159},
+		{2: 351, 351, 5: 351, 11: 351, 351, 351, 351, 16: 351, 90: 351},
+		{2: 86, 86, 11: 86, 86, 86, 770, 197: 1017},
+		{2: 78, 78, 11: 78, 78, 781, 198: 1018},
+		// 610
+		{2: 76, 76, 11: 76, 784, 201: 1019},
+		{2: 74, 74, 11: 788, 200: 1020},
+		{2: 356, 356},
+		{5: 1024, 16: 676, 196: 1023},
+		{5: 118, 16: 118},
+		// 615
+		{2: 88, 88, 11: 88, 88, 88, 88, 90: 509, 192: 1026},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 595, 450, 95: 1025},
+		{5: 117, 16: 117},
+		{2: 86, 86, 11: 86, 86, 86, 770, 197: 1027},
+		{2: 78, 78, 11: 78, 78, 781, 198: 1028},
+		// 620
+		{2: 76, 76, 11: 76, 784, 201: 1029},
+		{2: 74, 74, 11: 788, 200: 1030},
 		{2: 362, 362},
+		{1: 55, 4: 55, 6: 55, 55, 45: 55, 55, 55, 55, 55, 55, 53: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 186: 1037, 212: 1077},
+		{1: 55, 4: 55, 6: 55, 55, 45: 55, 55, 55, 55, 55, 55, 53: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 186: 1037, 212: 1053},
+		// 625
+		{199: 1035},
+		{199: 363},
+		{1: 55, 4: 55, 6: 55, 55, 45: 55, 55, 55, 55, 55, 55, 53: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 186: 1037, 212: 1036},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 1041, 450, 280: 1040},
+		{8: 1038},
+		// 630
+		{102: 1039},
+		{1: 54, 4: 54, 6: 54, 54, 45: 54, 54, 54, 54, 54, 54, 53: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{36: 1042, 97: 1043},
+		{36: 51, 97: 51},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 1047, 249: 1051},
+		// 635
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 1044},
+		{36: 1045},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 1047, 249: 1046},
+		{1048, 5: 1049},
+		{2, 5: 2},
+		// 640
+		{2: 365, 365},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 1050},
+		{1, 5: 1},
+		{1052, 5: 1049},
+		{2: 366, 366},
+		// 645
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 698, 450, 184: 1054},
+		{2: 377, 377, 36: 1056, 288: 1055},
+		{2: 378, 378},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 1059, 240: 1058, 287: 1057},
+		{1074, 5: 1075},
+		// 650
+		{375, 5: 375},
+		{45: 824, 823, 825, 818, 817, 168: 822, 821, 820, 819, 227: 1060},
+		{372, 5: 372, 8: 372, 174: 372, 290: 1061},
+		{373, 5: 373, 8: 1063, 174: 1064, 289: 1062},
+		{371, 5: 371, 8: 371, 174: 371},
+		// 655
+		{216: 1073},
+		{9: 1067, 45: 1069, 1068, 1070, 73: 1071, 93: 545, 542, 98: 544, 543, 101: 546, 103: 547, 106: 548, 108: 549, 129: 535, 133: 536, 136: 537, 538, 541, 1066, 141: 539, 540, 302: 1065},
+		{369, 5: 369, 8: 369, 174: 369},
+		{368, 5: 368, 8: 368, 174: 368},
+		{93: 545, 101: 546, 103: 547, 133: 1072},
+		// 660
+		{94: 924},
+		{94: 923},
+		{94: 922},
+		{93: 914},
+		{367, 5: 367, 8: 367, 174: 367},
+		// 665
+		{370, 5: 370, 8: 370, 174: 370},
+		{2: 376, 376},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 927, 450, 189: 1059, 240: 1076},
+		{374, 5: 374},
+		{1: 470, 4: 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 1078},
+		// 670
+		{2: 380, 380, 50: 1080, 305: 1079},
+		{2: 381, 381},
+		{2: 379, 379},
+		{1: 470, 385, 385, 469, 6: 452, 477, 45: 460, 457, 459, 454, 489, 495, 53: 467, 466, 462, 464, 493, 465, 463, 468, 451, 453, 458, 455, 472, 473, 474, 461, 475, 490, 456, 491, 471, 492, 494, 486, 487, 478, 479, 488, 480, 485, 449, 481, 482, 483, 476, 484, 91: 448, 450, 183: 1082},
+		{2: 384, 384},
+		// 675
+		{1: 440, 386, 386, 32: 93, 93, 93, 93, 57: 430, 61: 431, 432, 64: 436, 68: 437, 185: 439, 187: 438, 434, 190: 426, 218: 413, 414, 222: 415, 433, 416, 418, 417, 228: 419, 435, 420, 422, 421, 234: 412, 423, 238: 424, 250: 425, 253: 442, 429, 1084, 427, 441, 428},
+		{2: 405, 405},
 	}
 )
 
@@ -1961,7 +2130,7 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 }
 
 func yyParse(yylex yyLexer, parser *Parser) int {
-	const yyError = 300
+	const yyError = 319
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -2141,41 +2310,117 @@ yynewstate:
 			if yyS[yypt-0].statement != nil {
 				parser.result = append(parser.result, yyS[yypt-0].statement)
 			}
+			parser.bindVars = 0
 		}
 	case 3:
 		{
 			if yyS[yypt-0].statement != nil {
 				parser.result = append(parser.result, yyS[yypt-0].statement)
 			}
+			parser.bindVars = 0
 		}
-	case 21:
+	case 22:
 		{
 			parser.yyVAL.statement = nil
 		}
-	case 22:
+	case 23:
+		{
+			parser.yyVAL.statement = &ast.AnalyzeGraphStmt{}
+		}
+	case 24:
+		{
+			parser.yyVAL.statement = &ast.AnalyzeGraphStmt{
+				Graph: yyS[yypt-0].item.(model.CIStr),
+			}
+		}
+	case 25:
 		{
 			parser.yyVAL.statement = &ast.BeginStmt{}
 		}
-	case 23:
+	case 26:
 		{
 			parser.yyVAL.statement = &ast.CommitStmt{}
 		}
-	case 24:
+	case 27:
 		{
 			parser.yyVAL.statement = &ast.CreateGraphStmt{
-				IfNotExists: yyS[yypt-1].item.(bool),
-				Graph:       yyS[yypt-0].item.(model.CIStr),
+				IfNotExists: yyS[yypt-2].item.(bool),
+				Graph:       yyS[yypt-1].item.(model.CIStr),
+				Strict:      yyS[yypt-0].item.(bool),
 			}
 		}
-	case 25:
+	case 28:
+		{
+			parser.yyVAL.item = false
+		}
+	case 29:
+		{
+			parser.yyVAL.item = true
+		}
+	case 30:
 		{
 			cl := &ast.CreateLabelStmt{
-				IfNotExists: yyS[yypt-1].item.(bool),
-				Label:       yyS[yypt-0].item.(model.CIStr),
+				IfNotExists: yyS[yypt-2].item.(bool),
+				Label:       yyS[yypt-1].item.(model.CIStr),
+			}
+			if yyS[yypt-0].item != nil {
+				cl.Properties = yyS[yypt-0].item.([]*ast.LabelPropertyDef)
 			}
 			parser.yyVAL.statement = cl
 		}
-	case 26:
+	case 31:
+		{
+			parser.yyVAL.item = nil
+		}
+	case 32:
+		{
+			parser.yyVAL.item = yyS[yypt-1].item
+		}
+	case 33:
+		{
+			parser.yyVAL.item = []*ast.LabelPropertyDef{yyS[yypt-0].item.(*ast.LabelPropertyDef)}
+		}
+	case 34:
+		{
+			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.LabelPropertyDef), yyS[yypt-0].item.(*ast.LabelPropertyDef))
+		}
+	case 35:
+		{
+			def := &ast.LabelPropertyDef{
+				Property: yyS[yypt-2].item.(model.CIStr),
+				Type:     yyS[yypt-1].item.(ast.DataType),
+			}
+			for _, opt := range yyS[yypt-0].item.([]interface{}) {
+				switch o := opt.(type) {
+				case bool:
+					def.NotNull = o
+				case ast.ExprNode:
+					def.Default = o
+				}
+			}
+			parser.yyVAL.item = def
+		}
+	case 36:
+		{
+			parser.yyVAL.item = []interface{}{}
+		}
+	case 37:
+		{
+			parser.yyVAL.item = append(yyS[yypt-1].item.([]interface{}), yyS[yypt-0].item)
+		}
+	case 38:
+		{
+			parser.yyVAL.item = true
+		}
+	case 39:
+		{
+			parser.yyVAL.item = yyS[yypt-0].expr
+		}
+	case 41:
+		{
+			parser.yyVAL.expr = &ast.UnaryExpr{Op: opcode.Minus, V: yyS[yypt-0].expr}
+		}
+	case 42:
 		{
 			parser.yyVAL.statement = &ast.CreateIndexStmt{
 				KeyType:     yyS[yypt-6].item.(ast.IndexKeyType),
@@ -2184,15 +2429,25 @@ yynewstate:
 				Properties:  yyS[yypt-1].item.([]model.CIStr),
 			}
 		}
-	case 27:
+	case 43:
+		{
+			parser.yyVAL.statement = &ast.CreateIndexStmt{
+				KeyType:     yyS[yypt-8].item.(ast.IndexKeyType),
+				IfNotExists: yyS[yypt-6].item.(bool),
+				IndexName:   yyS[yypt-5].item.(model.CIStr),
+				Label:       yyS[yypt-3].item.(model.CIStr),
+				Properties:  yyS[yypt-1].item.([]model.CIStr),
+			}
+		}
+	case 44:
 		{
 			parser.yyVAL.item = ast.IndexKeyTypeNone
 		}
-	case 28:
+	case 45:
 		{
 			parser.yyVAL.item = ast.IndexKeyTypeUnique
 		}
-	case 29:
+	case 46:
 		{
 			ds := &ast.DeleteStmt{
 				VariableNames: yyS[yypt-6].item.([]model.CIStr),
@@ -2218,34 +2473,41 @@ yynewstate:
 			}
 			parser.yyVAL.statement = ds
 		}
-	case 30:
+	case 47:
 		{
 			parser.yyVAL.statement = &ast.DropGraphStmt{
 				IfExists: yyS[yypt-1].item.(bool),
 				Graph:    yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 31:
+	case 48:
 		{
 			parser.yyVAL.statement = &ast.DropLabelStmt{
 				IfExists: yyS[yypt-1].item.(bool),
 				Label:    yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 32:
+	case 49:
 		{
 			parser.yyVAL.statement = &ast.DropIndexStmt{
 				IfExists:  yyS[yypt-1].item.(bool),
 				IndexName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 33:
+	case 50:
 		{
 			parser.yyVAL.statement = &ast.ExplainStmt{
 				Select: yyS[yypt-0].statement.(*ast.SelectStmt),
 			}
 		}
-	case 34:
+	case 51:
+		{
+			parser.yyVAL.statement = &ast.ExplainStmt{
+				Analyze: true,
+				Select:  yyS[yypt-0].statement.(*ast.SelectStmt),
+			}
+		}
+	case 52:
 		{
 			is := &ast.InsertStmt{
 				Insertions: yyS[yypt-6].item.([]*ast.GraphElementInsertion),
@@ -2276,23 +2538,23 @@ yynewstate:
 			}
 			parser.yyVAL.statement = is
 		}
-	case 35:
+	case 53:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 37:
+	case 55:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item
 		}
-	case 38:
+	case 56:
 		{
 			parser.yyVAL.item = []*ast.GraphElementInsertion{yyS[yypt-0].item.(*ast.GraphElementInsertion)}
 		}
-	case 39:
+	case 57:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.GraphElementInsertion), yyS[yypt-0].item.(*ast.GraphElementInsertion))
 		}
-	case 40:
+	case 58:
 		{
 			insertion := &ast.GraphElementInsertion{
 				InsertionType:       ast.InsertionTypeVertex,
@@ -2303,7 +2565,7 @@ yynewstate:
 			}
 			parser.yyVAL.item = insertion
 		}
-	case 41:
+	case 59:
 		{
 			insertion := &ast.GraphElementInsertion{
 				InsertionType:       ast.InsertionTypeEdge,
@@ -2316,7 +2578,7 @@ yynewstate:
 			}
 			parser.yyVAL.item = insertion
 		}
-	case 42:
+	case 60:
 		{
 			lps := &ast.LabelsAndProperties{}
 			if yyS[yypt-1].item != nil {
@@ -2327,83 +2589,83 @@ yynewstate:
 			}
 			parser.yyVAL.item = lps
 		}
-	case 43:
+	case 61:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 45:
+	case 63:
 		{
 			parser.yyVAL.item = yyS[yypt-1].item
 		}
-	case 46:
+	case 64:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 48:
+	case 66:
 		{
 			parser.yyVAL.item = yyS[yypt-1].item
 		}
-	case 49:
+	case 67:
 		{
 			parser.yyVAL.item = []*ast.PropertyAssignment{yyS[yypt-0].item.(*ast.PropertyAssignment)}
 		}
-	case 50:
+	case 68:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.PropertyAssignment), yyS[yypt-0].item.(*ast.PropertyAssignment))
 		}
-	case 51:
+	case 69:
 		{
 			parser.yyVAL.item = &ast.PropertyAssignment{
 				PropertyAccess:  yyS[yypt-2].expr.(*ast.PropertyAccess),
 				ValueExpression: yyS[yypt-0].expr.(ast.ExprNode),
 			}
 		}
-	case 52:
+	case 70:
 		{
 			parser.yyVAL.expr = &ast.PropertyAccess{
 				VariableName: yyS[yypt-2].item.(model.CIStr),
 				PropertyName: yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 74:
+	case 95:
 		{
 			parser.yyVAL.expr = &ast.VariableReference{
 				VariableName: yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 82:
+	case 103:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].ident)
 		}
-	case 83:
+	case 104:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 84:
+	case 105:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 85:
+	case 106:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 86:
+	case 107:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 87:
+	case 108:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 88:
+	case 109:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(false)
 		}
-	case 89:
+	case 110:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(true)
 		}
-	case 90:
+	case 111:
 		{
 			d, err := datum.ParseDate(yyS[yypt-0].ident)
 			if err != nil {
@@ -2412,7 +2674,7 @@ yynewstate:
 			}
 			parser.yyVAL.expr = ast.NewValueExpr(d)
 		}
-	case 91:
+	case 112:
 		{
 			t, ttz, err := datum.ParseTimeOrTimeTZ(yyS[yypt-0].ident)
 			if err != nil {
@@ -2425,7 +2687,7 @@ yynewstate:
 				parser.yyVAL.expr = ast.NewValueExpr(ttz)
 			}
 		}
-	case 92:
+	case 113:
 		{
 			t, ttz, err := datum.ParseTimestampOrTimestampTZ(yyS[yypt-0].ident)
 			if err != nil {
@@ -2438,99 +2700,100 @@ yynewstate:
 				parser.yyVAL.expr = ast.NewValueExpr(ttz)
 			}
 		}
-	case 93:
+	case 114:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(datum.NewInterval(yyS[yypt-1].item.(int64), yyS[yypt-0].item.(datum.IntervalUnit)))
 		}
-	case 94:
+	case 115:
 		{
 			parser.yyVAL.item = datum.IntervalUnitYear
 		}
-	case 95:
+	case 116:
 		{
 			parser.yyVAL.item = datum.IntervalUnitMonth
 		}
-	case 96:
+	case 117:
 		{
 			parser.yyVAL.item = datum.IntervalUnitDay
 		}
-	case 97:
+	case 118:
 		{
 			parser.yyVAL.item = datum.IntervalUnitHour
 		}
-	case 98:
+	case 119:
 		{
 			parser.yyVAL.item = datum.IntervalUnitMinute
 		}
-	case 99:
+	case 120:
 		{
 			parser.yyVAL.item = datum.IntervalUnitSecond
 		}
-	case 100:
+	case 121:
 		{
-			parser.yyVAL.expr = &ast.BindVariable{}
+			parser.yyVAL.expr = &ast.BindVariable{Order: parser.bindVars}
+			parser.bindVars++
 		}
-	case 101:
+	case 122:
 		{
 			parser.yyVAL.expr = &ast.UnaryExpr{Op: opcode.Minus, V: yyS[yypt-0].expr}
 		}
-	case 102:
+	case 123:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Mul, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 103:
+	case 124:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Div, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 104:
+	case 125:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Mod, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 105:
+	case 126:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Plus, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 106:
+	case 127:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Minus, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 107:
+	case 128:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.EQ, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 108:
+	case 129:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.NE, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 109:
+	case 130:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.GT, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 110:
+	case 131:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.LT, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 111:
+	case 132:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.GE, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 112:
+	case 133:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.LE, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 113:
+	case 134:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.LogicOr, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 114:
+	case 135:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.LogicXor, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 115:
+	case 136:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.LogicAnd, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 116:
+	case 137:
 		{
 			v, ok := yyS[yypt-0].expr.(*ast.ExistsSubqueryExpr)
 			if ok {
@@ -2540,30 +2803,65 @@ yynewstate:
 				parser.yyVAL.expr = &ast.UnaryExpr{Op: opcode.Not, V: yyS[yypt-0].expr}
 			}
 		}
-	case 117:
+	case 138:
 		{
 			parser.yyVAL.expr = &ast.BinaryExpr{Op: opcode.Concat, L: yyS[yypt-2].expr, R: yyS[yypt-0].expr}
 		}
-	case 118:
+	case 139:
 		{
 			parser.yyVAL.expr = &ast.ParenthesesExpr{Expr: yyS[yypt-1].expr}
 		}
-	case 119:
+	case 140:
+		{
+			parser.yyVAL.expr = &ast.ListExpr{}
+		}
+	case 141:
+		{
+			parser.yyVAL.expr = &ast.ListExpr{Elems: yyS[yypt-1].item.([]ast.ExprNode)}
+		}
+	case 142:
+		{
+			parser.yyVAL.expr = &ast.MapExpr{}
+		}
+	case 143:
+		{
+			parser.yyVAL.expr = yyS[yypt-1].item.(*ast.MapExpr)
+		}
+	case 145:
+		{
+			m, entry := yyS[yypt-2].item.(*ast.MapExpr), yyS[yypt-0].item.(*ast.MapExpr)
+			m.Keys = append(m.Keys, entry.Keys...)
+			m.Values = append(m.Values, entry.Values...)
+			parser.yyVAL.item = m
+		}
+	case 146:
+		{
+			parser.yyVAL.item = &ast.MapExpr{Keys: []string{yyS[yypt-2].ident}, Values: []ast.ExprNode{yyS[yypt-0].expr}}
+		}
+	case 147:
+		{
+			parser.yyVAL.item = &ast.MapExpr{Keys: []string{yyS[yypt-2].ident}, Values: []ast.ExprNode{yyS[yypt-0].expr}}
+		}
+	case 148:
+		{
+			parser.yyVAL.expr = &ast.SubscriptExpr{Expr: yyS[yypt-3].expr, Index: yyS[yypt-1].expr}
+		}
+	case 149:
 		{
 			parser.yyVAL.expr = &ast.FuncCallExpr{
 				FnName: model.NewCIStr(yyS[yypt-3].ident),
 				Args:   yyS[yypt-1].item.([]ast.ExprNode),
 			}
 		}
-	case 136:
+	case 168:
 		{
 			parser.yyVAL.item = []ast.ExprNode{yyS[yypt-0].expr}
 		}
-	case 137:
+	case 169:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]ast.ExprNode), yyS[yypt-0].expr)
 		}
-	case 138:
+	case 170:
 		{
 			parser.yyVAL.expr = &ast.SubstrFuncExpr{
 				Expr:  yyS[yypt-4].expr,
@@ -2571,15 +2869,15 @@ yynewstate:
 				For:   yyS[yypt-1].expr,
 			}
 		}
-	case 140:
+	case 172:
 		{
 			parser.yyVAL.expr = nil
 		}
-	case 141:
+	case 173:
 		{
 			parser.yyVAL.expr = yyS[yypt-0].expr
 		}
-	case 142:
+	case 174:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F: yyS[yypt-3].ident,
@@ -2588,7 +2886,7 @@ yynewstate:
 				},
 			}
 		}
-	case 143:
+	case 175:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2596,7 +2894,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 144:
+	case 176:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2604,7 +2902,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 145:
+	case 177:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2612,7 +2910,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 146:
+	case 178:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2620,7 +2918,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 147:
+	case 179:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2628,7 +2926,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 148:
+	case 180:
 		{
 			parser.yyVAL.expr = &ast.AggregateFuncExpr{
 				F:        yyS[yypt-4].ident,
@@ -2636,7 +2934,7 @@ yynewstate:
 				Distinct: yyS[yypt-2].item.(bool),
 			}
 		}
-	case 149:
+	case 181:
 		{
 			expr := &ast.AggregateFuncExpr{
 				F:        yyS[yypt-5].ident,
@@ -2648,126 +2946,126 @@ yynewstate:
 			}
 			parser.yyVAL.expr = expr
 		}
-	case 150:
+	case 182:
 		{
 			parser.yyVAL.item = false
 		}
-	case 151:
+	case 183:
 		{
 			parser.yyVAL.item = true
 		}
-	case 152:
+	case 184:
 		{
 			parser.yyVAL.expr = nil
 		}
-	case 153:
+	case 185:
 		{
 			parser.yyVAL.expr = yyS[yypt-0].expr
 		}
-	case 154:
+	case 186:
 		{
 			parser.yyVAL.expr = &ast.ExtractFuncExpr{
 				ExtractField: yyS[yypt-3].item.(ast.ExtractField),
 				Expr:         yyS[yypt-1].expr,
 			}
 		}
-	case 155:
+	case 187:
 		{
 			parser.yyVAL.item = ast.ExtractFieldYear
 		}
-	case 156:
+	case 188:
 		{
 			parser.yyVAL.item = ast.ExtractFieldMonth
 		}
-	case 157:
+	case 189:
 		{
 			parser.yyVAL.item = ast.ExtractFieldDay
 		}
-	case 158:
+	case 190:
 		{
 			parser.yyVAL.item = ast.ExtractFieldHour
 		}
-	case 159:
+	case 191:
 		{
 			parser.yyVAL.item = ast.ExtractFieldMinute
 		}
-	case 160:
+	case 192:
 		{
 			parser.yyVAL.item = ast.ExtractFieldSecond
 		}
-	case 161:
+	case 193:
 		{
 			parser.yyVAL.item = ast.ExtractFieldTimezoneHour
 		}
-	case 162:
+	case 194:
 		{
 			parser.yyVAL.item = ast.ExtractFieldTimezoneMinute
 		}
-	case 163:
+	case 195:
 		{
 			parser.yyVAL.expr = &ast.IsNullExpr{
 				Expr: yyS[yypt-2].expr,
 			}
 		}
-	case 164:
+	case 196:
 		{
 			parser.yyVAL.expr = &ast.IsNullExpr{
 				Expr: yyS[yypt-3].expr,
 				Not:  true,
 			}
 		}
-	case 165:
+	case 197:
 		{
 			parser.yyVAL.expr = &ast.CastFuncExpr{
 				Expr:     yyS[yypt-3].expr,
 				DataType: yyS[yypt-1].item.(ast.DataType),
 			}
 		}
-	case 166:
+	case 198:
 		{
 			parser.yyVAL.item = ast.DataTypeString
 		}
-	case 167:
+	case 199:
 		{
 			parser.yyVAL.item = ast.DataTypeBoolean
 		}
-	case 168:
+	case 200:
 		{
 			parser.yyVAL.item = ast.DataTypeInteger
 		}
-	case 169:
+	case 201:
 		{
 			parser.yyVAL.item = ast.DataTypeFloat
 		}
-	case 170:
+	case 202:
 		{
 			parser.yyVAL.item = ast.DataTypeDouble
 		}
-	case 171:
+	case 203:
 		{
 			parser.yyVAL.item = ast.DataTypeDecimal
 		}
-	case 172:
+	case 204:
 		{
 			parser.yyVAL.item = ast.DataTypeDate
 		}
-	case 173:
+	case 205:
 		{
 			parser.yyVAL.item = ast.DataTypeTime
 		}
-	case 174:
+	case 206:
 		{
 			parser.yyVAL.item = ast.DataTypeTimeWithTimeZone
 		}
-	case 175:
+	case 207:
 		{
 			parser.yyVAL.item = ast.DataTypeTimestamp
 		}
-	case 176:
+	case 208:
 		{
 			parser.yyVAL.item = ast.DataTypeTimestampWithTimeZone
 		}
-	case 179:
+	case 211:
 		{
 			parser.yyVAL.expr = &ast.CaseExpr{
 				Value:       yyS[yypt-3].expr,
@@ -2775,44 +3073,51 @@ yynewstate:
 				ElseClause:  yyS[yypt-1].expr,
 			}
 		}
-	case 180:
+	case 212:
 		{
 			parser.yyVAL.expr = &ast.CaseExpr{
 				WhenClauses: yyS[yypt-2].item.([]*ast.WhenClause),
 				ElseClause:  yyS[yypt-1].expr,
 			}
 		}
-	case 181:
+	case 213:
 		{
 			parser.yyVAL.item = []*ast.WhenClause{yyS[yypt-0].item.(*ast.WhenClause)}
 		}
-	case 182:
+	case 214:
 		{
 			parser.yyVAL.item = append(yyS[yypt-1].item.([]*ast.WhenClause), yyS[yypt-0].item.(*ast.WhenClause))
 		}
-	case 183:
+	case 215:
 		{
 			parser.yyVAL.item = &ast.WhenClause{
 				Expr:   yyS[yypt-2].expr,
 				Result: yyS[yypt-0].expr,
 			}
 		}
-	case 184:
+	case 216:
 		{
 			parser.yyVAL.expr = nil
 		}
-	case 185:
+	case 217:
 		{
 			parser.yyVAL.expr = yyS[yypt-0].expr
 		}
-	case 186:
+	case 218:
 		{
 			parser.yyVAL.expr = &ast.PatternInExpr{
 				Expr: yyS[yypt-2].expr,
 				List: yyS[yypt-0].item.([]ast.ExprNode),
 			}
 		}
-	case 187:
+	case 219:
+		{
+			parser.yyVAL.expr = &ast.PatternInExpr{
+				Expr: yyS[yypt-2].expr,
+				Sel:  yyS[yypt-0].expr,
+			}
+		}
+	case 220:
 		{
 			parser.yyVAL.expr = &ast.PatternInExpr{
 				Expr: yyS[yypt-3].expr,
@@ -2820,31 +3125,43 @@ yynewstate:
 				Not:  true,
 			}
 		}
-	case 188:
+	case 221:
+		{
+			parser.yyVAL.expr = &ast.PatternInExpr{
+				Expr: yyS[yypt-3].expr,
+				Sel:  yyS[yypt-0].expr,
+				Not:  true,
+			}
+		}
+	case 222:
 		{
 			parser.yyVAL.item = yyS[yypt-1].item
 		}
-	case 189:
+	case 227:
 		{
 			parser.yyVAL.item = []ast.ExprNode{yyS[yypt-0].expr}
 		}
-	case 190:
+	case 228:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]ast.ExprNode), yyS[yypt-0].expr)
 		}
-	case 191:
+	case 229:
 		{
 			parser.yyVAL.expr = &ast.ExistsSubqueryExpr{
 				Sel: yyS[yypt-0].expr,
 			}
 		}
-	case 192:
+	case 230:
 		{
 			parser.yyVAL.expr = &ast.SubqueryExpr{
 				Query: yyS[yypt-1].statement.(*ast.SelectStmt),
 			}
 		}
-	case 195:
+	case 232:
+		{
+			parser.yyVAL.statement = &ast.RollbackStmt{}
+		}
+	case 233:
 		{
 			ss := &ast.SelectStmt{
 				Select: yyS[yypt-6].item.(*ast.SelectClause),
@@ -2870,41 +3187,41 @@ yynewstate:
 			}
 			parser.yyVAL.statement = ss
 		}
-	case 196:
+	case 234:
 		{
 			parser.yyVAL.item = &ast.SelectClause{
 				Distinct: yyS[yypt-1].item.(bool),
 				Elements: yyS[yypt-0].item.([]*ast.SelectElement),
 			}
 		}
-	case 197:
+	case 235:
 		{
 			parser.yyVAL.item = &ast.SelectClause{
 				Star: true,
 			}
 		}
-	case 198:
+	case 236:
 		{
 			parser.yyVAL.item = []*ast.SelectElement{yyS[yypt-0].item.(*ast.SelectElement)}
 		}
-	case 199:
+	case 237:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.SelectElement), yyS[yypt-0].item.(*ast.SelectElement))
 		}
-	case 200:
+	case 238:
 		{
 			parser.yyVAL.item = &ast.SelectElement{
 				ExpAsVar: yyS[yypt-0].item.(*ast.ExpAsVar),
 			}
 		}
-	case 201:
+	case 239:
 		{
 			parser.yyVAL.item = &ast.SelectElement{
 				Identifier: yyS[yypt-2].ident,
 				Prefix:     yyS[yypt-0].item.(string),
 			}
 		}
-	case 202:
+	case 240:
 		{
 			ev := &ast.ExpAsVar{
 				Expr: yyS[yypt-1].expr.(ast.ExprNode),
@@ -2914,55 +3231,55 @@ yynewstate:
 			}
 			parser.yyVAL.item = ev
 		}
-	case 203:
+	case 241:
 		{
 			parser.yyVAL.item = ""
 		}
-	case 204:
+	case 242:
 		{
 			parser.yyVAL.item = yyS[yypt-1].ident
 		}
-	case 205:
+	case 243:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 206:
+	case 244:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item.(model.CIStr)
 		}
-	case 207:
+	case 245:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 208:
+	case 246:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 209:
+	case 247:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item.(*ast.MatchClauseList)
 		}
-	case 210:
+	case 248:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 211:
+	case 249:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item.(*ast.MatchClauseList)
 		}
-	case 212:
+	case 250:
 		{
 			parser.yyVAL.item = &ast.MatchClauseList{
 				Matches: []*ast.MatchClause{yyS[yypt-0].item.(*ast.MatchClause)},
 			}
 		}
-	case 213:
+	case 251:
 		{
 			ml := yyS[yypt-2].item.(*ast.MatchClauseList)
 			ml.Matches = append(ml.Matches, yyS[yypt-0].item.(*ast.MatchClause))
 			parser.yyVAL.item = ml
 		}
-	case 214:
+	case 252:
 		{
 			mc := &ast.MatchClause{
 				Paths: yyS[yypt-2].item.([]*ast.PathPattern),
@@ -2972,112 +3289,112 @@ yynewstate:
 			}
 			parser.yyVAL.item = mc
 		}
-	case 215:
+	case 253:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item.(model.CIStr)
 		}
-	case 216:
+	case 254:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 219:
+	case 257:
 		{
 			parser.yyVAL.item = []*ast.PathPattern{yyS[yypt-0].item.(*ast.PathPattern)}
 		}
-	case 220:
+	case 258:
 		{
 			parser.yyVAL.item = yyS[yypt-1].item.([]*ast.PathPattern)
 		}
-	case 221:
+	case 259:
 		{
 			parser.yyVAL.item = []*ast.PathPattern{yyS[yypt-0].item.(*ast.PathPattern)}
 		}
-	case 222:
+	case 260:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.PathPattern), yyS[yypt-0].item.(*ast.PathPattern))
 		}
-	case 223:
+	case 261:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternSimple
 			parser.yyVAL.item = pp
 		}
-	case 224:
+	case 262:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAny
 			parser.yyVAL.item = pp
 		}
-	case 225:
+	case 263:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAnyShortest
 			parser.yyVAL.item = pp
 		}
-	case 226:
+	case 264:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAllShortest
 			parser.yyVAL.item = pp
 		}
-	case 227:
+	case 265:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternTopKShortest
 			pp.TopK = yyS[yypt-2].item.(int64)
 			parser.yyVAL.item = pp
 		}
-	case 228:
+	case 266:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAnyCheapest
 			parser.yyVAL.item = pp
 		}
-	case 229:
+	case 267:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAllCheapest
 			parser.yyVAL.item = pp
 		}
-	case 230:
+	case 268:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternTopKCheapest
 			pp.TopK = yyS[yypt-2].item.(int64)
 			parser.yyVAL.item = pp
 		}
-	case 231:
+	case 269:
 		{
 			pp := yyS[yypt-0].item.(*ast.PathPattern)
 			pp.Tp = ast.PathPatternAll
 			parser.yyVAL.item = pp
 		}
-	case 232:
+	case 270:
 		{
 			parser.yyVAL.item = &ast.PathPattern{Vertices: []*ast.VertexPattern{yyS[yypt-0].item.(*ast.VertexPattern)}}
 		}
-	case 233:
+	case 271:
 		{
 			pp := yyS[yypt-2].item.(*ast.PathPattern)
 			pp.Vertices = append(pp.Vertices, yyS[yypt-0].item.(*ast.VertexPattern))
 			pp.Connections = append(pp.Connections, yyS[yypt-1].item.(*ast.ReachabilityPathExpr))
 			parser.yyVAL.item = pp
 		}
-	case 234:
+	case 272:
 		{
 			pp := yyS[yypt-2].item.(*ast.PathPattern)
 			pp.Vertices = append(pp.Vertices, yyS[yypt-0].item.(*ast.VertexPattern))
 			pp.Connections = append(pp.Connections, yyS[yypt-1].item.(*ast.EdgePattern))
 			parser.yyVAL.item = pp
 		}
-	case 235:
+	case 273:
 		{
 			parser.yyVAL.item = &ast.PathPattern{
 				Vertices:    []*ast.VertexPattern{yyS[yypt-2].item.(*ast.VertexPattern), yyS[yypt-0].item.(*ast.VertexPattern)},
 				Connections: []ast.VertexPairConnection{yyS[yypt-1].item.(*ast.QuantifiedPathExpr)},
 			}
 		}
-	case 236:
+	case 274:
 		{
 			parser.yyVAL.item = &ast.ReachabilityPathExpr{
 				Labels:     yyS[yypt-2].item.([]model.CIStr),
@@ -3085,7 +3402,7 @@ yynewstate:
 				Quantifier: yyS[yypt-1].item.(*ast.PatternQuantifier),
 			}
 		}
-	case 237:
+	case 275:
 		{
 			parser.yyVAL.item = &ast.ReachabilityPathExpr{
 				Labels:     yyS[yypt-2].item.([]model.CIStr),
@@ -3093,7 +3410,7 @@ yynewstate:
 				Quantifier: yyS[yypt-1].item.(*ast.PatternQuantifier),
 			}
 		}
-	case 238:
+	case 276:
 		{
 			parser.yyVAL.item = &ast.ReachabilityPathExpr{
 				Labels:     yyS[yypt-2].item.([]model.CIStr),
@@ -3101,48 +3418,48 @@ yynewstate:
 				Quantifier: yyS[yypt-1].item.(*ast.PatternQuantifier),
 			}
 		}
-	case 239:
+	case 277:
 		{
 			parser.yyVAL.item = &ast.VertexPattern{Variable: yyS[yypt-1].item.(*ast.VariableSpec)}
 		}
-	case 240:
+	case 278:
 		{
 			parser.yyVAL.item = (*ast.VertexPattern)(nil)
 		}
-	case 242:
+	case 280:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{
 				Variable:  yyS[yypt-1].item.(*ast.VariableSpec),
 				Direction: ast.EdgeDirectionOutgoing,
 			}
 		}
-	case 243:
+	case 281:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{Direction: ast.EdgeDirectionOutgoing}
 		}
-	case 244:
+	case 282:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{
 				Variable:  yyS[yypt-1].item.(*ast.VariableSpec),
 				Direction: ast.EdgeDirectionIncoming,
 			}
 		}
-	case 245:
+	case 283:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{Direction: ast.EdgeDirectionIncoming}
 		}
-	case 246:
+	case 284:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{
 				Variable:  yyS[yypt-1].item.(*ast.VariableSpec),
 				Direction: ast.EdgeDirectionAnyDirected,
 			}
 		}
-	case 247:
+	case 285:
 		{
 			parser.yyVAL.item = &ast.EdgePattern{Direction: ast.EdgeDirectionAnyDirected}
 		}
-	case 248:
+	case 286:
 		{
 			v := &ast.VariableSpec{
 				Name:   yyS[yypt-1].item.(model.CIStr),
@@ -3153,54 +3470,54 @@ yynewstate:
 			}
 			parser.yyVAL.item = v
 		}
-	case 249:
+	case 287:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 250:
+	case 288:
 		{
 			parser.yyVAL.item = model.CIStr{}
 		}
-	case 252:
+	case 290:
 		{
 			parser.yyVAL.item = []model.CIStr{yyS[yypt-0].item.(model.CIStr)}
 		}
-	case 253:
+	case 291:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]model.CIStr), yyS[yypt-0].item.(model.CIStr))
 		}
-	case 254:
+	case 292:
 		{
 			parser.yyVAL.item = yyS[yypt-0].item.([]model.CIStr)
 		}
-	case 255:
+	case 293:
 		{
 			parser.yyVAL.item = []model.CIStr(nil)
 		}
-	case 259:
+	case 297:
 		{
 			parser.yyVAL.item = []model.CIStr{yyS[yypt-0].item.(model.CIStr)}
 		}
-	case 260:
+	case 298:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]model.CIStr), yyS[yypt-0].item.(model.CIStr))
 		}
-	case 261:
+	case 299:
 		{
 			parser.yyVAL.item = []model.CIStr{yyS[yypt-0].item.(model.CIStr)}
 		}
-	case 262:
+	case 300:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]model.CIStr), yyS[yypt-0].item.(model.CIStr))
 		}
-	case 263:
+	case 301:
 		{
 			parser.yyVAL.item = &ast.QuantifiedPathExpr{
 				Edge:       yyS[yypt-1].item.(*ast.EdgePattern),
 				Quantifier: yyS[yypt-0].item.(*ast.PatternQuantifier),
 			}
 		}
-	case 264:
+	case 302:
 		{
 			q := &ast.QuantifiedPathExpr{
 				Edge:        yyS[yypt-5].item.(*ast.EdgePattern),
@@ -3216,59 +3533,59 @@ yynewstate:
 			}
 			parser.yyVAL.item = q
 		}
-	case 265:
+	case 303:
 		{
 			parser.yyVAL.item = yyS[yypt-0].expr.(ast.ExprNode)
 		}
-	case 266:
+	case 304:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 268:
+	case 306:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierZeroOrMore, M: math.MaxInt64}
 		}
-	case 269:
+	case 307:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierOneOrMore, N: 1, M: math.MaxInt64}
 		}
-	case 270:
+	case 308:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierOptional, N: 0, M: 1}
 		}
-	case 271:
+	case 309:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierExactlyN, N: yyS[yypt-1].item.(int64), M: yyS[yypt-1].item.(int64)}
 		}
-	case 272:
+	case 310:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierNOrMore, N: yyS[yypt-2].item.(int64), M: math.MaxInt64}
 		}
-	case 273:
+	case 311:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierBetweenNAndM, N: yyS[yypt-3].item.(int64), M: yyS[yypt-1].item.(int64)}
 		}
-	case 274:
+	case 312:
 		{
 			parser.yyVAL.item = &ast.PatternQuantifier{Tp: ast.PatternQuantifierBetweenZeroAndM, N: 0, M: yyS[yypt-1].item.(int64)}
 		}
-	case 275:
+	case 313:
 		{
 			parser.yyVAL.item = (*ast.PatternQuantifier)(nil)
 		}
-	case 277:
+	case 315:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 279:
+	case 317:
 		{
 			parser.yyVAL.item = []*ast.PathPatternMacro{yyS[yypt-0].item.(*ast.PathPatternMacro)}
 		}
-	case 280:
+	case 318:
 		{
 			parser.yyVAL.item = append(yyS[yypt-1].item.([]*ast.PathPatternMacro), yyS[yypt-0].item.(*ast.PathPatternMacro))
 		}
-	case 281:
+	case 319:
 		{
 			p := &ast.PathPatternMacro{
 				Name: model.NewCIStr(yyS[yypt-3].ident),
@@ -3279,105 +3596,106 @@ yynewstate:
 			}
 			parser.yyVAL.item = p
 		}
-	case 282:
+	case 320:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 283:
+	case 321:
 		{
 			parser.yyVAL.item = yyS[yypt-0].expr
 		}
-	case 284:
+	case 322:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 285:
+	case 323:
 		{
 			parser.yyVAL.item = &ast.GroupByClause{Items: yyS[yypt-0].item.([]*ast.ByItem)}
 		}
-	case 286:
+	case 324:
 		{
 			parser.yyVAL.item = []*ast.ByItem{yyS[yypt-0].item.(*ast.ByItem)}
 		}
-	case 287:
+	case 325:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.ByItem), yyS[yypt-0].item.(*ast.ByItem))
 		}
-	case 288:
+	case 326:
 		{
 			parser.yyVAL.item = &ast.ByItem{
 				Expr:      yyS[yypt-0].item.(*ast.ExpAsVar),
 				NullOrder: true,
 			}
 		}
-	case 289:
+	case 327:
 		{
 			parser.yyVAL.item = &ast.ByItem{
 				Expr: yyS[yypt-1].item.(*ast.ExpAsVar),
 				Desc: yyS[yypt-0].item.(bool),
 			}
 		}
-	case 290:
+	case 328:
 		{
 			parser.yyVAL.item = false
 		}
-	case 291:
+	case 329:
 		{
 			parser.yyVAL.item = true
 		}
-	case 292:
+	case 330:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 293:
+	case 331:
 		{
 			parser.yyVAL.item = &ast.HavingClause{
 				Expr: yyS[yypt-0].expr,
 			}
 		}
-	case 294:
+	case 332:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 295:
+	case 333:
 		{
 			parser.yyVAL.item = &ast.OrderByClause{
 				Items: yyS[yypt-0].item.([]*ast.ByItem),
 			}
 		}
-	case 296:
+	case 334:
 		{
 			parser.yyVAL.item = nil
 		}
-	case 297:
+	case 335:
 		{
 			parser.yyVAL.item = &ast.LimitClause{
 				Count: yyS[yypt-0].expr,
 			}
 		}
-	case 298:
+	case 336:
 		{
 			parser.yyVAL.item = &ast.LimitClause{
 				Count:  yyS[yypt-0].expr.(ast.ExprNode),
 				Offset: yyS[yypt-2].expr.(ast.ExprNode),
 			}
 		}
-	case 299:
+	case 337:
 		{
 			parser.yyVAL.item = &ast.LimitClause{
 				Count:  yyS[yypt-2].expr.(ast.ExprNode),
 				Offset: yyS[yypt-0].expr.(ast.ExprNode),
 			}
 		}
-	case 301:
+	case 339:
 		{
-			parser.yyVAL.expr = &ast.BindVariable{}
+			parser.yyVAL.expr = &ast.BindVariable{Order: parser.bindVars}
+			parser.bindVars++
 		}
-	case 302:
+	case 340:
 		{
 			parser.yyVAL.expr = ast.NewValueExpr(yyS[yypt-0].item)
 		}
-	case 303:
+	case 341:
 		{
 			us := &ast.UpdateStmt{
 				Updates: yyS[yypt-6].item.([]*ast.GraphElementUpdate),
@@ -3403,83 +3721,96 @@ yynewstate:
 			}
 			parser.yyVAL.statement = us
 		}
-	case 304:
+	case 342:
 		{
 			parser.yyVAL.item = []*ast.GraphElementUpdate{yyS[yypt-0].item.(*ast.GraphElementUpdate)}
 		}
-	case 305:
+	case 343:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]*ast.GraphElementUpdate), yyS[yypt-0].item.(*ast.GraphElementUpdate))
 		}
-	case 306:
+	case 344:
 		{
 			parser.yyVAL.item = &ast.GraphElementUpdate{
 				VariableName: yyS[yypt-4].item.(model.CIStr),
 				Assignments:  yyS[yypt-1].item.([]*ast.PropertyAssignment),
 			}
 		}
-	case 307:
+	case 345:
 		{
 			parser.yyVAL.statement = &ast.UseStmt{
 				GraphName: yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 308:
+	case 346:
 		{
 			parser.yyVAL.statement = &ast.ShowStmt{
 				Tp: ast.ShowTargetGraphs,
 			}
 		}
-	case 309:
+	case 347:
 		{
 			parser.yyVAL.statement = &ast.ShowStmt{
 				Tp: ast.ShowTargetLabels,
 			}
 		}
-	case 310:
+	case 348:
 		{
 			parser.yyVAL.statement = &ast.ShowStmt{
 				Tp:        ast.ShowTargetLabels,
 				GraphName: yyS[yypt-0].item.(model.CIStr),
 			}
 		}
-	case 311:
+	case 349:
+		{
+			parser.yyVAL.statement = &ast.ShowStmt{
+				Tp: ast.ShowTargetStats,
+			}
+		}
+	case 350:
+		{
+			parser.yyVAL.statement = &ast.ShowStmt{
+				Tp:        ast.ShowTargetStats,
+				GraphName: yyS[yypt-0].item.(model.CIStr),
+			}
+		}
+	case 351:
 		{
 			parser.yyVAL.item = false
 		}
-	case 312:
+	case 352:
 		{
 			parser.yyVAL.item = true
 		}
-	case 313:
+	case 353:
 		{
 			parser.yyVAL.item = false
 		}
-	case 314:
+	case 354:
 		{
 			parser.yyVAL.item = true
 		}
-	case 315:
+	case 355:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 316:
+	case 356:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 317:
+	case 357:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 318:
+	case 358:
 		{
 			parser.yyVAL.item = model.NewCIStr(yyS[yypt-0].ident)
 		}
-	case 363:
+	case 406:
 		{
 			parser.yyVAL.item = []model.CIStr{yyS[yypt-0].item.(model.CIStr)}
 		}
-	case 364:
+	case 407:
 		{
 			parser.yyVAL.item = append(yyS[yypt-2].item.([]model.CIStr), yyS[yypt-0].item.(model.CIStr))
 		}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/simbiont-runtime/graphengine/parser"
+	"github.com/simbiont-runtime/graphengine/parser/ast"
 	"github.com/simbiont-runtime/graphengine/parser/format"
 	"github.com/stretchr/testify/require"
 )
@@ -287,6 +288,14 @@ WHERE generatorA.name = 'AEH382'`, true, "PATH `macro1` AS (`v1`:`Generator`) -[
 SELECT COUNT(*)
 FROM MATCH (generatorA) -/:macro1+/-> (generatorB)
 WHERE generatorA.name = 'AEH382'`, true, "PATH `macro1` AS (`v2`:`Connector`) <-[`e1`:`has_connector`]- (`v1`:`Generator`) SELECT COUNT(1) FROM MATCH (`generatorA`) -/:`macro1`+/-> (`generatorB`) WHERE `generatorA`.`name`='AEH382'"},
+		{"EXPLAIN SELECT n.name FROM MATCH (n:Person)", true, "EXPLAIN SELECT `n`.`name` FROM MATCH (`n`:`Person`)"},
+		{"EXPLAIN ANALYZE SELECT n.name FROM MATCH (n:Person)", true, "EXPLAIN ANALYZE SELECT `n`.`name` FROM MATCH (`n`:`Person`)"},
+		{"SELECT analyze.name FROM MATCH (analyze)", true, "SELECT `analyze`.`name` FROM MATCH (`analyze`)"},
+		{"SELECT SUBSTRING(n.name FROM 2 FOR 3), SUBSTRING(n.name FROM 2) FROM MATCH (n)", true, "SELECT SUBSTRING(`n`.`name` FROM 2 FOR 3),SUBSTRING(`n`.`name` FROM 2) FROM MATCH (`n`)"},
+		{"SELECT EXTRACT(YEAR FROM n.dob), EXTRACT(TIMEZONE_HOUR FROM n.t) FROM MATCH (n)", true, "SELECT EXTRACT(YEAR FROM `n`.`dob`),EXTRACT(TIMEZONE_HOUR FROM `n`.`t`) FROM MATCH (`n`)"},
+		{"SELECT MOD(n.age, 2), my_func(n.name) FROM MATCH (n)", true, "SELECT MOD(`n`.`age`, 2),MY_FUNC(`n`.`name`) FROM MATCH (`n`)"},
+		{"SELECT n.tags[0], n.attrs['color'], -n.nums[1 + 1] FROM MATCH (n)", true, "SELECT `n`.`tags`[0],`n`.`attrs`['color'],-`n`.`nums`[1+1] FROM MATCH (`n`)"},
+		{"SELECT n FROM MATCH (n) WHERE 'red' IN n.tags AND n.age NOT IN [1, 2] AND n.x IN ?", true, "SELECT `n` FROM MATCH (`n`) WHERE 'red' IN `n`.`tags` AND `n`.`age` NOT IN [1,2] AND `n`.`x` IN ?"},
 	}
 	RunTest(t, table)
 }
@@ -305,6 +314,9 @@ func TestLiteral(t *testing.T) {
 		{"SELECT INTERVAL 3 DAY FROM MATCH ()", true, "SELECT INTERVAL 3 DAY FROM MATCH ()"},
 		{"SELECT INTERVAL 4 HOUR FROM MATCH ()", true, "SELECT INTERVAL 4 HOUR FROM MATCH ()"},
 		{"SELECT INTERVAL 5 MINUTE FROM MATCH ()", true, "SELECT INTERVAL 5 MINUTE FROM MATCH ()"},
+		{"SELECT [], [1, 'a', [2]] FROM MATCH ()", true, "SELECT [],[1,'a',[2]] FROM MATCH ()"},
+		{"SELECT {}, {'color': 'red', size: 1 + 2} FROM MATCH ()", true, "SELECT {},{'color':'red','size':1+2} FROM MATCH ()"},
+		{"SELECT {'a': 1 FROM MATCH ()", false, ""},
 	}
 	RunTest(t, table)
 }
@@ -321,11 +333,51 @@ func TestDDL(t *testing.T) {
 			true,
 			"DROP GRAPH `g1`",
 		},
+		{
+			"CREATE GRAPH g1 strict",
+			true,
+			"CREATE GRAPH `g1` STRICT",
+		},
+		{
+			"CREATE GRAPH strict",
+			true,
+			"CREATE GRAPH `strict`",
+		},
 		{
 			`CREATE LABEL l`,
 			true,
 			"CREATE LABEL `l`",
 		},
+		{
+			`CREATE LABEL Person (name STRING NOT NULL, age INTEGER DEFAULT 0)`,
+			true,
+			"CREATE LABEL `Person` (`name` STRING NOT NULL, `age` INTEGER DEFAULT 0)",
+		},
+		{
+			`CREATE LABEL IF NOT EXISTS l (a DOUBLE DEFAULT -1.5 NOT NULL, b TIMESTAMP WITH TIME ZONE, c DATE DEFAULT DATE '2000-01-01', d BOOLEAN DEFAULT true)`,
+			true,
+			"CREATE LABEL IF NOT EXISTS `l` (`a` DOUBLE NOT NULL DEFAULT -1.5, `b` TIMESTAMP WITH TIME ZONE, `c` DATE DEFAULT DATE '2000-01-01', `d` BOOLEAN DEFAULT TRUE)",
+		},
+		{
+			`CREATE LABEL l ()`,
+			false,
+			"",
+		},
+		{
+			`CREATE LABEL l (a INTEGER DEFAULT 1 + 1)`,
+			false,
+			"",
+		},
+		{
+			`CREATE INDEX idx (name, age)`,
+			true,
+			"CREATE INDEX `idx` (`name`, `age`)",
+		},
+		{
+			`CREATE UNIQUE INDEX IF NOT EXISTS idx ON Person (email)`,
+			true,
+			"CREATE UNIQUE INDEX IF NOT EXISTS `idx` ON `Person` (`email`)",
+		},
 		{
 			`Drop LABEL l`,
 			true,
@@ -346,6 +398,31 @@ func TestDDL(t *testing.T) {
 			true,
 			"SHOW LABELS IN `g1`",
 		},
+		{
+			`show stats`,
+			true,
+			"SHOW STATS",
+		},
+		{
+			`show stats in g1`,
+			true,
+			"SHOW STATS IN `g1`",
+		},
+		{
+			`analyze graph`,
+			true,
+			"ANALYZE GRAPH",
+		},
+		{
+			`analyze graph g1`,
+			true,
+			"ANALYZE GRAPH `g1`",
+		},
+		{
+			`analyze graph graph`,
+			true,
+			"ANALYZE GRAPH `graph`",
+		},
 	}
 	RunTest(t, table)
 }
@@ -540,3 +617,32 @@ func RunRestoreTest(t *testing.T, sourceSQLs, expectSQLs string) {
 	}
 	require.Equalf(t, expectSQLs, restoreSQLs, "restore %v; expect %v", restoreSQLs, expectSQLs)
 }
+
+func TestBindVariable(t *testing.T) {
+	p := parser.New()
+	stmts, _, err := p.Parse("SELECT ? FROM MATCH (x) WHERE x.name = ? LIMIT ?; INSERT VERTEX x PROPERTIES (x.name = ?)")
+	require.NoError(t, err)
+	require.Len(t, stmts, 2)
+
+	// The orders start from 0 in each statement.
+	for i, expected := range [][]int{{0, 1, 2}, {0}} {
+		collector := &bindVariableCollector{}
+		stmts[i].Accept(collector)
+		require.Equal(t, expected, collector.orders)
+	}
+}
+
+type bindVariableCollector struct {
+	orders []int
+}
+
+func (c *bindVariableCollector) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	if v, ok := n.(*ast.BindVariable); ok {
+		c.orders = append(c.orders, v.Order)
+	}
+	return n, false
+}
+
+func (c *bindVariableCollector) Leave(n ast.Node) (node ast.Node, ok bool) {
+	return n, true
+}
@@ -135,6 +135,7 @@ var tokenMap = map[string]int{
 	"ABS":              abs,
 	"ALL":              all,
 	"ALL_DIFFERENT":    allDifferent,
+	"ANALYZE":          analyze,
 	"AND":              and,
 	"ANY":              any,
 	"ARRAY_AGG":        arrayAgg,
@@ -176,6 +177,7 @@ var tokenMap = map[string]int{
 	"FALSE":            falseKwd,
 	"FLOAT":            floatType,
 	"FLOOR":            floor,
+	"FOR":              forkKwd,
 	"FROM":             from,
 	"GRAPH":            graph,
 	"GRAPHS":           graphs,
@@ -221,6 +223,8 @@ var tokenMap = map[string]int{
 	"SET":              set,
 	"SHORTEST":         shortest,
 	"SHOW":             show,
+	"STATS":            stats,
+	"STRICT":           strict,
 	"STRING":           stringKwd,
 	"SUBSTR":           substring,
 	"SUBSTRING":        substring,
@@ -228,6 +232,8 @@ var tokenMap = map[string]int{
 	"THEN":             then,
 	"TIME":             timeType,
 	"TIMESTAMP":        timestampType,
+	"TIMEZONE_HOUR":    timezoneHour,
+	"TIMEZONE_MINUTE":  timezoneMinute,
 	"TOP":              top,
 	"TRUE":             trueKwd,
 	"UNIQUE":           unique,
@@ -240,6 +246,7 @@ var tokenMap = map[string]int{
 	"WITH":             with,
 	"XOR":              xor,
 	"YEAR":             yearType,
+	"ZONE":             zone,
 }
 
 var btFuncTokenMap = map[string]int{}
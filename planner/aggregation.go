@@ -0,0 +1,41 @@
+// ---
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/expression"
+)
+
+// LogicalAggregation represents the GROUP BY clause and aggregate functions. The
+// output columns are the results of AggFuncs followed by the values of GroupByItems.
+type LogicalAggregation struct {
+	baseLogicalPlan
+
+	AggFuncs     []*expression.AggregateExpr
+	GroupByItems []expression.Expression
+}
+
+type PhysicalHashAgg struct {
+	basePhysicalPlan
+
+	AggFuncs     []*expression.AggregateExpr
+	GroupByItems []expression.Expression
+}
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalHashAgg) ExplainInfo() string {
+	var parts []string
+	if len(p.GroupByItems) > 0 {
+		parts = append(parts, "group by:"+explainExprs(p.GroupByItems))
+	}
+	if len(p.AggFuncs) > 0 {
+		funcs := make([]string, 0, len(p.AggFuncs))
+		for _, f := range p.AggFuncs {
+			funcs = append(funcs, f.String())
+		}
+		parts = append(parts, "funcs:"+strings.Join(funcs, ", "))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,90 @@
+// ---
+
+package planner
+
+import (
+	"strings"
+)
+
+// ApplyType is the type of subquery evaluated by apply.
+type ApplyType int
+
+const (
+	// ApplyTypeExists evaluates an EXISTS subquery to a boolean.
+	ApplyTypeExists ApplyType = iota
+	// ApplyTypeScalar evaluates a scalar subquery to the first column of its only
+	// row, or NULL if the subquery returns no rows.
+	ApplyTypeScalar
+)
+
+// String implements the fmt.Stringer interface.
+func (t ApplyType) String() string {
+	if t == ApplyTypeExists {
+		return "exists"
+	}
+	return "scalar"
+}
+
+// LogicalApply evaluates the subquery (the second child) for each row of the
+// outer plan (the first child). The output columns are the outer columns followed
+// by the hidden column of the subquery result.
+type LogicalApply struct {
+	baseLogicalPlan
+
+	Type ApplyType
+	// Not is true if the subquery is NOT EXISTS.
+	Not bool
+	// Correlated indicates the subquery references the outer columns other than
+	// binding the vertices of its MATCH to the outer ones, so it can't be joined
+	// with the outer plan and must be evaluated for each outer row.
+	Correlated bool
+}
+
+// PhysicalApply is the physical plan of LogicalApply, whose subquery is executed
+// for each outer row with the outer row pushed to the statement context.
+type PhysicalApply struct {
+	basePhysicalPlan
+
+	Type ApplyType
+	Not  bool
+}
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalApply) ExplainInfo() string {
+	if p.Not {
+		return "not " + p.Type.String()
+	}
+	return p.Type.String()
+}
+
+// PhysicalHashSemiJoin is the decorrelated EXISTS subquery whose MATCH binds the
+// vertices of the outer MATCH. The subquery (the second child) is executed once,
+// and the outer rows whose bound vertices are (or are not, for anti semi join)
+// found in the subquery results are returned. The output columns are the same
+// as the apply it replaces, and the subquery result column is always true.
+type PhysicalHashSemiJoin struct {
+	basePhysicalPlan
+
+	Anti bool
+	// OuterKeys and InnerKeys are the indexes of the bound vertex columns in the
+	// outer and inner results.
+	OuterKeys []int
+	InnerKeys []int
+}
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalHashSemiJoin) ExplainInfo() string {
+	keys := make([]string, 0, len(p.OuterKeys))
+	outerCols, innerCols := p.Children()[0].Columns(), p.Children()[1].Columns()
+	for i := range p.OuterKeys {
+		keys = append(keys, "eq("+outerCols[p.OuterKeys[i]].Name.O+", "+innerCols[p.InnerKeys[i]].Name.O+")")
+	}
+	info := "semi join"
+	if p.Anti {
+		info = "anti semi join"
+	}
+	if len(keys) > 0 {
+		info += ", " + strings.Join(keys, ", ")
+	}
+	return info
+}
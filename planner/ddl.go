@@ -2,11 +2,17 @@
 
 package planner
 
-import "github.com/simbiont-runtime/graphengine/parser/ast"
+import (
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
 
 // DDL represents the physical plan of DDL statement.
 type DDL struct {
 	basePlan
 
 	Statement ast.DDLNode
+	// LabelProperties is the resolved schema of the label to be created by the
+	// CREATE LABEL statement.
+	LabelProperties []*model.LabelPropertyInfo
 }
@@ -0,0 +1,28 @@
+// ---
+
+package planner
+
+import (
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
+
+// Delete represents the plan of DELETE statement.
+type Delete struct {
+	basePlan
+
+	Graph     *catalog.Graph
+	Deletes   []*ElementDelete
+	MatchPlan Plan
+}
+
+// ElementDelete represents a graph element deletion.
+//
+// DELETE x, e FROM MATCH (x) -[e]-> ()
+// -------^--^-------------------------
+// VariableName is the name of the deleted vertex/edge variable and VariableIndex
+// is the column index of the variable in the output row of MatchPlan.
+type ElementDelete struct {
+	VariableName  model.CIStr
+	VariableIndex int
+}
@@ -0,0 +1,381 @@
+// ---
+
+package planner
+
+import (
+	"math"
+
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+// The pseudo statistics which are used to estimate the number of rows if the
+// graph has not been analyzed.
+const (
+	pseudoVertexCount      = 10000
+	pseudoLabelSelectivity = 0.1
+	pseudoIndexLookupRows  = 10
+	pseudoDegree           = 10
+	pseudoSelectivity      = 0.8
+)
+
+// EstimateRows estimates the number of rows produced by the physical plan.
+func EstimateRows(plan PhysicalPlan) float64 {
+	var childRows float64 = 1
+	if len(plan.Children()) > 0 {
+		childRows = EstimateRows(plan.Children()[0])
+	}
+	switch p := plan.(type) {
+	case *PhysicalMatch:
+		return estimateMatchRows(p)
+	case *PhysicalSelection, *PhysicalHashSemiJoin:
+		return childRows * pseudoSelectivity
+	case *PhysicalHashAgg:
+		if len(p.GroupByItems) == 0 {
+			return 1
+		}
+		return math.Max(1, childRows*pseudoSelectivity)
+	case *PhysicalLimit:
+		return estimateLimitRows(childRows, p.Offset, p.Count)
+	case *PhysicalTopN:
+		return estimateLimitRows(childRows, p.Offset, p.Count)
+	default:
+		return childRows
+	}
+}
+
+// estimateMatchRows estimates the number of matched subgraphs by following the
+// expansion plan. Each connected component starts from a vertex, and every
+// connection multiplies the rows by the average degree and the selectivity of
+// the vertex it reaches. The connection which closes a cycle is instead treated
+// as a filter on the matched vertices, and so are the filters of the steps.
+func estimateMatchRows(p *PhysicalMatch) float64 {
+	est := newMatchEstimator(p)
+	visited := make(map[string]struct{}, len(p.Subgraph.Vertices)+len(p.Subgraph.Connections))
+	for name := range p.Bindings {
+		visited[name] = struct{}{}
+	}
+	rows := 1.0
+	for _, step := range p.Order {
+		rows *= est.stepFactor(step, visited)
+		for _, filter := range step.Filters {
+			rows *= est.filterSelectivity(step, filter)
+		}
+		step.visit(visited)
+	}
+	return rows
+}
+
+// matchEstimator estimates the rows of match steps with the statistics of the
+// graph, and the pseudo statistics are used if the graph has not been analyzed.
+type matchEstimator struct {
+	subgraph *Subgraph
+	lookups  map[string]*IndexLookup
+	graph    *catalog.Graph
+	stats    *model.GraphStats
+}
+
+func newMatchEstimator(p *PhysicalMatch) *matchEstimator {
+	est := &matchEstimator{
+		subgraph: p.Subgraph,
+		lookups:  p.IndexLookups,
+		graph:    p.Graph,
+	}
+	if p.Graph != nil {
+		est.stats = p.Graph.Stats()
+	}
+	return est
+}
+
+// stepFactor estimates the ratio of rows after the match step, given the
+// variables which have been visited before the step.
+func (e *matchEstimator) stepFactor(step *MatchStep, visited map[string]struct{}) float64 {
+	if step.Vertex != nil {
+		return e.vertexRows(step.Vertex)
+	}
+	src := e.subgraph.Vertices[step.Conn.SrcVarName().L]
+	dst := e.subgraph.Vertices[step.Conn.DstVarName().L]
+	_, srcVisited := visited[src.Name.L]
+	_, dstVisited := visited[dst.Name.L]
+	if e.stats == nil {
+		switch {
+		case srcVisited && dstVisited:
+			return float64(pseudoDegree) / pseudoVertexCount
+		case srcVisited:
+			return estimateExpandFactor(dst)
+		default:
+			return estimateExpandFactor(src)
+		}
+	}
+
+	// The connections are estimated by the number of edges between the labels
+	// of the end vertices, which are divided by the vertices have been matched.
+	edges := e.edgeRows(step.Conn, src, dst)
+	switch {
+	case srcVisited && dstVisited:
+		return divide(edges, e.labelRows(src)*e.labelRows(dst))
+	case srcVisited:
+		return divide(edges, e.labelRows(src))
+	default:
+		return divide(edges, e.labelRows(dst))
+	}
+}
+
+// vertexRows estimates the number of vertices which match the vertex pattern,
+// which are looked up by the index if there is an index lookup of the vertex.
+func (e *matchEstimator) vertexRows(v *Vertex) float64 {
+	lookup := e.lookups[v.Name.L]
+	if e.stats == nil {
+		return estimateVertexRows(v, lookup)
+	}
+	rows := e.labelRows(v)
+	if lookup != nil {
+		for i, name := range lookup.Index.Meta().Properties {
+			rows *= e.equalSelectivity(e.vertexProperty(name.L), e.stats.VertexCount, lookup.Values[i])
+		}
+	}
+	return rows
+}
+
+// labelRows returns the number of vertices which have any label of the vertex
+// pattern.
+func (e *matchEstimator) labelRows(v *Vertex) float64 {
+	if len(v.Labels) == 0 {
+		return float64(e.stats.VertexCount)
+	}
+	var rows int64
+	for _, label := range v.Labels {
+		if l := e.stats.Label(label.Meta().ID); l != nil {
+			rows += l.VertexCount
+		}
+	}
+	return math.Min(float64(rows), float64(e.stats.VertexCount))
+}
+
+// edgeRows returns the number of edges which match the connection between the
+// vertex patterns.
+func (e *matchEstimator) edgeRows(conn VertexPairConnection, src, dst *Vertex) float64 {
+	rows := e.labelPairRows(src, dst)
+	if conn.AnyDirected() {
+		rows += e.labelPairRows(dst, src)
+	}
+	if edge, ok := conn.(*Edge); ok && len(edge.Labels) > 0 {
+		var labeled int64
+		for _, label := range edge.Labels {
+			if l := e.stats.Label(label.Meta().ID); l != nil {
+				labeled += l.EdgeCount
+			}
+		}
+		rows *= math.Min(1, divide(float64(labeled), float64(e.stats.EdgeCount)))
+	}
+	return rows
+}
+
+func (e *matchEstimator) labelPairRows(src, dst *Vertex) float64 {
+	var rows int64
+	for _, srcID := range labelIDs(src) {
+		for _, dstID := range labelIDs(dst) {
+			rows += e.stats.LabelPair(srcID, dstID)
+		}
+	}
+	return float64(rows)
+}
+
+// labelIDs returns the label IDs of the vertex pattern, or the label ID 0 which
+// matches any vertex in the statistics.
+func labelIDs(v *Vertex) []int64 {
+	if len(v.Labels) == 0 {
+		return []int64{0}
+	}
+	ids := make([]int64, 0, len(v.Labels))
+	for _, label := range v.Labels {
+		ids = append(ids, label.Meta().ID)
+	}
+	return ids
+}
+
+// filterSelectivity estimates the selectivity of the filter of the step. The
+// comparisons between properties and constants are estimated by the statistics
+// of properties, and the others are estimated by the pseudo selectivity.
+func (e *matchEstimator) filterSelectivity(step *MatchStep, filter expression.Expression) float64 {
+	if e.stats == nil {
+		return pseudoSelectivity
+	}
+	// The equality conditions of the index lookup have been estimated with the
+	// vertices.
+	if step.Vertex != nil && e.lookups[step.Vertex.Name.L] != nil {
+		if varName, propName, _, ok := extractPropertyEqual(filter); ok && varName == step.Vertex.Name.L {
+			for _, name := range e.lookups[varName].Index.Meta().Properties {
+				if name.L == propName {
+					return 1
+				}
+			}
+		}
+	}
+
+	binary, ok := filter.(*expression.BinaryExpr)
+	if !ok {
+		return pseudoSelectivity
+	}
+	op := binary.Op
+	prop, ok := binary.Left.(*expression.PropertyAccess)
+	value, isConst := binary.Right.(*expression.Constant)
+	if !ok {
+		prop, ok = binary.Right.(*expression.PropertyAccess)
+		value, isConst = binary.Left.(*expression.Constant)
+		op = reverseComparison(op)
+	}
+	if !ok || !isConst {
+		return pseudoSelectivity
+	}
+	var (
+		stats *model.PropertyStats
+		total int64
+	)
+	if _, ok := e.subgraph.Vertices[prop.VariableName.L]; ok {
+		stats, total = e.vertexProperty(prop.PropertyName.L), e.stats.VertexCount
+	} else {
+		stats, total = e.edgeProperty(prop.PropertyName.L), e.stats.EdgeCount
+	}
+
+	switch op {
+	case opcode.EQ:
+		return e.equalSelectivity(stats, total, value)
+	case opcode.NE:
+		return 1 - e.equalSelectivity(stats, total, value)
+	case opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+		v, ok := numericValue(value.Value)
+		if stats == nil || stats.Histogram == nil || !ok {
+			return pseudoSelectivity
+		}
+		less := stats.Histogram.LessCount(v)
+		if op == opcode.LE || op == opcode.GT {
+			less += stats.Histogram.EqualCount(v)
+		}
+		if op == opcode.GT || op == opcode.GE {
+			less = float64(stats.Histogram.Count()) - less
+		}
+		return math.Max(0, divide(less, float64(total)))
+	default:
+		return pseudoSelectivity
+	}
+}
+
+// equalSelectivity estimates the selectivity of the property equals to the
+// value, which may not be a constant (e.g. a bind variable).
+func (e *matchEstimator) equalSelectivity(stats *model.PropertyStats, total int64, value expression.Expression) float64 {
+	if stats == nil {
+		return float64(pseudoIndexLookupRows) / pseudoVertexCount
+	}
+	if c, ok := value.(*expression.Constant); ok && stats.Histogram != nil {
+		if v, ok := numericValue(c.Value); ok {
+			return divide(stats.Histogram.EqualCount(v), float64(total))
+		}
+	}
+	return divide(divide(float64(stats.Count), float64(stats.NDV)), float64(total))
+}
+
+// vertexProperty returns the statistics of the vertex property, or nil if the
+// property doesn't exist or has not been analyzed.
+func (e *matchEstimator) vertexProperty(name string) *model.PropertyStats {
+	if prop := e.graph.Property(name); prop != nil {
+		return e.stats.VertexProperty(prop.ID)
+	}
+	return nil
+}
+
+// edgeProperty returns the statistics of the edge property, or nil if the
+// property doesn't exist or has not been analyzed.
+func (e *matchEstimator) edgeProperty(name string) *model.PropertyStats {
+	if prop := e.graph.Property(name); prop != nil {
+		return e.stats.EdgeProperty(prop.ID)
+	}
+	return nil
+}
+
+// reverseComparison returns the operator with the operands swapped, e.g. `1 < x`
+// is the same as `x > 1`.
+func reverseComparison(op opcode.Op) opcode.Op {
+	switch op {
+	case opcode.LT:
+		return opcode.GT
+	case opcode.LE:
+		return opcode.GE
+	case opcode.GT:
+		return opcode.LT
+	case opcode.GE:
+		return opcode.LE
+	default:
+		return op
+	}
+}
+
+// numericValue returns the value of the integer or float datum.
+func numericValue(d datum.Datum) (float64, bool) {
+	switch d.Type() {
+	case types.Int:
+		return float64(datum.AsInt(d)), true
+	case types.Float:
+		return datum.AsFloat(d), true
+	default:
+		return 0, false
+	}
+}
+
+// divide returns 0 if the divisor is 0, which happens if the graph is empty.
+func divide(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+// estimateVertexRows estimates the number of vertices which match the vertex
+// pattern by the pseudo statistics, which are looked up by the index if it is
+// not nil.
+func estimateVertexRows(v *Vertex, lookup *IndexLookup) float64 {
+	switch {
+	case lookup != nil:
+		return pseudoIndexLookupRows
+	case len(v.Labels) > 0:
+		return pseudoVertexCount * pseudoLabelSelectivity
+	default:
+		return pseudoVertexCount
+	}
+}
+
+// estimateExpandFactor estimates the ratio of rows after expanding a connection
+// to the end vertex by the pseudo statistics, which is the average degree
+// filtered by the labels of the end vertex.
+func estimateExpandFactor(end *Vertex) float64 {
+	if len(end.Labels) > 0 {
+		return pseudoDegree * pseudoLabelSelectivity
+	}
+	return pseudoDegree
+}
+
+func estimateLimitRows(childRows float64, offset, count expression.Expression) float64 {
+	rows := childRows - constantInt(offset, 0)
+	if n := constantInt(count, -1); n >= 0 {
+		rows = math.Min(rows, n)
+	}
+	return math.Max(0, rows)
+}
+
+// constantInt returns the value of expr if it is an integer constant, otherwise
+// the default value.
+func constantInt(expr expression.Expression, defaultValue float64) float64 {
+	c, ok := expr.(*expression.Constant)
+	if !ok {
+		return defaultValue
+	}
+	v, err := datum.TryAsInt(c.Value)
+	if err != nil {
+		return defaultValue
+	}
+	return float64(v)
+}
@@ -0,0 +1,61 @@
+// ---
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/types"
+)
+
+// Explain represents the plan of EXPLAIN statement. The target plan is executed
+// to collect the runtime statistics if Analyze is true.
+type Explain struct {
+	basePlan
+
+	TargetPlan PhysicalPlan
+	Analyze    bool
+}
+
+var (
+	explainColumns = ResultColumns{
+		{Name: model.NewCIStr("id"), Type: types.String},
+		{Name: model.NewCIStr("info"), Type: types.String},
+		{Name: model.NewCIStr("estRows"), Type: types.String},
+	}
+	explainAnalyzeColumns = append(explainColumns[:len(explainColumns):len(explainColumns)], ResultColumns{
+		{Name: model.NewCIStr("actRows"), Type: types.Int},
+		{Name: model.NewCIStr("time"), Type: types.String},
+		{Name: model.NewCIStr("execInfo"), Type: types.String},
+	}...)
+)
+
+func explainExprs(exprs []expression.Expression) string {
+	strs := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		strs = append(strs, expr.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+func explainByItems(byItems []*ByItem) string {
+	strs := make([]string, 0, len(byItems))
+	for _, item := range byItems {
+		if item.Desc {
+			strs = append(strs, item.Expr.String()+":desc")
+		} else {
+			strs = append(strs, item.Expr.String())
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
+func explainOffsetCount(offset, count expression.Expression) string {
+	offsetStr := "0"
+	if offset != nil {
+		offsetStr = offset.String()
+	}
+	return "offset:" + offsetStr + ", count:" + count.String()
+}
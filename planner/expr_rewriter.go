@@ -3,22 +3,42 @@
 package planner
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/expression"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/parser/format"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/types"
+	"golang.org/x/exp/slices"
 )
 
 type exprRewriter struct {
+	// b builds the subqueries and resolves the columns of outer queries, and the
+	// subqueries are not supported if it is nil.
+	b        *Builder
 	p        LogicalPlan
 	ctxStack []expression.Expression
 	err      error
+
+	// aggregated indicates the plan is (or is on top of) an aggregation. An
+	// expression which has the same text as an output column refers to the
+	// column, e.g: the aggregate functions and GROUP BY expressions.
+	aggregated bool
+	// groupBy maps the GROUP BY expressions to the output columns of aggregation,
+	// which is keyed by the lowercase text of expressions without the outer
+	// parentheses.
+	groupBy map[string]int
+	// columnRef is the expression resolved as a column reference in Enter.
+	columnRef ast.Node
 }
 
 func RewriteExpr(expr ast.ExprNode, p LogicalPlan) (expression.Expression, error) {
-	rewriter := &exprRewriter{
-		p: p,
-	}
+	rewriter := &exprRewriter{p: p}
 	expr.Accept(rewriter)
 	if rewriter.err != nil {
 		return nil, rewriter.err
@@ -26,13 +46,73 @@ func RewriteExpr(expr ast.ExprNode, p LogicalPlan) (expression.Expression, error
 	return rewriter.ctxStack[0], nil
 }
 
+// rewriteExpr rewrites the expression over the plan. The subqueries in the
+// expression are built as the applies on top of the plan, so the plan with the
+// applies is returned and the expression must be evaluated over it.
+func (b *Builder) rewriteExpr(expr ast.ExprNode, p LogicalPlan, aggregated bool, groupBy map[string]int) (expression.Expression, LogicalPlan, error) {
+	rewriter := &exprRewriter{
+		b:          b,
+		p:          p,
+		aggregated: aggregated,
+		groupBy:    groupBy,
+	}
+	expr.Accept(rewriter)
+	if rewriter.err != nil {
+		return nil, nil, rewriter.err
+	}
+	return rewriter.ctxStack[0], rewriter.p, nil
+}
+
 // Enter implements the ast.Visitor interface.
 func (er *exprRewriter) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
 	switch expr := n.(type) {
+	case *ast.ExistsSubqueryExpr:
+		subquery, ok := expr.Sel.(*ast.SubqueryExpr)
+		if !ok {
+			er.err = fmt.Errorf("unsupported EXISTS expression %T", expr.Sel)
+			return n, true
+		}
+		er.rewriteSubquery(subquery.Query, ApplyTypeExists, expr.Not)
+		er.columnRef = n
+		return n, true
+	case *ast.SubqueryExpr:
+		er.rewriteSubquery(expr.Query, ApplyTypeScalar, false)
+		er.columnRef = n
+		return n, true
+	}
+	if !er.aggregated {
+		return n, false
+	}
+	switch n.(type) {
 	case *ast.ValueExpr:
-		_ = expr
+		return n, false
 	}
-	return n, false
+	// The expression same as a GROUP BY expression references its column even
+	// if the GROUP BY expression has an alias.
+	text, err := restoreNode(unwrapParentheses(n))
+	if err != nil {
+		er.err = err
+		return n, true
+	}
+	idx, ok := er.groupBy[strings.ToLower(text)]
+	if !ok {
+		name, err := restoreNode(n)
+		if err != nil {
+			er.err = err
+			return n, true
+		}
+		idx = er.p.Columns().FindColumnIndex(model.NewCIStr(name))
+	}
+	if idx == -1 {
+		return n, false
+	}
+	er.ctxStackAppend(&expression.Column{
+		Index: idx,
+		Name:  er.p.Columns()[idx].Name,
+		Type:  er.p.Columns()[idx].Type,
+	})
+	er.columnRef = n
+	return n, true
 }
 
 // Leave implements the ast.Visitor interface.
@@ -40,6 +120,10 @@ func (er *exprRewriter) Leave(n ast.Node) (node ast.Node, ok bool) {
 	if er.err != nil {
 		return node, false
 	}
+	if er.columnRef == n {
+		er.columnRef = nil
+		return n, true
+	}
 
 	switch expr := n.(type) {
 	case *ast.ValueExpr:
@@ -63,38 +147,229 @@ func (er *exprRewriter) Leave(n ast.Node) (node ast.Node, ok bool) {
 			return n, true
 		}
 		er.ctxStackAppend(unaryExpr)
+	case *ast.BindVariable:
+		er.ctxStackAppend(&expression.BindVariable{Order: expr.Order})
 	case *ast.VariableReference:
-		idx := er.p.Columns().FindColumnIndex(expr.VariableName)
-		if idx == -1 {
-			er.err = fmt.Errorf("unresolved variable %s", expr.VariableName)
+		col, err := er.resolveColumn(expr.VariableName)
+		if err != nil {
+			er.err = err
 			return n, true
 		}
-		er.ctxStackAppend(&expression.Column{
-			Index: idx,
-			Name:  expr.VariableName,
-			Type:  er.p.Columns()[idx].Type,
-		})
+		er.ctxStackAppend(col)
 	case *ast.PropertyAccess:
-		idx := er.p.Columns().FindColumnIndex(expr.VariableName)
-		if idx == -1 {
-			er.err = fmt.Errorf("unresolved variable %s", expr.VariableName)
+		col, err := er.resolveColumn(expr.VariableName)
+		if err != nil {
+			er.err = err
 			return n, true
 		}
-		col := &expression.Column{
-			Index: idx,
-			Name:  expr.VariableName,
-			Type:  er.p.Columns()[idx].Type,
-		}
 		er.ctxStackAppend(&expression.PropertyAccess{
 			Expr:         col,
 			VariableName: expr.VariableName,
 			PropertyName: expr.PropertyName,
 		})
+	case *ast.FuncCallExpr:
+		args := make([]expression.Expression, len(expr.Args))
+		copy(args, er.ctxStack[er.ctxStackLen()-len(expr.Args):])
+		er.ctxStackPop(len(expr.Args))
+		var (
+			funcExpr expression.Expression
+			err      error
+		)
+		switch expr.FnName.L {
+		case "match_number":
+			funcExpr, err = er.rewriteMatchNumber(args)
+		case "element_number":
+			funcExpr, err = er.rewriteElementNumber(args)
+		default:
+			funcExpr, err = expression.NewFuncExpr(expr.FnName.L, args...)
+		}
+		if err != nil {
+			er.err = err
+			return n, false
+		}
+		er.ctxStackAppend(funcExpr)
+	case *ast.SubstrFuncExpr:
+		numArgs := 2
+		if expr.For != nil {
+			numArgs = 3
+		}
+		args := make([]expression.Expression, numArgs)
+		copy(args, er.ctxStack[er.ctxStackLen()-numArgs:])
+		er.ctxStackPop(numArgs)
+		funcExpr, err := expression.NewFuncExpr("substring", args...)
+		if err != nil {
+			er.err = err
+			return n, false
+		}
+		er.ctxStackAppend(funcExpr)
+	case *ast.ExtractFuncExpr:
+		// The field is passed as the first argument of extract function.
+		field := &expression.Constant{Value: datum.NewString(expr.ExtractField.String())}
+		input := er.ctxStack[er.ctxStackLen()-1]
+		er.ctxStackPop(1)
+		funcExpr, err := expression.NewFuncExpr("extract", field, input)
+		if err != nil {
+			er.err = err
+			return n, false
+		}
+		er.ctxStackAppend(funcExpr)
+	case *ast.IsNullExpr:
+		input := er.ctxStack[er.ctxStackLen()-1]
+		er.ctxStackPop(1)
+		er.ctxStackAppend(&expression.IsNullExpr{Expr: input, Not: expr.Not})
+	case *ast.CastFuncExpr:
+		input := er.ctxStack[er.ctxStackLen()-1]
+		er.ctxStackPop(1)
+		typ, ok := castTypes[expr.DataType]
+		if !ok {
+			er.err = fmt.Errorf("unsupported cast type %s", expr.DataType)
+			return n, false
+		}
+		er.ctxStackAppend(expression.NewCastExpr(input, typ))
+	case *ast.CaseExpr:
+		// The stack contains the value, the WHEN and THEN expressions of each WHEN
+		// clause and the ELSE result in order.
+		numArgs := 2 * len(expr.WhenClauses)
+		if expr.Value != nil {
+			numArgs++
+		}
+		if expr.ElseClause != nil {
+			numArgs++
+		}
+		args := er.ctxStack[er.ctxStackLen()-numArgs:]
+		caseExpr := &expression.CaseExpr{}
+		if expr.Value != nil {
+			caseExpr.Value = args[0]
+			args = args[1:]
+		}
+		for range expr.WhenClauses {
+			caseExpr.WhenClauses = append(caseExpr.WhenClauses, &expression.WhenClause{
+				Expr:   args[0],
+				Result: args[1],
+			})
+			args = args[2:]
+		}
+		if expr.ElseClause != nil {
+			caseExpr.ElseClause = args[0]
+		}
+		er.ctxStackPop(numArgs)
+		er.ctxStackAppend(caseExpr)
+	case *ast.PatternInExpr:
+		var sel expression.Expression
+		if expr.Sel != nil {
+			sel = er.ctxStack[er.ctxStackLen()-1]
+			er.ctxStackPop(1)
+		}
+		list := make([]expression.Expression, len(expr.List))
+		copy(list, er.ctxStack[er.ctxStackLen()-len(expr.List):])
+		er.ctxStackPop(len(expr.List))
+		input := er.ctxStack[er.ctxStackLen()-1]
+		er.ctxStackPop(1)
+		er.ctxStackAppend(&expression.InExpr{Expr: input, List: list, Sel: sel, Not: expr.Not})
+	case *ast.ListExpr:
+		elems := make([]expression.Expression, len(expr.Elems))
+		copy(elems, er.ctxStack[er.ctxStackLen()-len(expr.Elems):])
+		er.ctxStackPop(len(expr.Elems))
+		er.ctxStackAppend(&expression.ListExpr{Elems: elems})
+	case *ast.MapExpr:
+		values := make([]expression.Expression, len(expr.Values))
+		copy(values, er.ctxStack[er.ctxStackLen()-len(expr.Values):])
+		er.ctxStackPop(len(expr.Values))
+		er.ctxStackAppend(&expression.MapExpr{Keys: expr.Keys, Values: values})
+	case *ast.SubscriptExpr:
+		input := er.ctxStack[er.ctxStackLen()-2]
+		index := er.ctxStack[er.ctxStackLen()-1]
+		er.ctxStackPop(2)
+		er.ctxStackAppend(&expression.SubscriptExpr{Expr: input, Index: index})
+	case *ast.AggregateFuncExpr:
+		listIndexes := groupVarIndexes(er.p.Columns(), expr)
+		if len(listIndexes) == 0 {
+			er.err = fmt.Errorf("invalid use of aggregate function %s", expr.F)
+			return n, false
+		}
+		args := make([]expression.Expression, len(expr.Args))
+		copy(args, er.ctxStack[er.ctxStackLen()-len(expr.Args):])
+		er.ctxStackPop(len(expr.Args))
+		aggExpr, err := expression.NewAggregateExpr(expr.F, expr.Distinct, args...)
+		if err != nil {
+			er.err = err
+			return n, false
+		}
+		er.ctxStackAppend(&expression.HorizontalAggregateExpr{
+			AggregateExpr: aggExpr,
+			ListIndexes:   listIndexes,
+		})
 	}
 
 	return n, true
 }
 
+// resolveColumn resolves the variable in the plan, or in the outer queries if the
+// expression is in a subquery.
+func (er *exprRewriter) resolveColumn(name model.CIStr) (expression.Expression, error) {
+	if idx := er.p.Columns().FindColumnIndex(name); idx != -1 {
+		return &expression.Column{
+			Index: idx,
+			Name:  name,
+			Type:  er.p.Columns()[idx].Type,
+		}, nil
+	}
+	if er.b != nil {
+		if col, ok := er.b.resolveOuterColumn(name); ok {
+			er.b.markCorrelated(col.Depth)
+			return col, nil
+		}
+	}
+	return nil, fmt.Errorf("unresolved variable %s", name)
+}
+
+// rewriteSubquery builds the subquery as an apply on top of the plan, and the
+// subquery is rewritten to the column of its result.
+func (er *exprRewriter) rewriteSubquery(query *ast.SelectStmt, tp ApplyType, not bool) {
+	if er.b == nil {
+		er.err = errors.New("subquery is not supported here")
+		return
+	}
+	inner, correlated, err := er.b.buildSubquery(query, er.p.Columns(), tp == ApplyTypeExists)
+	if err != nil {
+		er.err = err
+		return
+	}
+	colType := types.Bool
+	if tp == ApplyTypeScalar {
+		innerCols := inner.Columns()
+		if len(innerCols) != 1 {
+			er.err = errors.New("subquery must return only one column")
+			return
+		}
+		colType = innerCols[0].Type
+	}
+
+	name := model.NewCIStr(fmt.Sprintf("__subquery_%d", er.b.subqueries))
+	er.b.subqueries++
+	outerCols := er.p.Columns()
+	cols := make(ResultColumns, 0, len(outerCols)+1)
+	cols = append(cols, outerCols...)
+	cols = append(cols, ResultColumn{
+		Name:   name,
+		Type:   colType,
+		Hidden: true,
+	})
+	apply := &LogicalApply{
+		Type:       tp,
+		Not:        not,
+		Correlated: correlated,
+	}
+	apply.SetColumns(cols)
+	apply.SetChildren(er.p, inner)
+	er.p = apply
+	er.ctxStackAppend(&expression.Column{
+		Index: len(cols) - 1,
+		Name:  name,
+		Type:  colType,
+	})
+}
+
 func (er *exprRewriter) ctxStackLen() int {
 	return len(er.ctxStack)
 }
@@ -107,3 +382,134 @@ func (er *exprRewriter) ctxStackPop(num int) {
 func (er *exprRewriter) ctxStackAppend(col expression.Expression) {
 	er.ctxStack = append(er.ctxStack, col)
 }
+
+// castTypes maps the data types of CAST to the value types.
+var castTypes = map[ast.DataType]types.T{
+	ast.DataTypeString:                types.String,
+	ast.DataTypeBoolean:               types.Bool,
+	ast.DataTypeInteger:               types.Int,
+	ast.DataTypeFloat:                 types.Float,
+	ast.DataTypeDouble:                types.Float,
+	ast.DataTypeDecimal:               types.Decimal,
+	ast.DataTypeDate:                  types.Date,
+	ast.DataTypeTime:                  types.Time,
+	ast.DataTypeTimeWithTimeZone:      types.TimeTZ,
+	ast.DataTypeTimestamp:             types.Timestamp,
+	ast.DataTypeTimestampWithTimeZone: types.TimestampTZ,
+}
+
+// graphElementArg returns the variable column which is the only argument of a
+// graph element function.
+func graphElementArg(fnName string, args []expression.Expression) (*expression.Column, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid arguments count to call function %s", fnName)
+	}
+	col, ok := args[0].(*expression.Column)
+	if !ok || (col.Type != types.Vertex && col.Type != types.Edge) {
+		return nil, fmt.Errorf("function %s expects a vertex or edge variable", fnName)
+	}
+	return col, nil
+}
+
+// rewriteMatchNumber rewrites MATCH_NUMBER(v) to the hidden match number column,
+// since all variables of a match have the same match number.
+func (er *exprRewriter) rewriteMatchNumber(args []expression.Expression) (expression.Expression, error) {
+	if _, err := graphElementArg("match_number", args); err != nil {
+		return nil, err
+	}
+	idx := er.p.Columns().FindColumnIndex(MatchNumberColumnName)
+	if idx == -1 {
+		return nil, fmt.Errorf("function match_number is not available here")
+	}
+	return &expression.Column{
+		Index: idx,
+		Name:  MatchNumberColumnName,
+		Type:  types.Int,
+	}, nil
+}
+
+// rewriteElementNumber rewrites ELEMENT_NUMBER(v) to the constant position of
+// the variable in its path pattern.
+func (er *exprRewriter) rewriteElementNumber(args []expression.Expression) (expression.Expression, error) {
+	col, err := graphElementArg("element_number", args)
+	if err != nil {
+		return nil, err
+	}
+	for p := er.p; p != nil; {
+		if match, ok := p.(*LogicalMatch); ok {
+			number, ok := match.Subgraph.ElementNumbers[col.Name.L]
+			if !ok {
+				break
+			}
+			return &expression.Constant{Value: datum.NewInt(number)}, nil
+		}
+		// The first child of apply is the outer plan.
+		if len(p.Children()) == 0 {
+			break
+		}
+		p = p.Children()[0]
+	}
+	return nil, fmt.Errorf("cannot get element number of variable %s", col.Name)
+}
+
+// restoreNode restores the text of the AST node, which is used as the name of the
+// output column of an expression.
+func restoreNode(n ast.Node) (string, error) {
+	var buf bytes.Buffer
+	restoreCtx := format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)
+	if err := n.Restore(restoreCtx); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// unwrapParentheses returns the expression in the outer parentheses.
+func unwrapParentheses(n ast.Node) ast.Node {
+	for {
+		expr, ok := n.(*ast.ParenthesesExpr)
+		if !ok {
+			return n
+		}
+		n = expr.Expr
+	}
+}
+
+// groupVarIndexes returns the indexes of the group variable columns referenced by
+// the aggregate function. The aggregate function which references group variables
+// is a horizontal aggregation.
+func groupVarIndexes(cols ResultColumns, aggFunc *ast.AggregateFuncExpr) []int {
+	collector := &varRefCollector{}
+	for _, arg := range aggFunc.Args {
+		arg.Accept(collector)
+	}
+	var indexes []int
+	for _, name := range collector.names {
+		idx := cols.FindColumnIndex(name)
+		if idx == -1 || cols[idx].Type != types.List || slices.Contains(indexes, idx) {
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	return indexes
+}
+
+// varRefCollector collects the variable names referenced by an expression.
+type varRefCollector struct {
+	names []model.CIStr
+}
+
+// Enter implements the ast.Visitor interface.
+func (c *varRefCollector) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	switch expr := n.(type) {
+	case *ast.VariableReference:
+		c.names = append(c.names, expr.VariableName)
+	case *ast.PropertyAccess:
+		c.names = append(c.names, expr.VariableName)
+	}
+	return n, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (c *varRefCollector) Leave(n ast.Node) (node ast.Node, ok bool) {
+	return n, true
+}
@@ -7,9 +7,13 @@ import (
 
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/parser"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/parser/model"
 	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/simbiont-runtime/graphengine/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRewriteExpr(t *testing.T) {
@@ -21,6 +25,121 @@ func TestRewriteExpr(t *testing.T) {
 			expr:   &ast.ValueExpr{Datum: datum.NewInt(1)},
 			expect: &expression.Constant{Value: datum.NewInt(1)},
 		},
+		{
+			expr: &ast.FuncCallExpr{
+				FnName: model.NewCIStr("ID"),
+				Args:   []ast.ExprNode{&ast.ValueExpr{Datum: datum.NewInt(1)}},
+			},
+			expect: mustFuncExpr("id", &expression.Constant{Value: datum.NewInt(1)}),
+		},
+		{
+			expr: &ast.SubstrFuncExpr{
+				Expr:  &ast.ValueExpr{Datum: datum.NewString("abc")},
+				Start: &ast.ValueExpr{Datum: datum.NewInt(2)},
+			},
+			expect: mustFuncExpr("substring",
+				&expression.Constant{Value: datum.NewString("abc")},
+				&expression.Constant{Value: datum.NewInt(2)},
+			),
+		},
+		{
+			expr: &ast.ExtractFuncExpr{
+				ExtractField: ast.ExtractFieldMonth,
+				Expr:         &ast.ValueExpr{Datum: datum.NewInt(1)},
+			},
+			expect: mustFuncExpr("extract",
+				&expression.Constant{Value: datum.NewString("MONTH")},
+				&expression.Constant{Value: datum.NewInt(1)},
+			),
+		},
+		{
+			expr:   &ast.BindVariable{Order: 1},
+			expect: &expression.BindVariable{Order: 1},
+		},
+		{
+			expr: &ast.IsNullExpr{
+				Expr: &ast.ValueExpr{Datum: datum.NewInt(1)},
+				Not:  true,
+			},
+			expect: &expression.IsNullExpr{
+				Expr: &expression.Constant{Value: datum.NewInt(1)},
+				Not:  true,
+			},
+		},
+		{
+			expr: &ast.CastFuncExpr{
+				Expr:     &ast.ValueExpr{Datum: datum.NewString("2023-01-02")},
+				DataType: ast.DataTypeDate,
+			},
+			expect: expression.NewCastExpr(&expression.Constant{Value: datum.NewString("2023-01-02")}, types.Date),
+		},
+		{
+			expr: &ast.CaseExpr{
+				Value: &ast.ValueExpr{Datum: datum.NewInt(1)},
+				WhenClauses: []*ast.WhenClause{
+					{Expr: &ast.ValueExpr{Datum: datum.NewInt(2)}, Result: &ast.ValueExpr{Datum: datum.NewString("a")}},
+					{Expr: &ast.ValueExpr{Datum: datum.NewInt(1)}, Result: &ast.ValueExpr{Datum: datum.NewString("b")}},
+				},
+				ElseClause: &ast.ValueExpr{Datum: datum.NewString("c")},
+			},
+			expect: &expression.CaseExpr{
+				Value: &expression.Constant{Value: datum.NewInt(1)},
+				WhenClauses: []*expression.WhenClause{
+					{Expr: &expression.Constant{Value: datum.NewInt(2)}, Result: &expression.Constant{Value: datum.NewString("a")}},
+					{Expr: &expression.Constant{Value: datum.NewInt(1)}, Result: &expression.Constant{Value: datum.NewString("b")}},
+				},
+				ElseClause: &expression.Constant{Value: datum.NewString("c")},
+			},
+		},
+		{
+			expr: &ast.PatternInExpr{
+				Expr: &ast.ValueExpr{Datum: datum.NewInt(1)},
+				List: []ast.ExprNode{
+					&ast.ValueExpr{Datum: datum.NewInt(2)},
+					&ast.ValueExpr{Datum: datum.NewInt(3)},
+				},
+				Not: true,
+			},
+			expect: &expression.InExpr{
+				Expr: &expression.Constant{Value: datum.NewInt(1)},
+				List: []expression.Expression{
+					&expression.Constant{Value: datum.NewInt(2)},
+					&expression.Constant{Value: datum.NewInt(3)},
+				},
+				Not: true,
+			},
+		},
+		{
+			expr: &ast.PatternInExpr{
+				Expr: &ast.ValueExpr{Datum: datum.NewInt(1)},
+				Sel: &ast.ListExpr{Elems: []ast.ExprNode{
+					&ast.ValueExpr{Datum: datum.NewInt(2)},
+				}},
+			},
+			expect: &expression.InExpr{
+				Expr: &expression.Constant{Value: datum.NewInt(1)},
+				List: []expression.Expression{},
+				Sel: &expression.ListExpr{Elems: []expression.Expression{
+					&expression.Constant{Value: datum.NewInt(2)},
+				}},
+			},
+		},
+		{
+			expr: &ast.SubscriptExpr{
+				Expr: &ast.MapExpr{
+					Keys:   []string{"a"},
+					Values: []ast.ExprNode{&ast.ValueExpr{Datum: datum.NewInt(1)}},
+				},
+				Index: &ast.ValueExpr{Datum: datum.NewString("a")},
+			},
+			expect: &expression.SubscriptExpr{
+				Expr: &expression.MapExpr{
+					Keys:   []string{"a"},
+					Values: []expression.Expression{&expression.Constant{Value: datum.NewInt(1)}},
+				},
+				Index: &expression.Constant{Value: datum.NewString("a")},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -29,3 +148,71 @@ func TestRewriteExpr(t *testing.T) {
 		assert.Equal(t, c.expect, expr)
 	}
 }
+
+func mustFuncExpr(name string, args ...expression.Expression) expression.Expression {
+	expr, err := expression.NewFuncExpr(name, args...)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+func TestRegisterFunction(t *testing.T) {
+	fn := expression.NewFunction(1, types.Int, func(args []datum.Datum) (datum.Datum, error) {
+		return datum.NewInt(datum.AsInt(args[0]) + 1), nil
+	})
+	require.NoError(t, expression.RegisterFunction("Plus_One", fn))
+	require.ErrorContains(t, expression.RegisterFunction("plus_one", fn), "function plus_one already exists")
+	require.ErrorContains(t, expression.RegisterFunction("lower", fn), "function lower already exists")
+
+	call := func(arg datum.Datum) datum.Datum {
+		expr, err := planner.RewriteExpr(&ast.FuncCallExpr{
+			FnName: model.NewCIStr("PLUS_ONE"),
+			Args:   []ast.ExprNode{&ast.ValueExpr{Datum: arg}},
+		}, &planner.LogicalDual{})
+		require.NoError(t, err)
+		require.Equal(t, types.Int, expr.ReturnType())
+		d, err := expr.Eval(nil, nil)
+		require.NoError(t, err)
+		return d
+	}
+	require.Equal(t, datum.NewInt(2), call(datum.NewInt(1)))
+	require.Equal(t, datum.Null, call(datum.Null))
+}
+
+func TestRewriteGraphElementFuncs(t *testing.T) {
+	stmt, err := parser.New().ParseOneStmt("SELECT x FROM MATCH (x) -[e]-> (y), MATCH (y) -[f]-> (z)")
+	require.NoError(t, err)
+	sg, err := planner.NewSubgraphBuilder(nil).AddPathPatterns(stmt.(*ast.SelectStmt).From.Matches[0].Paths...).
+		AddPathPatterns(stmt.(*ast.SelectStmt).From.Matches[1].Paths...).Build()
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"x": 1, "e": 2, "y": 3, "f": 2, "z": 3}, sg.ElementNumbers)
+
+	match := &planner.LogicalMatch{Subgraph: sg}
+	match.SetColumns(planner.ResultColumnsFromSubgraph(sg))
+	selection := &planner.LogicalSelection{}
+	selection.SetChildren(match)
+
+	call := func(name string, varName string) (expression.Expression, error) {
+		return planner.RewriteExpr(&ast.FuncCallExpr{
+			FnName: model.NewCIStr(name),
+			Args:   []ast.ExprNode{&ast.VariableReference{VariableName: model.NewCIStr(varName)}},
+		}, selection)
+	}
+	expr, err := call("ELEMENT_NUMBER", "z")
+	require.NoError(t, err)
+	require.Equal(t, &expression.Constant{Value: datum.NewInt(3)}, expr)
+
+	expr, err = call("MATCH_NUMBER", "e")
+	require.NoError(t, err)
+	cols := match.Columns()
+	require.Equal(t, &expression.Column{
+		Index: len(cols) - 1,
+		Name:  planner.MatchNumberColumnName,
+		Type:  types.Int,
+	}, expr)
+	require.True(t, cols[len(cols)-1].Hidden)
+
+	_, err = call("MATCH_NUMBER", "w")
+	require.ErrorContains(t, err, "unresolved variable w")
+}
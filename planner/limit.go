@@ -4,6 +4,8 @@ package planner
 
 import "github.com/simbiont-runtime/graphengine/expression"
 
+// LogicalLimit represents the LIMIT clause. The Offset is nil if the OFFSET is
+// not specified.
 type LogicalLimit struct {
 	baseLogicalPlan
 
@@ -17,3 +19,8 @@ type PhysicalLimit struct {
 	Offset expression.Expression
 	Count  expression.Expression
 }
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalLimit) ExplainInfo() string {
+	return explainOffsetCount(p.Offset, p.Count)
+}
@@ -6,22 +6,131 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/expression"
 	"github.com/simbiont-runtime/graphengine/internal/slicesext"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
 	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/types"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 type LogicalMatch struct {
 	baseLogicalPlan
 
+	Graph    *catalog.Graph
 	Subgraph *Subgraph
+	// Bindings are the vertices bound to the vertices of outer queries in a
+	// correlated subquery, which are keyed by the lower-case variable names.
+	Bindings map[string]*expression.CorrelatedColumn
 }
 
 type PhysicalMatch struct {
 	basePhysicalPlan
+	Graph    *catalog.Graph
 	Subgraph *Subgraph
+	// IndexLookups are the index-backed access paths of vertices, which are
+	// keyed by the lower-case variable names.
+	IndexLookups map[string]*IndexLookup
+	// Bindings are the same as LogicalMatch.Bindings.
+	Bindings map[string]*expression.CorrelatedColumn
+	// Order is the expansion plan which is chosen by the estimated cost.
+	Order []*MatchStep
+	// Properties are the lower-case names of properties which are referenced by
+	// the plan, which are keyed by the lower-case variable names. Only these
+	// properties are decoded, and the variables not in the map (e.g: selected as
+	// a whole) are decoded with all properties.
+	Properties map[string][]string
+}
+
+// IndexLookup represents an index-backed access path which finds the vertices
+// whose indexed properties equal to the given values. The values are in the
+// same order as the properties of index.
+type IndexLookup struct {
+	Index  *catalog.Index
+	Values []expression.Expression
+}
+
+// ExplainInfo implements the Plan interface. The vertices, connections, index
+// lookups and bindings are listed in the order of variable names, followed by
+// the variables bound by the steps of the expansion plan.
+func (p *PhysicalMatch) ExplainInfo() string {
+	var parts []string
+	vertexNames := maps.Keys(p.Subgraph.Vertices)
+	slices.Sort(vertexNames)
+	for _, name := range vertexNames {
+		v := p.Subgraph.Vertices[name]
+		parts = append(parts, "("+v.Name.O+explainLabels(v.Labels)+")")
+	}
+	connNames := maps.Keys(p.Subgraph.Connections)
+	slices.Sort(connNames)
+	for _, name := range connNames {
+		parts = append(parts, explainConnection(p.Subgraph.Connections[name]))
+	}
+	lookupNames := maps.Keys(p.IndexLookups)
+	slices.Sort(lookupNames)
+	for _, name := range lookupNames {
+		lookup := p.IndexLookups[name]
+		parts = append(parts, fmt.Sprintf("index:%s(%s)", lookup.Index.Meta().Name.O, name))
+	}
+	bindingNames := maps.Keys(p.Bindings)
+	slices.Sort(bindingNames)
+	for _, name := range bindingNames {
+		parts = append(parts, fmt.Sprintf("bind:%s(%s)", p.Bindings[name], name))
+	}
+	if len(p.Order) > 0 {
+		steps := make([]string, 0, len(p.Order))
+		for _, step := range p.Order {
+			steps = append(steps, step.String())
+		}
+		parts = append(parts, "order:["+strings.Join(steps, ", ")+"]")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func explainLabels(labels []*catalog.Label) string {
+	var sb strings.Builder
+	for i, label := range labels {
+		if i == 0 {
+			sb.WriteByte(':')
+		} else {
+			sb.WriteByte('|')
+		}
+		if label == nil {
+			sb.WriteString("?")
+			continue
+		}
+		sb.WriteString(label.Meta().Name.O)
+	}
+	return sb.String()
+}
+
+func explainConnection(conn VertexPairConnection) string {
+	var pattern string
+	switch c := conn.(type) {
+	case *Edge:
+		pattern = "[" + c.Name().O + explainLabels(c.Labels) + "]"
+	case *VariableLengthPath:
+		var labels string
+		if edge, ok := c.Conn.(*Edge); ok {
+			labels = explainLabels(edge.Labels)
+		}
+		quantifier := fmt.Sprintf("{%d,}", c.MinHops)
+		if c.MaxHops != math.MaxInt64 {
+			quantifier = fmt.Sprintf("{%d,%d}", c.MinHops, c.MaxHops)
+		}
+		pattern = "/" + c.Name().O + labels + quantifier + "/"
+	default:
+		pattern = "[" + conn.Name().O + "]"
+	}
+	arrow := "->"
+	if conn.AnyDirected() {
+		arrow = "-"
+	}
+	return "(" + conn.SrcVarName().O + ")-" + pattern + arrow + "(" + conn.DstVarName().O + ")"
 }
 
 type Vertex struct {
@@ -110,6 +219,10 @@ const (
 	PathFindingCheapest
 )
 
+// VariableLengthPath represents a connection which consists of a variable number
+// of hops. The Constraints and Cost are evaluated on each hop, and the input row
+// of a hop consists of the hop source vertex, the edge and the hop destination
+// vertex (see HopColumns).
 type VariableLengthPath struct {
 	baseVertexPairConnection
 
@@ -119,17 +232,38 @@ type VariableLengthPath struct {
 	MaxHops     int64
 	TopK        int64
 	WithTies    bool
-	Constraints ast.ExprNode
-	Cost        ast.ExprNode
+	Constraints expression.Expression
+	Cost        expression.Expression
 	HopSrc      *Vertex
 	HopDst      *Vertex
 }
 
+// HopColumns returns the columns of the input row of a hop.
+func (p *VariableLengthPath) HopColumns() ResultColumns {
+	var srcName, dstName model.CIStr
+	if p.HopSrc != nil {
+		srcName = p.HopSrc.Name
+	}
+	if p.HopDst != nil {
+		dstName = p.HopDst.Name
+	}
+	return ResultColumns{
+		{Name: srcName, Type: types.Vertex},
+		{Name: p.Conn.Name(), Type: types.Edge},
+		{Name: dstName, Type: types.Vertex},
+	}
+}
+
 type Subgraph struct {
 	Vertices      map[string]*Vertex
 	Connections   map[string]VertexPairConnection
 	SingletonVars []*GraphVar
 	GroupVars     []*GraphVar
+	// ElementNumbers are the positions of the variables in their path patterns,
+	// which are keyed by the lower-case variable names. The vertices have odd
+	// numbers and the connections have even numbers, e.g: the elements of
+	// (a)-[e]->(b) are numbered as a=1, e=2 and b=3.
+	ElementNumbers map[string]int64
 }
 
 // GraphVar represents a graph variable in a MATCH clause.
@@ -183,15 +317,45 @@ func (s *SubgraphBuilder) Build() (*Subgraph, error) {
 	sort.Slice(s.groupVars, func(i, j int) bool {
 		return s.groupVars[i].Name.L < s.groupVars[j].Name.L
 	})
+	elementNumbers, err := s.buildElementNumbers()
+	if err != nil {
+		return nil, err
+	}
 	sg := &Subgraph{
-		Vertices:      s.vertices,
-		Connections:   s.connections,
-		SingletonVars: s.singletonVars,
-		GroupVars:     s.groupVars,
+		Vertices:       s.vertices,
+		Connections:    s.connections,
+		SingletonVars:  s.singletonVars,
+		GroupVars:      s.groupVars,
+		ElementNumbers: elementNumbers,
 	}
 	return sg, nil
 }
 
+// buildElementNumbers numbers the variables by their positions in the path
+// patterns. A variable which occurs in many path patterns is numbered by its
+// first occurrence.
+func (s *SubgraphBuilder) buildElementNumbers() (map[string]int64, error) {
+	numbers := make(map[string]int64)
+	number := func(name model.CIStr, pos int) {
+		if _, ok := numbers[name.L]; !ok {
+			numbers[name.L] = int64(pos)
+		}
+	}
+	for _, path := range s.paths {
+		for i, astVertex := range path.Vertices {
+			number(astVertex.Variable.Name, 2*i+1)
+		}
+		for i, astConn := range path.Connections {
+			connName, _, err := extractConnNameAndDirection(astConn)
+			if err != nil {
+				return nil, err
+			}
+			number(connName, 2*i+2)
+		}
+	}
+	return numbers, nil
+}
+
 func (s *SubgraphBuilder) buildCommonPathExpressions() error {
 	for _, m := range s.macros {
 		result, err := s.buildPathPatternMacro(m)
@@ -361,6 +525,7 @@ func (s *SubgraphBuilder) buildVariableLengthPath(
 		return nil, err
 	}
 	vlp := &VariableLengthPath{Conn: conn}
+	vlp.SetName(varName)
 
 	switch pathTp {
 	case ast.PathPatternAny, ast.PathPatternAnyShortest:
@@ -391,15 +556,6 @@ func (s *SubgraphBuilder) buildVariableLengthPath(
 		vlp.MinHops = 1
 		vlp.MaxHops = 1
 	}
-	if x.Quantifier != nil {
-		vlp.MinHops = x.Quantifier.N
-		vlp.MaxHops = x.Quantifier.M
-	} else {
-		vlp.MinHops = 1
-		vlp.MaxHops = 1
-	}
-	vlp.Constraints = x.Where
-	vlp.Cost = x.Cost
 
 	var hopSrcVar, hopDstVar *ast.VariableSpec
 	if x.Source != nil {
@@ -429,6 +585,22 @@ func (s *SubgraphBuilder) buildVariableLengthPath(
 		Name:      varName,
 		Anonymous: x.Edge.Variable.Anonymous,
 	})
+
+	// The constraints and cost are evaluated on each hop.
+	hop := &LogicalDual{}
+	hop.SetColumns(vlp.HopColumns())
+	if x.Where != nil {
+		vlp.Constraints, err = RewriteExpr(x.Where, hop)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if x.Cost != nil {
+		vlp.Cost, err = RewriteExpr(x.Cost, hop)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return vlp, nil
 }
 
@@ -437,8 +609,8 @@ func (s *SubgraphBuilder) buildVariableLengthPath(
 func (s *SubgraphBuilder) buildConnWithCpe(varName model.CIStr, labelOrCpeNames []model.CIStr) (VertexPairConnection, error) {
 	edge := &Edge{}
 	edge.SetName(varName)
+	// The edge without labels matches the edges of any label.
 	if len(labelOrCpeNames) == 0 {
-		edge.Labels = s.graph.Labels()
 		return edge, nil
 	}
 
@@ -0,0 +1,206 @@
+// ---
+
+package planner
+
+import (
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/expression"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// MatchStep is a step of the expansion plan of match. A step either starts a
+// connected component from a vertex, which is scanned or looked up by index, or
+// expands a connection from its matched vertices. The expansion binds the other
+// end vertex of the connection if it has not been matched.
+type MatchStep struct {
+	Vertex *Vertex
+	Conn   VertexPairConnection
+	// Filters are the conjuncts of the selection over the match, which are
+	// evaluated on the candidates of the step since all their variables have
+	// been bound.
+	Filters []expression.Expression
+}
+
+// String returns the variable name of the start vertex or the connection, which
+// is followed by the filters of the step.
+func (s *MatchStep) String() string {
+	if s.Vertex != nil {
+		return explainStep(s.Vertex.Name.O, s.Filters)
+	}
+	return explainStep(s.Conn.Name().O, s.Filters)
+}
+
+func explainStep(name string, filters []expression.Expression) string {
+	if len(filters) == 0 {
+		return name
+	}
+	conds := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		conds = append(conds, filter.String())
+	}
+	return name + "(" + strings.Join(conds, ", ") + ")"
+}
+
+// visit marks the variables bound by the step as visited.
+func (s *MatchStep) visit(visited map[string]struct{}) {
+	if s.Vertex != nil {
+		visited[s.Vertex.Name.L] = struct{}{}
+		return
+	}
+	visited[s.Conn.Name().L] = struct{}{}
+	visited[s.Conn.SrcVarName().L] = struct{}{}
+	visited[s.Conn.DstVarName().L] = struct{}{}
+	// The group variables of the path are bound with the path.
+	if path, ok := s.Conn.(*VariableLengthPath); ok {
+		if path.HopSrc != nil {
+			visited[path.HopSrc.Name.L] = struct{}{}
+		}
+		if path.HopDst != nil {
+			visited[path.HopDst.Name.L] = struct{}{}
+		}
+	}
+}
+
+// chooseOrder chooses the expansion plan of the match, and the filters are
+// attached to the earliest steps where all their variables are bound.
+func (p *PhysicalMatch) chooseOrder(filters []expression.Expression) {
+	p.Order = chooseMatchOrder(newMatchEstimator(p), maps.Keys(p.Bindings))
+
+	visited := make(map[string]struct{}, len(p.Subgraph.Vertices)+len(p.Subgraph.Connections))
+	for name := range p.Bindings {
+		visited[name] = struct{}{}
+	}
+	remained := filters
+	for _, step := range p.Order {
+		step.visit(visited)
+		var unbound []expression.Expression
+		for _, filter := range remained {
+			if p.boundColumns(filter, visited) {
+				step.Filters = append(step.Filters, filter)
+			} else {
+				unbound = append(unbound, filter)
+			}
+		}
+		remained = unbound
+	}
+}
+
+// boundColumns reports whether all columns referenced by the expression are the
+// visited variables.
+func (p *PhysicalMatch) boundColumns(expr expression.Expression, visited map[string]struct{}) bool {
+	bound := true
+	expression.Walk(expr, func(e expression.Expression) bool {
+		if col, ok := e.(*expression.Column); ok {
+			if _, ok := visited[p.Columns()[col.Index].Name.L]; !ok {
+				bound = false
+			}
+			return false
+		}
+		return bound
+	})
+	return bound
+}
+
+// Filters returns the filters of all steps.
+func (p *PhysicalMatch) Filters() []expression.Expression {
+	var filters []expression.Expression
+	for _, step := range p.Order {
+		filters = append(filters, step.Filters...)
+	}
+	return filters
+}
+
+// matchOrderer chooses the order of the match steps by the estimated cost, which
+// is the total number of intermediate rows produced by the steps.
+type matchOrderer struct {
+	*matchEstimator
+}
+
+// chooseMatchOrder returns the expansion plan of the subgraph. The connections of
+// the matched vertices are expanded greedily by the fewest rows they produce, and
+// a new component is started from the vertex whose expansion costs the least.
+// The bound vertices are matched before the steps.
+func chooseMatchOrder(est *matchEstimator, bound []string) []*MatchStep {
+	o := &matchOrderer{matchEstimator: est}
+	subgraph := est.subgraph
+	visited := make(map[string]struct{}, len(subgraph.Vertices)+len(subgraph.Connections))
+	for _, name := range bound {
+		visited[name] = struct{}{}
+	}
+	order, _ := o.expand(visited, 1)
+
+	vertexNames := maps.Keys(subgraph.Vertices)
+	slices.Sort(vertexNames)
+	for {
+		var (
+			bestSteps   []*MatchStep
+			bestCost    float64
+			bestVisited map[string]struct{}
+		)
+		for _, name := range vertexNames {
+			if _, ok := visited[name]; ok {
+				continue
+			}
+			start := &MatchStep{Vertex: subgraph.Vertices[name]}
+			rows := o.stepFactor(start, visited)
+			candidate := maps.Clone(visited)
+			start.visit(candidate)
+			steps, cost := o.expand(candidate, rows)
+			cost += rows
+			if bestVisited == nil || cost < bestCost {
+				bestSteps = append([]*MatchStep{start}, steps...)
+				bestCost = cost
+				bestVisited = candidate
+			}
+		}
+		if bestVisited == nil {
+			return order
+		}
+		order = append(order, bestSteps...)
+		visited = bestVisited
+	}
+}
+
+// expand expands the connections of the visited vertices until no connection can
+// be expanded, and returns the steps and their cost. The rows is the number of
+// rows before expanding.
+func (o *matchOrderer) expand(visited map[string]struct{}, rows float64) ([]*MatchStep, float64) {
+	connNames := maps.Keys(o.subgraph.Connections)
+	slices.Sort(connNames)
+
+	var (
+		steps []*MatchStep
+		cost  float64
+	)
+	for {
+		var (
+			best       *MatchStep
+			bestFactor float64
+		)
+		for _, name := range connNames {
+			if _, ok := visited[name]; ok {
+				continue
+			}
+			conn := o.subgraph.Connections[name]
+			_, srcVisited := visited[conn.SrcVarName().L]
+			_, dstVisited := visited[conn.DstVarName().L]
+			if !srcVisited && !dstVisited {
+				continue
+			}
+			step := &MatchStep{Conn: conn}
+			factor := o.stepFactor(step, visited)
+			if best == nil || factor < bestFactor {
+				best, bestFactor = step, factor
+			}
+		}
+		if best == nil {
+			return steps, cost
+		}
+		best.visit(visited)
+		steps = append(steps, best)
+		rows *= bestFactor
+		cost += rows
+	}
+}
@@ -2,40 +2,427 @@
 
 package planner
 
+import (
+	"sort"
+
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/parser/opcode"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
 // Optimize optimizes the plan to the optimal physical plan.
 func Optimize(plan LogicalPlan) Plan {
+	result := optimize(plan)
+	if p, ok := result.(PhysicalPlan); ok {
+		assignPlanIDs(p, 0)
+		pruneProperties(p)
+	}
+	return result
+}
+
+// assignPlanIDs assigns the IDs to the plans in pre-order starting from lastID+1
+// and returns the last assigned ID. The IDs are unique in a plan tree.
+func assignPlanIDs(plan PhysicalPlan, lastID int) int {
+	lastID++
+	plan.SetID(lastID)
+	for _, child := range plan.Children() {
+		lastID = assignPlanIDs(child, lastID)
+	}
+	return lastID
+}
+
+func optimize(plan LogicalPlan) Plan {
 	switch p := plan.(type) {
 	case *LogicalMatch:
-		return optimizeMatch(p)
+		return optimizeMatch(p, nil, nil)
 	case *LogicalProjection:
 		return optimizeProjection(p)
 	case *LogicalSelection:
 		return optimizeSelection(p)
+	case *LogicalAggregation:
+		return optimizeAggregation(p)
+	case *LogicalSort:
+		return optimizeSort(p)
+	case *LogicalLimit:
+		return optimizeLimit(p)
+	case *LogicalApply:
+		return optimizeApply(p, nil, nil, nil)
 	}
 	return plan
 }
 
-func optimizeMatch(plan *LogicalMatch) Plan {
+// optimizeMatch optimizes the match plan. The cond is the filter condition over
+// the match result, which is used to choose the access paths of vertices, and
+// the filters are evaluated by the steps of match.
+func optimizeMatch(plan *LogicalMatch, cond expression.Expression, filters []expression.Expression) Plan {
 	result := &PhysicalMatch{}
+	result.tp = TypeMatch
 	result.SetColumns(plan.Columns())
+	result.Graph = plan.Graph
 	result.Subgraph = plan.Subgraph
+	result.IndexLookups = chooseIndexLookups(plan, cond)
+	result.Bindings = plan.Bindings
+	result.chooseOrder(filters)
 	return result
 }
 
+// chooseIndexLookups chooses the index with the most properties for each vertex
+// whose indexed properties are all compared with constants for equality, e.g.
+// the index on (name) can be used by `WHERE x.name = 'Lee'`. The condition is
+// still evaluated on the match result, so the index only needs to find all the
+// candidates. The types of the indexed values are unknown until executed, and
+// the vertices are scanned instead if the constants cannot be compared with
+// the indexed values (see MatchExec.indexValuesComparable).
+func chooseIndexLookups(plan *LogicalMatch, cond expression.Expression) map[string]*IndexLookup {
+	if plan.Graph == nil || cond == nil {
+		return nil
+	}
+	indexes := plan.Graph.Indexes()
+	if len(indexes) == 0 {
+		return nil
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Meta().ID < indexes[j].Meta().ID
+	})
+
+	// Collect the equality constraints: variable name -> property name -> value.
+	equals := make(map[string]map[string]expression.Expression)
+	for _, expr := range splitConjuncts(cond, nil) {
+		varName, propName, value, ok := extractPropertyEqual(expr)
+		if !ok {
+			continue
+		}
+		if _, ok := plan.Subgraph.Vertices[varName]; !ok {
+			continue
+		}
+		if equals[varName] == nil {
+			equals[varName] = make(map[string]expression.Expression)
+		}
+		if _, ok := equals[varName][propName]; !ok {
+			equals[varName][propName] = value
+		}
+	}
+
+	var lookups map[string]*IndexLookup
+	for varName, props := range equals {
+		var best *IndexLookup
+		for _, index := range indexes {
+			if !indexCoversVertex(index, plan.Subgraph.Vertices[varName]) {
+				continue
+			}
+			var values []expression.Expression
+			for _, prop := range index.Meta().Properties {
+				value, ok := props[prop.L]
+				if !ok {
+					break
+				}
+				values = append(values, value)
+			}
+			if len(values) < len(index.Meta().Properties) {
+				continue
+			}
+			if best == nil || len(values) > len(best.Values) {
+				best = &IndexLookup{Index: index, Values: values}
+			}
+		}
+		if best == nil {
+			continue
+		}
+		if lookups == nil {
+			lookups = make(map[string]*IndexLookup)
+		}
+		lookups[varName] = best
+	}
+	return lookups
+}
+
+// indexCoversVertex reports whether the index contains all the vertices that
+// can be matched by the vertex pattern. The index defined on a label only
+// covers the vertex pattern with exactly the same label.
+func indexCoversVertex(index *catalog.Index, vertex *Vertex) bool {
+	label := index.Meta().Label
+	if label.L == "" {
+		return true
+	}
+	return len(vertex.Labels) == 1 && vertex.Labels[0].Meta().Name.L == label.L
+}
+
+// splitConjuncts splits the condition by AND into conjuncts.
+func splitConjuncts(expr expression.Expression, conjuncts []expression.Expression) []expression.Expression {
+	if e, ok := expr.(*expression.BinaryExpr); ok && e.Op == opcode.LogicAnd {
+		conjuncts = splitConjuncts(e.Left, conjuncts)
+		return splitConjuncts(e.Right, conjuncts)
+	}
+	return append(conjuncts, expr)
+}
+
+// extractPropertyEqual extracts the variable name, property name and the value
+// from the expression `var.prop = constant` or `constant = var.prop`. The bind
+// variable is also accepted as a constant, whose value is checked when the index
+// is looked up.
+func extractPropertyEqual(expr expression.Expression) (varName, propName string, value expression.Expression, ok bool) {
+	e, ok := expr.(*expression.BinaryExpr)
+	if !ok || e.Op != opcode.EQ {
+		return "", "", nil, false
+	}
+	prop, ok := e.Left.(*expression.PropertyAccess)
+	value = e.Right
+	if !ok {
+		prop, ok = e.Right.(*expression.PropertyAccess)
+		value = e.Left
+	}
+	if !ok {
+		return "", "", nil, false
+	}
+	switch c := value.(type) {
+	case *expression.Constant:
+		if !codec.IndexLookupSupported(c.Value.Type()) {
+			return "", "", nil, false
+		}
+	case *expression.BindVariable:
+	default:
+		return "", "", nil, false
+	}
+	return prop.VariableName.L, prop.PropertyName.L, value, true
+}
+
 func optimizeProjection(plan *LogicalProjection) Plan {
 	result := &PhysicalProjection{}
+	result.tp = TypeProjection
 	result.SetColumns(plan.Columns())
 	result.Exprs = plan.Exprs
-	childPlan := Optimize(plan.Children()[0])
+	childPlan := optimize(plan.Children()[0])
 	result.SetChildren(childPlan.(PhysicalPlan))
 	return result
 }
 
+// optimizeSelection optimizes the selection plan. The EXISTS subqueries which
+// are the conjuncts of the condition are decorrelated into semi joins if they
+// can be, and the conjuncts over the variables of the match are pushed down to
+// the steps of match. The selection is removed if no conjuncts are left.
 func optimizeSelection(plan *LogicalSelection) Plan {
+	semiJoins := make(map[*LogicalApply]struct{})
+	var conjuncts []expression.Expression
+	for _, expr := range splitConjuncts(plan.Condition, nil) {
+		if apply := decorrelatableApply(plan.Children()[0], expr); apply != nil {
+			semiJoins[apply] = struct{}{}
+			continue
+		}
+		conjuncts = append(conjuncts, expr)
+	}
+	cond := plan.Condition
+	if len(semiJoins) > 0 {
+		cond = composeConjuncts(conjuncts)
+	}
+
+	var filters, remained []expression.Expression
+	match := underlyingMatch(plan.Children()[0])
+	for _, expr := range conjuncts {
+		if match != nil && pushable(match, expr) {
+			filters = append(filters, expr)
+		} else {
+			remained = append(remained, expr)
+		}
+	}
+	childPlan := optimizeWithCondition(plan.Children()[0], cond, filters, semiJoins)
+	if len(remained) == 0 {
+		return childPlan
+	}
+
 	result := &PhysicalSelection{}
+	result.tp = TypeSelection
+	result.SetColumns(plan.Columns())
+	result.Condition = composeConjuncts(remained)
+	result.SetChildren(childPlan.(PhysicalPlan))
+	return result
+}
+
+// optimizeWithCondition optimizes the child plan of selection. The cond is the
+// condition of selection, which is used to choose the access paths of the match
+// plan under the applies, and the filters are pushed down to the match.
+func optimizeWithCondition(plan LogicalPlan, cond expression.Expression, filters []expression.Expression, semiJoins map[*LogicalApply]struct{}) Plan {
+	switch p := plan.(type) {
+	case *LogicalMatch:
+		return optimizeMatch(p, cond, filters)
+	case *LogicalApply:
+		return optimizeApply(p, cond, filters, semiJoins)
+	default:
+		return optimize(plan)
+	}
+}
+
+// underlyingMatch returns the match under the chain of applies, or nil if the
+// plan is not a match or an apply.
+func underlyingMatch(plan LogicalPlan) *LogicalMatch {
+	for {
+		switch p := plan.(type) {
+		case *LogicalMatch:
+			return p
+		case *LogicalApply:
+			plan = p.Children()[0]
+		default:
+			return nil
+		}
+	}
+}
+
+// pushable reports whether the conjunct can be evaluated by the steps of match.
+// The conjunct must only reference the variables of match (the columns of applies
+// are after them), and at least one of them is bound by the steps instead of the
+// outer query. The match number is unknown until the whole match is found.
+func pushable(match *LogicalMatch, expr expression.Expression) bool {
+	numVars := len(match.Columns()) - 1
+	ok, stepBound := true, false
+	expression.Walk(expr, func(e expression.Expression) bool {
+		col, isCol := e.(*expression.Column)
+		if !isCol {
+			return ok
+		}
+		if col.Index >= numVars {
+			ok = false
+		} else if _, bound := match.Bindings[match.Columns()[col.Index].Name.L]; !bound {
+			stepBound = true
+		}
+		return false
+	})
+	return ok && stepBound
+}
+
+// composeConjuncts composes the conjuncts by AND, and returns nil if there are
+// no conjuncts.
+func composeConjuncts(conjuncts []expression.Expression) expression.Expression {
+	if len(conjuncts) == 0 {
+		return nil
+	}
+	cond := conjuncts[0]
+	for _, expr := range conjuncts[1:] {
+		// The AND operator is always supported.
+		cond, _ = expression.NewBinaryExpr(opcode.LogicAnd, cond, expr)
+	}
+	return cond
+}
+
+// decorrelatableApply returns the apply of the EXISTS subquery if the conjunct
+// is its result column and it can be decorrelated into a semi join. The apply
+// must be in the chain of applies directly under the selection, and its subquery
+// must be a match with optional selections, which only binds the vertices of the
+// immediate outer query.
+func decorrelatableApply(plan LogicalPlan, conjunct expression.Expression) *LogicalApply {
+	col, ok := conjunct.(*expression.Column)
+	if !ok {
+		return nil
+	}
+	for {
+		apply, ok := plan.(*LogicalApply)
+		if !ok {
+			return nil
+		}
+		if col.Index != len(apply.Columns())-1 {
+			plan = apply.Children()[0]
+			continue
+		}
+		if apply.Type != ApplyTypeExists || apply.Correlated {
+			return nil
+		}
+		for inner := apply.Children()[1]; ; inner = inner.Children()[0] {
+			switch inner.(type) {
+			case *LogicalSelection:
+				continue
+			case *LogicalMatch:
+				return apply
+			}
+			return nil
+		}
+	}
+}
+
+func optimizeApply(plan *LogicalApply, cond expression.Expression, filters []expression.Expression, semiJoins map[*LogicalApply]struct{}) Plan {
+	outerPlan := optimizeWithCondition(plan.Children()[0], cond, filters, semiJoins).(PhysicalPlan)
+	innerPlan := optimize(plan.Children()[1]).(PhysicalPlan)
+	if _, ok := semiJoins[plan]; ok {
+		return optimizeSemiJoin(plan, outerPlan, innerPlan)
+	}
+
+	result := &PhysicalApply{}
+	result.tp = TypeApply
+	result.SetColumns(plan.Columns())
+	result.Type = plan.Type
+	result.Not = plan.Not
+	result.SetChildren(outerPlan, innerPlan)
+	return result
+}
+
+// optimizeSemiJoin turns the apply of EXISTS subquery into a semi join, which is
+// keyed by the bound vertices. The vertices are no longer bound, so the subquery
+// can be executed only once.
+func optimizeSemiJoin(plan *LogicalApply, outerPlan, innerPlan PhysicalPlan) Plan {
+	result := &PhysicalHashSemiJoin{}
+	result.tp = TypeSemiJoin
+	result.SetColumns(plan.Columns())
+	result.Anti = plan.Not
+
+	inner := innerPlan
+	for len(inner.Children()) > 0 {
+		inner = inner.Children()[0]
+	}
+	match := inner.(*PhysicalMatch)
+	names := maps.Keys(match.Bindings)
+	slices.Sort(names)
+	for _, name := range names {
+		result.OuterKeys = append(result.OuterKeys, match.Bindings[name].Index)
+		result.InnerKeys = append(result.InnerKeys, innerPlan.Columns().FindColumnIndex(match.Subgraph.Vertices[name].Name))
+	}
+	match.Bindings = nil
+	match.chooseOrder(match.Filters())
+
+	result.SetChildren(outerPlan, innerPlan)
+	return result
+}
+
+func optimizeAggregation(plan *LogicalAggregation) Plan {
+	result := &PhysicalHashAgg{}
+	result.tp = TypeHashAgg
+	result.SetColumns(plan.Columns())
+	result.AggFuncs = plan.AggFuncs
+	result.GroupByItems = plan.GroupByItems
+	childPlan := optimize(plan.Children()[0])
+	result.SetChildren(childPlan.(PhysicalPlan))
+	return result
+}
+
+func optimizeSort(plan *LogicalSort) Plan {
+	result := &PhysicalSort{}
+	result.tp = TypeSort
+	result.SetColumns(plan.Columns())
+	result.ByItems = plan.ByItems
+	childPlan := optimize(plan.Children()[0])
+	result.SetChildren(childPlan.(PhysicalPlan))
+	return result
+}
+
+func optimizeLimit(plan *LogicalLimit) Plan {
+	// Merge the ORDER BY and LIMIT clauses into TopN.
+	if sort, ok := plan.Children()[0].(*LogicalSort); ok {
+		result := &PhysicalTopN{}
+		result.tp = TypeTopN
+		result.SetColumns(plan.Columns())
+		result.ByItems = sort.ByItems
+		result.Offset = plan.Offset
+		result.Count = plan.Count
+		childPlan := optimize(sort.Children()[0])
+		result.SetChildren(childPlan.(PhysicalPlan))
+		return result
+	}
+
+	result := &PhysicalLimit{}
+	result.tp = TypeLimit
 	result.SetColumns(plan.Columns())
-	result.Condition = plan.Condition
-	childPlan := Optimize(plan.Children()[0])
+	result.Offset = plan.Offset
+	result.Count = plan.Count
+	childPlan := optimize(plan.Children()[0])
 	result.SetChildren(childPlan.(PhysicalPlan))
 	return result
 }
@@ -0,0 +1,294 @@
+// ---
+
+package planner_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine"
+	"github.com/simbiont-runtime/graphengine/parser"
+	"github.com/simbiont-runtime/graphengine/planner"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimize_IndexLookup(t *testing.T) {
+	db, err := graphengine.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	sess := db.NewSession()
+	for _, query := range []string{
+		"CREATE GRAPH g",
+		"USE g",
+		"INSERT VERTEX x PROPERTIES (x.name = 'Lee', x.age = 22)",
+		"CREATE INDEX idx_name (name)",
+		"CREATE INDEX idx_name_age (name, age)",
+	} {
+		rs, err := sess.Execute(ctx, query)
+		require.NoError(t, err)
+		require.NoError(t, rs.Next(ctx))
+		require.NoError(t, rs.Close())
+	}
+
+	cases := []struct {
+		query   string
+		lookups map[string]string
+	}{
+		{
+			query:   "SELECT x FROM MATCH (x) WHERE x.name = 'Lee'",
+			lookups: map[string]string{"x": "idx_name"},
+		},
+		{
+			query:   "SELECT x FROM MATCH (x) WHERE 'Lee' = x.name",
+			lookups: map[string]string{"x": "idx_name"},
+		},
+		{
+			query:   "SELECT x FROM MATCH (x) WHERE x.age = 22 AND x.name = 'Lee'",
+			lookups: map[string]string{"x": "idx_name_age"},
+		},
+		{
+			query:   "SELECT x, y FROM MATCH (x) -[e]-> (y) WHERE y.name = 'Lee' AND x.age > 1",
+			lookups: map[string]string{"y": "idx_name"},
+		},
+		{
+			query: "SELECT x FROM MATCH (x) WHERE x.name = 'Lee' OR x.age = 22",
+		},
+		{
+			query: "SELECT x FROM MATCH (x) WHERE x.age = 22",
+		},
+		{
+			query: "SELECT x FROM MATCH (x) WHERE x.name = x.age",
+		},
+	}
+
+	for _, c := range cases {
+		stmt, err := parser.New().ParseOneStmt(c.query)
+		require.NoError(t, err, c.query)
+		plan, err := planner.NewBuilder(sess.StmtContext()).Build(stmt)
+		require.NoError(t, err, c.query)
+
+		p := planner.Optimize(plan.(planner.LogicalPlan)).(planner.PhysicalPlan)
+		match, ok := p.(*planner.PhysicalMatch)
+		for !ok {
+			p = p.Children()[0]
+			match, ok = p.(*planner.PhysicalMatch)
+		}
+		lookups := make(map[string]string)
+		for name, lookup := range match.IndexLookups {
+			lookups[name] = lookup.Index.Meta().Name.L
+		}
+		if c.lookups == nil {
+			c.lookups = map[string]string{}
+		}
+		require.Equal(t, c.lookups, lookups, c.query)
+	}
+}
+
+func TestOptimize_Decorrelate(t *testing.T) {
+	db, err := graphengine.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	sess := db.NewSession()
+	for _, query := range []string{
+		"CREATE GRAPH g",
+		"USE g",
+		"INSERT VERTEX x PROPERTIES (x.name = 'Lee', x.age = 22)",
+		"CREATE INDEX idx_name (name)",
+	} {
+		rs, err := sess.Execute(ctx, query)
+		require.NoError(t, err)
+		require.NoError(t, rs.Next(ctx))
+		require.NoError(t, rs.Close())
+	}
+
+	cases := []struct {
+		query string
+		// plan is the plan type under the projection.
+		plan    string
+		anti    bool
+		lookups int
+	}{
+		{
+			query: "SELECT x FROM MATCH (x) WHERE EXISTS (SELECT * FROM MATCH (x) -[e]-> (y))",
+			plan:  planner.TypeSemiJoin,
+		},
+		{
+			query: "SELECT x FROM MATCH (x) WHERE NOT EXISTS (SELECT * FROM MATCH (x) -[e]-> (y) WHERE y.age > x.age)",
+			plan:  planner.TypeSemiJoin,
+			anti:  true,
+		},
+		{
+			// The other conjunct is pushed down to the match.
+			query:   "SELECT x FROM MATCH (x) WHERE x.name = 'Lee' AND EXISTS (SELECT * FROM MATCH (x) -[e]-> (y))",
+			plan:    planner.TypeSemiJoin,
+			lookups: 1,
+		},
+		{
+			// The outer variable is referenced by expression.
+			query: "SELECT x FROM MATCH (x) WHERE EXISTS (SELECT * FROM MATCH (y) WHERE y.age > x.age)",
+			plan:  planner.TypeSelection,
+		},
+		{
+			query: "SELECT x FROM MATCH (x) WHERE EXISTS (SELECT * FROM MATCH (x) -[e]-> (y)) OR x.age > 1",
+			plan:  planner.TypeSelection,
+		},
+		{
+			query: "SELECT x, EXISTS (SELECT * FROM MATCH (x) -[e]-> (y)) FROM MATCH (x)",
+			plan:  planner.TypeApply,
+		},
+		{
+			query: "SELECT x, (SELECT COUNT(*) FROM MATCH (x) -[e]-> (y)) FROM MATCH (x)",
+			plan:  planner.TypeApply,
+		},
+	}
+
+	for _, c := range cases {
+		stmt, err := parser.New().ParseOneStmt(c.query)
+		require.NoError(t, err, c.query)
+		plan, err := planner.NewBuilder(sess.StmtContext()).Build(stmt)
+		require.NoError(t, err, c.query)
+
+		p := planner.Optimize(plan.(planner.LogicalPlan)).(planner.PhysicalPlan)
+		p = p.Children()[0]
+		require.Equal(t, c.plan, p.TP(), c.query)
+		for p.TP() != planner.TypeSemiJoin && p.TP() != planner.TypeApply {
+			p = p.Children()[0]
+		}
+		if semiJoin, ok := p.(*planner.PhysicalHashSemiJoin); ok {
+			require.Equal(t, c.anti, semiJoin.Anti, c.query)
+			require.Len(t, semiJoin.OuterKeys, 1, c.query)
+			// The bindings are replaced by the join keys.
+			inner := semiJoin.Children()[1]
+			for len(inner.Children()) > 0 {
+				inner = inner.Children()[0]
+			}
+			require.Empty(t, inner.(*planner.PhysicalMatch).Bindings, c.query)
+		}
+		outer := p.Children()[0].(*planner.PhysicalMatch)
+		require.Len(t, outer.IndexLookups, c.lookups, c.query)
+	}
+}
+
+func TestOptimize_PushDown(t *testing.T) {
+	db, err := graphengine.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	sess := db.NewSession()
+	for _, query := range []string{
+		"CREATE GRAPH g",
+		"USE g",
+		"CREATE LABEL Person",
+	} {
+		rs, err := sess.Execute(ctx, query)
+		require.NoError(t, err)
+		require.NoError(t, rs.Next(ctx))
+		require.NoError(t, rs.Close())
+	}
+
+	cases := []struct {
+		query string
+		// steps are the steps of match with their filters.
+		steps []string
+		// selection is the condition left in the selection.
+		selection string
+	}{
+		{
+			query: "SELECT x FROM MATCH (x:Person) -[e]-> (y) WHERE x.age > 1 AND y.age > x.age AND e.since = 2000",
+			steps: []string{"x(x.age gt 1)", "e(y.age gt x.age, e.since eq 2000)"},
+		},
+		{
+			query: "SELECT x FROM MATCH (x:Person) -[e]-> (y) WHERE x.age > 1 OR y.age > 1",
+			steps: []string{"x", "e(x.age gt 1 or y.age gt 1)"},
+		},
+		{
+			// The match number is unknown until the whole match is found.
+			query:     "SELECT x FROM MATCH (x:Person) WHERE MATCH_NUMBER(x) > 1 AND x.age > 1",
+			steps:     []string{"x(x.age gt 1)"},
+			selection: "__match_number gt 1",
+		},
+		{
+			// The conjunct without variables is not pushed down.
+			query:     "SELECT x FROM MATCH (x:Person) WHERE 1 = 1",
+			steps:     []string{"x"},
+			selection: "1 eq 1",
+		},
+	}
+
+	for _, c := range cases {
+		stmt, err := parser.New().ParseOneStmt(c.query)
+		require.NoError(t, err, c.query)
+		plan, err := planner.NewBuilder(sess.StmtContext()).Build(stmt)
+		require.NoError(t, err, c.query)
+
+		p := planner.Optimize(plan.(planner.LogicalPlan)).(planner.PhysicalPlan)
+		var selection string
+		for p.TP() != planner.TypeMatch {
+			if sel, ok := p.(*planner.PhysicalSelection); ok {
+				selection = sel.Condition.String()
+			}
+			p = p.Children()[0]
+		}
+		var steps []string
+		for _, step := range p.(*planner.PhysicalMatch).Order {
+			steps = append(steps, step.String())
+		}
+		require.Equal(t, c.steps, steps, c.query)
+		require.Equal(t, c.selection, selection, c.query)
+	}
+}
+
+func TestOptimize_PruneProperties(t *testing.T) {
+	db, err := graphengine.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	sess := db.NewSession()
+	for _, query := range []string{
+		"CREATE GRAPH g",
+		"USE g",
+	} {
+		rs, err := sess.Execute(ctx, query)
+		require.NoError(t, err)
+		require.NoError(t, rs.Next(ctx))
+		require.NoError(t, rs.Close())
+	}
+
+	cases := []struct {
+		query      string
+		properties map[string][]string
+	}{
+		{
+			query:      "SELECT x.name FROM MATCH (x) -[e]-> (y) WHERE y.age > 1",
+			properties: map[string][]string{"x": {"name"}, "e": {}, "y": {"age"}},
+		},
+		{
+			query:      "SELECT x.name, x.age FROM MATCH (x) -[e]-> (y) ORDER BY y.name",
+			properties: map[string][]string{"x": {"age", "name"}, "e": {}, "y": {"name"}},
+		},
+		{
+			// The variables referenced as a whole are decoded with all properties.
+			query:      "SELECT x, LABEL(e) FROM MATCH (x) -[e]-> (y)",
+			properties: map[string][]string{"y": {}},
+		},
+	}
+
+	for _, c := range cases {
+		stmt, err := parser.New().ParseOneStmt(c.query)
+		require.NoError(t, err, c.query)
+		plan, err := planner.NewBuilder(sess.StmtContext()).Build(stmt)
+		require.NoError(t, err, c.query)
+
+		p := planner.Optimize(plan.(planner.LogicalPlan)).(planner.PhysicalPlan)
+		for p.TP() != planner.TypeMatch {
+			p = p.Children()[0]
+		}
+		require.Equal(t, c.properties, p.(*planner.PhysicalMatch).Properties, c.query)
+	}
+}
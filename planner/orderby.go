@@ -9,11 +9,14 @@ import (
 	"github.com/simbiont-runtime/graphengine/parser/model"
 )
 
-// ByItem wraps a "by" item.
+// ByItem wraps a "by" item. The NULL values are ordered after all non-NULL values
+// in ascending order, and before them in descending order.
 type ByItem struct {
-	Expr      expression.Expression
-	AsName    model.CIStr
-	Desc      bool
+	Expr   expression.Expression
+	AsName model.CIStr
+	Desc   bool
+	// NullOrder is true if the order is not specified explicitly, in which case
+	// the ascending order is used.
 	NullOrder bool
 }
 
@@ -36,3 +39,8 @@ type PhysicalSort struct {
 
 	ByItems []*ByItem
 }
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalSort) ExplainInfo() string {
+	return explainByItems(p.ByItems)
+}
@@ -6,9 +6,24 @@ import (
 	"strconv"
 )
 
+// The types of physical plans, which are used as the prefix of ExplainID.
+const (
+	TypeMatch      = "Match"
+	TypeProjection = "Projection"
+	TypeSelection  = "Selection"
+	TypeHashAgg    = "HashAgg"
+	TypeSort       = "Sort"
+	TypeTopN       = "TopN"
+	TypeLimit      = "Limit"
+	TypeApply      = "Apply"
+	TypeSemiJoin   = "HashSemiJoin"
+)
+
 type Plan interface {
 	// ID gets the ID.
 	ID() int
+	// SetID sets the ID.
+	SetID(id int)
 	// TP gets the plan type.
 	TP() string
 	// Columns gets the result columns.
@@ -54,6 +69,11 @@ func (p *basePlan) ID() int {
 	return p.id
 }
 
+// SetID implements the Plan interface.
+func (p *basePlan) SetID(id int) {
+	p.id = id
+}
+
 // TP implements the Plan interface.
 func (p *basePlan) TP() string {
 	return p.tp
@@ -3,16 +3,17 @@
 package planner
 
 import (
-	"bytes"
+	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/codec"
 	"github.com/simbiont-runtime/graphengine/expression"
 	"github.com/simbiont-runtime/graphengine/meta"
 	"github.com/simbiont-runtime/graphengine/parser/ast"
-	"github.com/simbiont-runtime/graphengine/parser/format"
 	"github.com/simbiont-runtime/graphengine/parser/model"
 	"github.com/simbiont-runtime/graphengine/stmtctx"
+	"github.com/simbiont-runtime/graphengine/types"
 )
 
 // builderContext represents the context of building plan.
@@ -20,10 +21,25 @@ type builderContext struct {
 	plan Plan
 }
 
+// outerScope represents an outer query of the subquery being built, whose
+// columns can be referenced by the subquery.
+type outerScope struct {
+	cols ResultColumns
+	// correlated indicates the subquery references the columns of this scope
+	// other than binding the vertices of its MATCH, see LogicalApply.Correlated.
+	correlated bool
+}
+
 // Builder is used to build the AST into a plan.
 type Builder struct {
 	sc     *stmtctx.Context
 	stacks []*builderContext
+	// scopes are the outer queries of the subquery being built, and the immediate
+	// outer query is the last.
+	scopes []*outerScope
+	// subqueries is the number of subqueries built, which is used to name the
+	// columns of subquery results.
+	subqueries int
 }
 
 // NewBuilder returns a plan builder.
@@ -42,14 +58,20 @@ func (b *Builder) Build(node ast.StmtNode) (Plan, error) {
 	switch stmt := node.(type) {
 	case ast.DDLNode:
 		err = b.buildDDL(stmt)
-	case *ast.UseStmt:
+	case *ast.UseStmt, *ast.BeginStmt, *ast.CommitStmt, *ast.RollbackStmt, *ast.AnalyzeGraphStmt:
 		err = b.buildSimple(stmt)
 	case *ast.InsertStmt:
 		err = b.buildInsert(stmt)
+	case *ast.UpdateStmt:
+		err = b.buildUpdate(stmt)
+	case *ast.DeleteStmt:
+		err = b.buildDelete(stmt)
 	case *ast.SelectStmt:
 		err = b.buildSelect(stmt)
 	case *ast.ShowStmt:
 		err = b.buildShow(stmt)
+	case *ast.ExplainStmt:
+		err = b.buildExplain(stmt)
 	}
 	if err != nil {
 		return nil, err
@@ -75,12 +97,54 @@ func (b *Builder) setPlan(plan Plan) {
 }
 
 func (b *Builder) buildDDL(ddl ast.DDLNode) error {
-	b.setPlan(&DDL{
+	plan := &DDL{
 		Statement: ddl,
-	})
+	}
+	if stmt, ok := ddl.(*ast.CreateLabelStmt); ok {
+		props, err := b.buildLabelProperties(stmt.Properties)
+		if err != nil {
+			return err
+		}
+		plan.LabelProperties = props
+	}
+	b.setPlan(plan)
 	return nil
 }
 
+// buildLabelProperties resolves the property definitions of a label schema. The
+// default values are converted to the property types and encoded.
+func (b *Builder) buildLabelProperties(defs []*ast.LabelPropertyDef) ([]*model.LabelPropertyInfo, error) {
+	var props []*model.LabelPropertyInfo
+	for _, def := range defs {
+		for _, prop := range props {
+			if prop.Name.L == def.Property.L {
+				return nil, errors.Errorf("duplicate property %s in the schema of label", def.Property)
+			}
+		}
+		prop := &model.LabelPropertyInfo{
+			Name:    def.Property,
+			Type:    castTypes[def.Type],
+			NotNull: def.NotNull,
+		}
+		if def.Default != nil {
+			expr, err := RewriteExpr(def.Default, nil)
+			if err != nil {
+				return nil, err
+			}
+			value, err := expression.NewCastExpr(expr, prop.Type).Eval(b.sc, nil)
+			if err != nil {
+				return nil, errors.Annotatef(err, "invalid default value of property %s", def.Property)
+			}
+			prop.Default, err = codec.EncodeValue(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		props = append(props, prop)
+	}
+	return props, nil
+}
+
 func (b *Builder) buildSimple(stmt ast.StmtNode) error {
 	b.setPlan(&Simple{
 		Statement: stmt,
@@ -105,24 +169,11 @@ func (b *Builder) buildInsert(stmt *ast.InsertStmt) error {
 
 	var fromPlan LogicalPlan
 	if stmt.From != nil {
-		p, err := b.buildMatch(stmt.From.Matches)
+		p, err := b.buildFrom(stmt.From, stmt.Where)
 		if err != nil {
 			return err
 		}
-
-		if stmt.Where != nil {
-			cond, err := RewriteExpr(stmt.Where, p)
-			if err != nil {
-				return err
-			}
-			where := &LogicalSelection{
-				Condition: cond,
-			}
-			where.SetChildren(p)
-			p = where
-		}
 		fromPlan = p
-
 	} else {
 		fromPlan = &LogicalDual{}
 	}
@@ -198,38 +249,126 @@ func (b *Builder) buildInsert(stmt *ast.InsertStmt) error {
 	return nil
 }
 
-func (b *Builder) buildSelect(stmt *ast.SelectStmt) error {
-	// Build source
-	plan, err := b.buildMatch(stmt.From.Matches)
+func (b *Builder) buildUpdate(stmt *ast.UpdateStmt) error {
+	graph := b.sc.CurrentGraph()
+	if graph == nil {
+		return meta.ErrGraphNotExists
+	}
+
+	fromPlan, err := b.buildFrom(stmt.From, stmt.Where)
 	if err != nil {
 		return err
 	}
 
-	// Build selection
-	if stmt.Where != nil {
-		expr, err := RewriteExpr(stmt.Where, plan)
-		if err != nil {
-			return err
+	var updates []*ElementUpdate
+	for _, update := range stmt.Updates {
+		idx := fromPlan.Columns().FindColumnIndex(update.VariableName)
+		if idx == -1 {
+			return errors.Errorf("unresolved variable %s", update.VariableName)
 		}
-		where := &LogicalSelection{
-			Condition: expr,
+		var assignments []*expression.Assignment
+		for _, prop := range update.Assignments {
+			// Note: The property suppose to be exists because we have invoked property
+			// creation module before building plan.
+			propInfo := graph.Property(prop.PropertyAccess.PropertyName.L)
+			if propInfo == nil {
+				return errors.Errorf("property %s not exists", prop.PropertyAccess.PropertyName.L)
+			}
+			expr, err := RewriteExpr(prop.ValueExpression, fromPlan)
+			if err != nil {
+				return err
+			}
+			assignment := &expression.Assignment{
+				VariableRef: &expression.VariableRef{Name: prop.PropertyAccess.VariableName},
+				PropertyRef: &expression.PropertyRef{Property: propInfo},
+				Expr:        expr,
+			}
+			assignments = append(assignments, assignment)
 		}
-		where.SetChildren(plan)
-		plan = where
+		updates = append(updates, &ElementUpdate{
+			VariableName:  update.VariableName,
+			VariableIndex: idx,
+			Assignments:   assignments,
+		})
 	}
 
-	// TODO: support GROUP BY clause.
+	plan := &Update{
+		Graph:     graph,
+		Updates:   updates,
+		MatchPlan: Optimize(fromPlan),
+	}
+	b.setPlan(plan)
+	return nil
+}
+
+func (b *Builder) buildDelete(stmt *ast.DeleteStmt) error {
+	graph := b.sc.CurrentGraph()
+	if graph == nil {
+		return meta.ErrGraphNotExists
+	}
+
+	fromPlan, err := b.buildFrom(stmt.From, stmt.Where)
+	if err != nil {
+		return err
+	}
+
+	var deletes []*ElementDelete
+	for _, name := range stmt.VariableNames {
+		idx := fromPlan.Columns().FindColumnIndex(name)
+		if idx == -1 {
+			return errors.Errorf("unresolved variable %s", name)
+		}
+		deletes = append(deletes, &ElementDelete{
+			VariableName:  name,
+			VariableIndex: idx,
+		})
+	}
+
+	plan := &Delete{
+		Graph:     graph,
+		Deletes:   deletes,
+		MatchPlan: Optimize(fromPlan),
+	}
+	b.setPlan(plan)
+	return nil
+}
+
+func (b *Builder) buildSelect(stmt *ast.SelectStmt) error {
+	// Build source and selection
+	plan, err := b.buildFrom(stmt.From, stmt.Where)
+	if err != nil {
+		return err
+	}
+	plan, err = b.buildSelectResult(stmt, plan)
+	if err != nil {
+		return err
+	}
+	b.setPlan(plan)
+	return nil
+}
+
+// buildSelectResult builds the clauses of the SELECT statement other than MATCH
+// and WHERE on top of the plan.
+func (b *Builder) buildSelectResult(stmt *ast.SelectStmt, plan LogicalPlan) (LogicalPlan, error) {
 	// Explicit GROUP BY: SELECT * FROM MATCH (n) GROUP BY n.name;
 	// Implicit GROUP BY: SELECT COUNT(*) FROM MATCH (n);
-	if stmt.GroupBy != nil {
-
+	aggFuncs := collectAggFuncs(stmt, plan.Columns())
+	aggregated := stmt.GroupBy != nil || len(aggFuncs) > 0
+	var groupBy map[string]int
+	if aggregated {
+		var err error
+		plan, groupBy, err = b.buildAggregation(plan, stmt.GroupBy, aggFuncs)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if stmt.Having != nil {
-		expr, err := RewriteExpr(stmt.Having.Expr, plan)
+		expr, p, err := b.rewriteExpr(stmt.Having.Expr, plan, aggregated, groupBy)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		plan = p
 		having := &LogicalSelection{
 			Condition: expr,
 		}
@@ -238,12 +377,14 @@ func (b *Builder) buildSelect(stmt *ast.SelectStmt) error {
 	}
 
 	if stmt.OrderBy != nil {
+		aliases := selectAliases(stmt.Select)
 		byItems := make([]*ByItem, 0, len(stmt.OrderBy.Items))
 		for _, item := range stmt.OrderBy.Items {
-			expr, err := RewriteExpr(item.Expr.Expr, plan)
+			expr, p, err := b.rewriteExpr(resolveSelectAliases(item.Expr.Expr, aliases), plan, aggregated, groupBy)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			plan = p
 			byItems = append(byItems, &ByItem{
 				Expr:      expr,
 				AsName:    item.Expr.AsName,
@@ -259,40 +400,45 @@ func (b *Builder) buildSelect(stmt *ast.SelectStmt) error {
 	}
 
 	if stmt.Limit != nil {
-		offset, err := RewriteExpr(stmt.Limit.Offset, plan)
-		if err != nil {
-			return err
+		limit := &LogicalLimit{}
+		if stmt.Limit.Offset != nil {
+			offset, err := RewriteExpr(stmt.Limit.Offset, plan)
+			if err != nil {
+				return nil, err
+			}
+			limit.Offset = offset
 		}
 		count, err := RewriteExpr(stmt.Limit.Count, plan)
 		if err != nil {
-			return err
-		}
-		limit := &LogicalLimit{
-			Offset: offset,
-			Count:  count,
+			return nil, err
 		}
+		limit.Count = count
 		limit.SetChildren(plan)
 		plan = limit
 	}
 
+	if stmt.Select.Star {
+		return b.buildStarProjection(plan), nil
+	}
+
 	cols := make(ResultColumns, 0, len(stmt.Select.Elements))
-	// TODO: support DISTINCT and wildcard.
+	// TODO: support DISTINCT.
 	proj := &LogicalProjection{}
 	for _, elem := range stmt.Select.Elements {
-		expr, err := RewriteExpr(elem.ExpAsVar.Expr, plan)
+		expr, p, err := b.rewriteExpr(elem.ExpAsVar.Expr, plan, aggregated, groupBy)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		plan = p
 		proj.Exprs = append(proj.Exprs, expr)
 
 		var colName model.CIStr
 		if elem.ExpAsVar.AsName.IsEmpty() {
-			var buf bytes.Buffer
-			restoreCtx := format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)
-			if err := elem.ExpAsVar.Expr.Restore(restoreCtx); err != nil {
-				return err
+			name, err := restoreNode(elem.ExpAsVar.Expr)
+			if err != nil {
+				return nil, err
 			}
-			colName = model.NewCIStr(buf.String())
+			colName = model.NewCIStr(name)
 		} else {
 			colName = elem.ExpAsVar.AsName
 		}
@@ -305,9 +451,212 @@ func (b *Builder) buildSelect(stmt *ast.SelectStmt) error {
 	}
 	proj.SetColumns(cols)
 	proj.SetChildren(plan)
+	return proj, nil
+}
 
-	b.setPlan(proj)
-	return nil
+// buildStarProjection builds the projection of SELECT *, which selects all the
+// visible columns except the anonymous variables.
+func (b *Builder) buildStarProjection(plan LogicalPlan) LogicalPlan {
+	proj := &LogicalProjection{}
+	var cols ResultColumns
+	for i, col := range plan.Columns() {
+		if col.Hidden || strings.HasPrefix(col.Name.L, anonymousVarPrefix) {
+			continue
+		}
+		proj.Exprs = append(proj.Exprs, &expression.Column{
+			Index: i,
+			Name:  col.Name,
+			Type:  col.Type,
+		})
+		cols = append(cols, col)
+	}
+	proj.SetColumns(cols)
+	proj.SetChildren(plan)
+	return proj
+}
+
+// buildAggregation builds the aggregation on top of the plan. The output columns
+// of aggregation are named by the text of the aggregate functions and GROUP BY
+// expressions (or their alias), so the expressions above the aggregation can
+// reference them by text. The returned map finds the columns of GROUP BY
+// expressions by their lowercase text without the outer parentheses.
+func (b *Builder) buildAggregation(plan LogicalPlan, groupBy *ast.GroupByClause, aggFuncs []*ast.AggregateFuncExpr) (LogicalPlan, map[string]int, error) {
+	agg := &LogicalAggregation{}
+	cols := make(ResultColumns, 0, len(aggFuncs))
+	for _, aggFunc := range aggFuncs {
+		name, err := restoreNode(aggFunc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cols.FindColumnIndex(model.NewCIStr(name)) != -1 {
+			continue
+		}
+		args := make([]expression.Expression, 0, len(aggFunc.Args))
+		for _, arg := range aggFunc.Args {
+			expr, err := RewriteExpr(arg, plan)
+			if err != nil {
+				return nil, nil, err
+			}
+			args = append(args, expr)
+		}
+		expr, err := expression.NewAggregateExpr(aggFunc.F, aggFunc.Distinct, args...)
+		if err != nil {
+			return nil, nil, err
+		}
+		agg.AggFuncs = append(agg.AggFuncs, expr)
+		cols = append(cols, ResultColumn{
+			Name: model.NewCIStr(name),
+			Type: expr.ReturnType(),
+		})
+	}
+
+	groupByCols := make(map[string]int)
+	if groupBy != nil {
+		for _, item := range groupBy.Items {
+			expr, err := RewriteExpr(item.Expr.Expr, plan)
+			if err != nil {
+				return nil, nil, err
+			}
+			text, err := restoreNode(unwrapParentheses(item.Expr.Expr))
+			if err != nil {
+				return nil, nil, err
+			}
+			key := strings.ToLower(text)
+			if _, ok := groupByCols[key]; !ok {
+				groupByCols[key] = len(cols)
+			}
+			name := item.Expr.AsName
+			if name.IsEmpty() {
+				text, err := restoreNode(item.Expr.Expr)
+				if err != nil {
+					return nil, nil, err
+				}
+				name = model.NewCIStr(text)
+			}
+			agg.GroupByItems = append(agg.GroupByItems, expr)
+			cols = append(cols, ResultColumn{
+				Name: name,
+				Type: expr.ReturnType(),
+			})
+		}
+	}
+
+	agg.SetColumns(cols)
+	agg.SetChildren(plan)
+	return agg, groupByCols, nil
+}
+
+// collectAggFuncs collects the aggregate functions referenced by the SELECT, HAVING
+// and ORDER BY clauses. The horizontal aggregations over group variables are not
+// collected because they are evaluated on each row.
+func collectAggFuncs(stmt *ast.SelectStmt, cols ResultColumns) []*ast.AggregateFuncExpr {
+	collector := &aggFuncCollector{cols: cols}
+	for _, elem := range stmt.Select.Elements {
+		if elem.ExpAsVar != nil {
+			elem.ExpAsVar.Expr.Accept(collector)
+		}
+	}
+	if stmt.Having != nil {
+		stmt.Having.Expr.Accept(collector)
+	}
+	if stmt.OrderBy != nil {
+		for _, item := range stmt.OrderBy.Items {
+			item.Expr.Expr.Accept(collector)
+		}
+	}
+	return collector.aggFuncs
+}
+
+type aggFuncCollector struct {
+	cols     ResultColumns
+	aggFuncs []*ast.AggregateFuncExpr
+}
+
+// Enter implements the ast.Visitor interface.
+func (c *aggFuncCollector) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	switch expr := n.(type) {
+	case *ast.AggregateFuncExpr:
+		if len(groupVarIndexes(c.cols, expr)) == 0 {
+			c.aggFuncs = append(c.aggFuncs, expr)
+		}
+		return n, true
+	case *ast.SubqueryExpr, *ast.ExistsSubqueryExpr:
+		// The aggregate functions in subqueries belong to the subqueries.
+		return n, true
+	}
+	return n, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (c *aggFuncCollector) Leave(n ast.Node) (node ast.Node, ok bool) {
+	return n, true
+}
+
+// selectAliases returns the expressions of SELECT elements by their aliases.
+func selectAliases(sel *ast.SelectClause) map[string]ast.ExprNode {
+	aliases := make(map[string]ast.ExprNode)
+	for _, elem := range sel.Elements {
+		if elem.ExpAsVar != nil && !elem.ExpAsVar.AsName.IsEmpty() {
+			aliases[elem.ExpAsVar.AsName.L] = elem.ExpAsVar.Expr
+		}
+	}
+	return aliases
+}
+
+// resolveSelectAliases replaces the variable references to the aliases of SELECT
+// elements with the aliased expressions. The aliases take precedence over the
+// variables of MATCH, so ORDER BY can reference the aliases.
+func resolveSelectAliases(expr ast.ExprNode, aliases map[string]ast.ExprNode) ast.ExprNode {
+	if len(aliases) == 0 {
+		return expr
+	}
+	node, _ := expr.Accept(&aliasResolver{aliases: aliases})
+	return node.(ast.ExprNode)
+}
+
+type aliasResolver struct {
+	aliases map[string]ast.ExprNode
+}
+
+// Enter implements the ast.Visitor interface.
+func (r *aliasResolver) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
+	switch n.(type) {
+	case *ast.SubqueryExpr, *ast.ExistsSubqueryExpr:
+		// The variables in subqueries are resolved in their own scope.
+		return n, true
+	}
+	return n, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (r *aliasResolver) Leave(n ast.Node) (node ast.Node, ok bool) {
+	if ref, ok := n.(*ast.VariableReference); ok {
+		if expr, ok := r.aliases[ref.VariableName.L]; ok {
+			return expr, true
+		}
+	}
+	return n, true
+}
+
+// buildFrom builds the data source of a statement from the MATCH clauses and
+// the optional WHERE condition.
+func (b *Builder) buildFrom(from *ast.MatchClauseList, where ast.ExprNode) (LogicalPlan, error) {
+	plan, err := b.buildMatch(from.Matches)
+	if err != nil {
+		return nil, err
+	}
+	if where == nil {
+		return plan, nil
+	}
+	cond, plan, err := b.rewriteExpr(where, plan, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	selection := &LogicalSelection{
+		Condition: cond,
+	}
+	selection.SetChildren(plan)
+	return selection, nil
 }
 
 func (b *Builder) buildMatch(matches []*ast.MatchClause) (LogicalPlan, error) {
@@ -315,7 +664,8 @@ func (b *Builder) buildMatch(matches []*ast.MatchClause) (LogicalPlan, error) {
 		return &LogicalDual{}, nil
 	}
 
-	sgb := NewSubgraphBuilder(b.sc.CurrentGraph())
+	graph := b.sc.CurrentGraph()
+	sgb := NewSubgraphBuilder(graph)
 	for _, match := range matches {
 		sgb.AddPathPatterns(match.Paths...)
 	}
@@ -325,12 +675,109 @@ func (b *Builder) buildMatch(matches []*ast.MatchClause) (LogicalPlan, error) {
 	}
 
 	cols := ResultColumnsFromSubgraph(sg)
-	plan := &LogicalMatch{Subgraph: sg}
+	plan := &LogicalMatch{Graph: graph, Subgraph: sg}
 	plan.SetColumns(cols)
 
+	// The vertices of a subquery are bound to the vertices of outer queries with
+	// the same names. Only the vertices are bound, and the other variables shadow
+	// the outer ones.
+	for name, v := range sg.Vertices {
+		col, ok := b.resolveOuterColumn(v.Name)
+		if !ok || col.Type != types.Vertex {
+			continue
+		}
+		// The binding to the immediate outer query can be turned into a join.
+		if col.Depth > 1 {
+			b.markCorrelated(col.Depth)
+		}
+		if plan.Bindings == nil {
+			plan.Bindings = make(map[string]*expression.CorrelatedColumn)
+		}
+		plan.Bindings[name] = col
+	}
+
 	return plan, nil
 }
 
+// buildSubquery builds the subquery whose outer query has the columns, and
+// reports whether the subquery is correlated, see LogicalApply.Correlated. Only
+// the existence of rows matters for an EXISTS subquery, so the SELECT clause is
+// not built if it doesn't change the number of rows.
+func (b *Builder) buildSubquery(stmt *ast.SelectStmt, outerCols ResultColumns, exists bool) (LogicalPlan, bool, error) {
+	scope := &outerScope{cols: outerCols}
+	b.scopes = append(b.scopes, scope)
+	defer func() {
+		b.scopes = b.scopes[:len(b.scopes)-1]
+	}()
+
+	plan, err := b.buildFrom(stmt.From, stmt.Where)
+	if err != nil {
+		return nil, false, err
+	}
+	if exists && stmt.GroupBy == nil && stmt.Having == nil && stmt.Limit == nil &&
+		len(collectAggFuncs(stmt, plan.Columns())) == 0 {
+		return plan, scope.correlated, nil
+	}
+	plan, err = b.buildSelectResult(stmt, plan)
+	if err != nil {
+		return nil, false, err
+	}
+	return plan, scope.correlated, nil
+}
+
+// resolveOuterColumn resolves the column with the name in the outer queries of
+// the subquery being built, and the innermost one is preferred.
+func (b *Builder) resolveOuterColumn(name model.CIStr) (*expression.CorrelatedColumn, bool) {
+	for depth := 1; depth <= len(b.scopes); depth++ {
+		cols := b.scopes[len(b.scopes)-depth].cols
+		idx := cols.FindColumnIndex(name)
+		if idx == -1 {
+			continue
+		}
+		return &expression.CorrelatedColumn{
+			Depth: depth,
+			Index: idx,
+			Name:  cols[idx].Name,
+			Type:  cols[idx].Type,
+		}, true
+	}
+	return nil, false
+}
+
+// markCorrelated marks the subqueries which are nested in the outer query at the
+// depth as correlated.
+func (b *Builder) markCorrelated(depth int) {
+	for _, scope := range b.scopes[len(b.scopes)-depth:] {
+		scope.correlated = true
+	}
+}
+
+func (b *Builder) buildExplain(stmt *ast.ExplainStmt) error {
+	b.pushContext()
+	err := b.buildSelect(stmt.Select)
+	target := b.plan()
+	b.popContext()
+	if err != nil {
+		return err
+	}
+
+	physicalPlan, ok := Optimize(target.(LogicalPlan)).(PhysicalPlan)
+	if !ok {
+		return errors.Errorf("unsupported plan %T in EXPLAIN", target)
+	}
+	plan := &Explain{
+		TargetPlan: physicalPlan,
+		Analyze:    stmt.Analyze,
+	}
+	if stmt.Analyze {
+		plan.SetColumns(explainAnalyzeColumns)
+	} else {
+		plan.SetColumns(explainColumns)
+	}
+	b.setPlan(plan)
+	return nil
+}
+
 func (b *Builder) buildShow(stmt *ast.ShowStmt) error {
 	plan := &Simple{
 		Statement: stmt,
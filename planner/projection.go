@@ -15,3 +15,8 @@ type PhysicalProjection struct {
 
 	Exprs []expression.Expression
 }
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalProjection) ExplainInfo() string {
+	return explainExprs(p.Exprs)
+}
@@ -0,0 +1,120 @@
+// ---
+
+package planner
+
+import (
+	"github.com/simbiont-runtime/graphengine/expression"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// propertyReferences collects the properties of variables referenced by the
+// expressions of a plan tree. The variables are identified by their names, so
+// the references of different variables with the same name (e.g: the variables
+// of a subquery) are merged, which only makes more properties decoded.
+type propertyReferences struct {
+	// properties are the referenced properties keyed by the variable names.
+	properties map[string]map[string]struct{}
+	// whole are the variables which are referenced as a whole, whose properties
+	// are all needed.
+	whole   map[string]struct{}
+	matches []*PhysicalMatch
+}
+
+// pruneProperties sets the properties which need to be decoded by the matches of
+// the plan tree. The columns of the root plan are the results, so the variables
+// in the results are decoded with all properties.
+func pruneProperties(root PhysicalPlan) {
+	refs := &propertyReferences{
+		properties: make(map[string]map[string]struct{}),
+		whole:      make(map[string]struct{}),
+	}
+	for _, col := range root.Columns() {
+		refs.whole[col.Name.L] = struct{}{}
+	}
+	refs.collectPlan(root)
+
+	for _, match := range refs.matches {
+		match.Properties = make(map[string][]string)
+		names := append(maps.Keys(match.Subgraph.Vertices), maps.Keys(match.Subgraph.Connections)...)
+		for _, name := range names {
+			if _, ok := refs.whole[name]; ok {
+				continue
+			}
+			props := maps.Keys(refs.properties[name])
+			slices.Sort(props)
+			match.Properties[name] = props
+		}
+	}
+}
+
+func (r *propertyReferences) collectPlan(plan PhysicalPlan) {
+	var exprs []expression.Expression
+	switch p := plan.(type) {
+	case *PhysicalMatch:
+		r.matches = append(r.matches, p)
+		exprs = p.Filters()
+		for _, lookup := range p.IndexLookups {
+			exprs = append(exprs, lookup.Values...)
+		}
+		for _, conn := range p.Subgraph.Connections {
+			if path, ok := conn.(*VariableLengthPath); ok {
+				exprs = append(exprs, path.Constraints, path.Cost)
+			}
+		}
+	case *PhysicalProjection:
+		exprs = p.Exprs
+	case *PhysicalSelection:
+		exprs = []expression.Expression{p.Condition}
+	case *PhysicalHashAgg:
+		exprs = append(exprs, p.GroupByItems...)
+		for _, aggFunc := range p.AggFuncs {
+			exprs = append(exprs, aggFunc)
+		}
+	case *PhysicalSort:
+		exprs = byItemExprs(p.ByItems)
+	case *PhysicalTopN:
+		exprs = byItemExprs(p.ByItems)
+	}
+	for _, expr := range exprs {
+		r.collectExpr(expr)
+	}
+	for _, child := range plan.Children() {
+		r.collectPlan(child)
+	}
+}
+
+func (r *propertyReferences) collectExpr(expr expression.Expression) {
+	expression.Walk(expr, func(e expression.Expression) bool {
+		switch x := e.(type) {
+		case *expression.PropertyAccess:
+			var name string
+			switch v := x.Expr.(type) {
+			case *expression.Column:
+				name = v.Name.L
+			case *expression.CorrelatedColumn:
+				name = v.Name.L
+			default:
+				return true
+			}
+			if r.properties[name] == nil {
+				r.properties[name] = make(map[string]struct{})
+			}
+			r.properties[name][x.PropertyName.L] = struct{}{}
+			return false
+		case *expression.Column:
+			r.whole[x.Name.L] = struct{}{}
+		case *expression.CorrelatedColumn:
+			r.whole[x.Name.L] = struct{}{}
+		}
+		return true
+	})
+}
+
+func byItemExprs(byItems []*ByItem) []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(byItems))
+	for _, item := range byItems {
+		exprs = append(exprs, item.Expr)
+	}
+	return exprs
+}
@@ -19,6 +19,14 @@ import (
 	"github.com/simbiont-runtime/graphengine/types"
 )
 
+// MatchNumberColumnName is the name of the hidden column of match results, which
+// is the 1-based number of the match in the MATCH clauses.
+var MatchNumberColumnName = model.NewCIStr("__match_number")
+
+// anonymousVarPrefix is the name prefix of anonymous variables, which are named
+// by the compiler and not selected by SELECT *.
+const anonymousVarPrefix = "__anonymous_"
+
 type ResultColumn struct {
 	Name   model.CIStr
 	Type   types.T
@@ -50,5 +58,19 @@ func ResultColumnsFromSubgraph(sg *Subgraph) ResultColumns {
 			Type: colType,
 		})
 	}
+	// The group variables of variable-length paths are bound to the lists of
+	// vertices or edges along the paths.
+	for _, v := range sg.GroupVars {
+		cols = append(cols, ResultColumn{
+			Name: v.Name,
+			Type: types.List,
+		})
+	}
+	// The matches are numbered by MATCH_NUMBER.
+	cols = append(cols, ResultColumn{
+		Name:   MatchNumberColumnName,
+		Type:   types.Int,
+		Hidden: true,
+	})
 	return cols
 }
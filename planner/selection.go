@@ -15,3 +15,8 @@ type PhysicalSelection struct {
 
 	Condition expression.Expression
 }
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalSelection) ExplainInfo() string {
+	return p.Condition.String()
+}
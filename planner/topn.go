@@ -0,0 +1,21 @@
+// ---
+
+package planner
+
+import "github.com/simbiont-runtime/graphengine/expression"
+
+// PhysicalTopN represents an ORDER BY clause followed by a LIMIT clause. Only
+// the first Offset+Count rows need to be retained, so there is no need to sort
+// the whole result set.
+type PhysicalTopN struct {
+	basePhysicalPlan
+
+	ByItems []*ByItem
+	Offset  expression.Expression
+	Count   expression.Expression
+}
+
+// ExplainInfo implements the Plan interface.
+func (p *PhysicalTopN) ExplainInfo() string {
+	return explainByItems(p.ByItems) + ", " + explainOffsetCount(p.Offset, p.Count)
+}
@@ -0,0 +1,30 @@
+// ---
+
+package planner
+
+import (
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/expression"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+)
+
+// Update represents the plan of UPDATE statement.
+type Update struct {
+	basePlan
+
+	Graph     *catalog.Graph
+	Updates   []*ElementUpdate
+	MatchPlan Plan
+}
+
+// ElementUpdate represents a graph element update.
+//
+// UPDATE x SET ( x.age = 42 ) FROM MATCH (x:Person) WHERE x.name = 'John'
+// -------^---------------------------------------------------------------
+// VariableName is the name of the updated vertex/edge variable and VariableIndex
+// is the column index of the variable in the output row of MatchPlan.
+type ElementUpdate struct {
+	VariableName  model.CIStr
+	VariableIndex int
+	Assignments   []*expression.Assignment
+}
@@ -0,0 +1,141 @@
+// ---
+
+package session
+
+import (
+	"container/list"
+	"strings"
+
+	"github.com/simbiont-runtime/graphengine/parser/ast"
+	"github.com/simbiont-runtime/graphengine/planner"
+)
+
+// DefaultPlanCacheSize is the default number of plans cached by a session.
+const DefaultPlanCacheSize = 100
+
+// planCacheKey identifies a cached plan. The plan is resolved against the current
+// graph and the catalog, so it can be reused only if both of them are unchanged.
+type planCacheKey struct {
+	graph         string
+	query         string
+	schemaVersion int64
+}
+
+// cachedPlan is a compiled statement which can be executed repeatedly with
+// different values of bind variables.
+type cachedPlan struct {
+	key planCacheKey
+	// plan is nil if the query is empty.
+	plan      planner.Plan
+	numParams int
+	// cacheable reports whether the plan can be executed repeatedly. Otherwise,
+	// the query must be compiled every time.
+	cacheable bool
+}
+
+// planCache is an LRU cache of the compiled plans.
+type planCache struct {
+	capacity int
+	lru      *list.List
+	items    map[planCacheKey]*list.Element
+}
+
+func newPlanCache(capacity int) *planCache {
+	return &planCache{
+		capacity: capacity,
+		lru:      list.New(),
+		items:    make(map[planCacheKey]*list.Element),
+	}
+}
+
+// Get returns the cached plan and marks it as the most recently used.
+func (c *planCache) Get(key planCacheKey) (*cachedPlan, bool) {
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cachedPlan), true
+}
+
+// Put adds the plan into cache, and evicts the least recently used plans if
+// the capacity is exceeded.
+func (c *planCache) Put(plan *cachedPlan) {
+	if c.capacity <= 0 {
+		return
+	}
+	if elem, ok := c.items[plan.key]; ok {
+		elem.Value = plan
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.items[plan.key] = c.lru.PushFront(plan)
+	for c.lru.Len() > c.capacity {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.items, elem.Value.(*cachedPlan).key)
+	}
+}
+
+// Len returns the number of cached plans.
+func (c *planCache) Len() int {
+	return c.lru.Len()
+}
+
+// SetCapacity changes the capacity of cache and evicts the plans exceeded.
+func (c *planCache) SetCapacity(capacity int) {
+	c.capacity = capacity
+	for c.lru.Len() > 0 && c.lru.Len() > capacity {
+		elem := c.lru.Back()
+		c.lru.Remove(elem)
+		delete(c.items, elem.Value.(*cachedPlan).key)
+	}
+}
+
+// cacheable reports whether the plan of statement can be cached. The statements
+// which change the session or the catalog are compiled every time.
+func cacheable(node ast.StmtNode) bool {
+	switch node.(type) {
+	case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.ExplainStmt:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeQuery normalizes the query text to be used as the key of plan cache.
+// The leading and trailing spaces are removed, and the consecutive spaces outside
+// the quoted strings and identifiers are replaced with a single space. The text
+// is not changed otherwise, since the names of result columns are derived from it.
+func normalizeQuery(query string) string {
+	var (
+		sb      strings.Builder
+		quote   rune
+		escaped bool
+		spaces  bool
+	)
+	sb.Grow(len(query))
+	for _, r := range strings.TrimSpace(query) {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' && quote != '`' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			spaces = true
+			continue
+		}
+		if spaces {
+			sb.WriteByte(' ')
+			spaces = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
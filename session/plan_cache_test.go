@@ -0,0 +1,96 @@
+// ---
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanCache(t *testing.T) {
+	key := func(query string) planCacheKey {
+		return planCacheKey{graph: "g", query: query}
+	}
+	cache := newPlanCache(2)
+	cache.Put(&cachedPlan{key: key("a")})
+	cache.Put(&cachedPlan{key: key("b")})
+	_, ok := cache.Get(key("a"))
+	require.True(t, ok)
+
+	// The least recently used plan is evicted.
+	cache.Put(&cachedPlan{key: key("c")})
+	require.Equal(t, 2, cache.Len())
+	_, ok = cache.Get(key("b"))
+	require.False(t, ok)
+	_, ok = cache.Get(key("a"))
+	require.True(t, ok)
+
+	// The same query against another schema version is a different plan.
+	_, ok = cache.Get(planCacheKey{graph: "g", query: "a", schemaVersion: 1})
+	require.False(t, ok)
+
+	cache.SetCapacity(0)
+	require.Equal(t, 0, cache.Len())
+	cache.Put(&cachedPlan{key: key("a")})
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	cases := []struct {
+		query      string
+		normalized string
+	}{
+		{"  SELECT  x.name\n FROM\tMATCH (x)  ", "SELECT x.name FROM MATCH (x)"},
+		{"SELECT x FROM MATCH (x) WHERE x.name = 'a  b'", "SELECT x FROM MATCH (x) WHERE x.name = 'a  b'"},
+		{`SELECT x FROM MATCH (x) WHERE x.name = 'a\'  b'   LIMIT 1`, `SELECT x FROM MATCH (x) WHERE x.name = 'a\'  b' LIMIT 1`},
+		{"SELECT `a  b`  FROM MATCH (x)", "SELECT `a  b` FROM MATCH (x)"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.normalized, normalizeQuery(c.query), c.query)
+	}
+}
+
+func TestSession_PlanCache(t *testing.T) {
+	store, err := storage.Open(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+	snapshot, err := store.Snapshot(store.CurrentVersion())
+	require.NoError(t, err)
+	cat, err := catalog.Load(snapshot)
+	require.NoError(t, err)
+
+	s := New(store, cat)
+	defer s.Close()
+
+	ctx := context.Background()
+	mustExecute := func(query string) {
+		rs, err := s.Execute(ctx, query)
+		require.NoError(t, err, query)
+		require.NoError(t, rs.Next(ctx), query)
+		require.NoError(t, rs.Close(), query)
+	}
+	mustExecute("CREATE GRAPH g")
+	mustExecute("USE g")
+	mustExecute("CREATE LABEL Person")
+	mustExecute("INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Lee')")
+	require.Equal(t, 0, s.planCache.Len())
+
+	// The statements which differ only in spaces share the same plan.
+	mustExecute("SELECT x.name FROM MATCH (x:Person)")
+	mustExecute("SELECT  x.name\nFROM MATCH (x:Person)")
+	require.Equal(t, 1, s.planCache.Len())
+
+	// The plans are invalidated by the DDL statements.
+	mustExecute("CREATE LABEL Company")
+	mustExecute("SELECT x.name FROM MATCH (x:Person)")
+	require.Equal(t, 2, s.planCache.Len())
+
+	// The plan cache is disabled if the size is not positive.
+	s.SetPlanCacheSize(0)
+	mustExecute("SELECT x.name FROM MATCH (x:Person)")
+	require.Equal(t, 0, s.planCache.Len())
+}
@@ -0,0 +1,43 @@
+// ---
+
+package session
+
+import (
+	"context"
+
+	"github.com/simbiont-runtime/graphengine/datum"
+)
+
+// PreparedStmt is a statement compiled by Session.Prepare, which can be executed
+// repeatedly with different values of bind variables.
+type PreparedStmt struct {
+	session *Session
+	query   string
+	plan    *cachedPlan
+}
+
+// NumParams returns the number of bind variables in the statement.
+func (p *PreparedStmt) NumParams() int {
+	return p.plan.numParams
+}
+
+// Execute executes the statement with the values of bind variables. The statement
+// is compiled again if the plan cannot be reused, e.g: the catalog has changed
+// since the statement was prepared.
+func (p *PreparedStmt) Execute(ctx context.Context, params ...datum.Datum) (ResultSet, error) {
+	s := p.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, done := s.enter(ctx)
+	defer done()
+
+	if !p.plan.cacheable || !s.validPlan(p.plan) {
+		plan, err := s.compile(ctx, p.query)
+		if err != nil {
+			return nil, err
+		}
+		p.plan = plan
+	}
+	return s.executePlan(ctx, p.plan, params)
+}
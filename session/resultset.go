@@ -19,6 +19,7 @@ import (
 
 	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/executor"
+	"github.com/simbiont-runtime/graphengine/stmtctx"
 )
 
 // ResultSet represents the result of a query.
@@ -61,15 +62,19 @@ func (e emptyResultSet) Close() error {
 	return nil
 }
 
-// queryResultSet is a wrapper of executor.RecordSet. It
+// queryResultSet is a wrapper of executor.RecordSet. It finishes the statement
+// in the session transaction after all rows are consumed or the result set is
+// closed.
 type queryResultSet struct {
-	valid bool
-	row   datum.Row
-	exec  executor.Executor
+	valid    bool
+	finished bool
+	row      datum.Row
+	sc       *stmtctx.Context
+	exec     executor.Executor
 }
 
-func newQueryResultSet(exec executor.Executor) ResultSet {
-	return &queryResultSet{valid: true, exec: exec}
+func newQueryResultSet(sc *stmtctx.Context, exec executor.Executor) ResultSet {
+	return &queryResultSet{valid: true, sc: sc, exec: exec}
 }
 
 // Columns implements the ResultSet.Columns.
@@ -88,13 +93,20 @@ func (q *queryResultSet) Valid() bool {
 
 // Next implements the ResultSet.Next.
 func (q *queryResultSet) Next(ctx context.Context) error {
+	if q.finished {
+		q.valid = false
+		q.row = nil
+		return nil
+	}
 	r, err := q.exec.Next(ctx)
 	if err != nil {
-		return err
+		q.valid = false
+		return q.finish(ctx, err)
 	}
 	q.row = r
 	if r == nil {
 		q.valid = false
+		return q.finish(ctx, nil)
 	}
 	return nil
 }
@@ -108,5 +120,14 @@ func (q *queryResultSet) Row() datum.Row {
 func (q *queryResultSet) Close() error {
 	q.valid = false
 	q.row = nil
-	return q.exec.Close()
+	err := q.exec.Close()
+	if q.finished {
+		return err
+	}
+	return q.finish(context.Background(), err)
+}
+
+func (q *queryResultSet) finish(ctx context.Context, err error) error {
+	q.finished = true
+	return q.sc.Txn().FinishStmt(ctx, err)
 }
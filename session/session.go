@@ -10,8 +10,9 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/catalog"
 	"github.com/simbiont-runtime/graphengine/compiler"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/executor"
 	"github.com/simbiont-runtime/graphengine/parser"
-	"github.com/simbiont-runtime/graphengine/parser/ast"
 	"github.com/simbiont-runtime/graphengine/stmtctx"
 	"github.com/simbiont-runtime/graphengine/storage/kv"
 )
@@ -37,6 +38,9 @@ type Session struct {
 	closed   atomic.Bool
 	cancelFn context.CancelFunc
 
+	// planCache caches the plans of recently executed statements.
+	planCache *planCache
+
 	// Callback function while session closing.
 	closeCallback func(s *Session)
 }
@@ -44,10 +48,11 @@ type Session struct {
 // New returns a new session instance.
 func New(store kv.Storage, catalog *catalog.Catalog) *Session {
 	return &Session{
-		id:      idGenerator.Add(1),
-		sc:      stmtctx.New(store, catalog),
-		store:   store,
-		catalog: catalog,
+		id:        idGenerator.Add(1),
+		sc:        stmtctx.New(store, catalog),
+		store:     store,
+		catalog:   catalog,
+		planCache: newPlanCache(DefaultPlanCacheSize),
 	}
 }
 
@@ -62,15 +67,62 @@ func (s *Session) StmtContext() *stmtctx.Context {
 }
 
 // Execute executes a query and reports whether the query executed successfully or not.
-// A result set will be non-empty if execute successfully.
-func (s *Session) Execute(ctx context.Context, query string) (ResultSet, error) {
+// A result set will be non-empty if execute successfully. The params are the values
+// of bind variables (?) in the query, in the order of their appearance.
+func (s *Session) Execute(ctx context.Context, query string, params ...datum.Datum) (ResultSet, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	ctx, done := s.enter(ctx)
+	defer done()
+
+	plan, err := s.compile(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.executePlan(ctx, plan, params)
+}
+
+// Prepare compiles the query into a statement which can be executed repeatedly
+// with different values of bind variables.
+func (s *Session) Prepare(ctx context.Context, query string) (*PreparedStmt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, done := s.enter(ctx)
+	defer done()
+
+	plan, err := s.compile(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &PreparedStmt{session: s, query: query, plan: plan}, nil
+}
+
+// enter prepares for executing the next statement, which can be canceled by
+// closing the session. The returned function must be called after the statement
+// finished.
+func (s *Session) enter(ctx context.Context) (context.Context, func()) {
 	ctx, cancelFn := context.WithCancel(ctx)
 	s.cancelFn = cancelFn
 	s.wg.Add(1)
-	defer s.wg.Done()
+
+	// Reset the current statement context and prepare for executing the next statement.
+	s.sc.Reset()
+	return ctx, s.wg.Done
+}
+
+// compile compiles the query into a plan, and the plan of the same query is
+// reused from the plan cache if the current graph and the catalog are unchanged.
+func (s *Session) compile(ctx context.Context, query string) (*cachedPlan, error) {
+	key := planCacheKey{
+		graph:         s.sc.CurrentGraphName(),
+		query:         normalizeQuery(query),
+		schemaVersion: s.catalog.SchemaVersion(),
+	}
+	if plan, ok := s.planCache.Get(key); ok {
+		return plan, nil
+	}
 
 	p := parserPool.Get().(*parser.Parser)
 	defer parserPool.Put(p)
@@ -83,31 +135,92 @@ func (s *Session) Execute(ctx context.Context, query string) (ResultSet, error)
 		s.sc.AppendWarning(errors.Annotate(warn, "parse warning"))
 	}
 	if len(stmts) == 0 {
-		return emptyResultSet{}, nil
+		return &cachedPlan{key: key}, nil
 	}
 	if len(stmts) > 1 {
 		return nil, ErrMultipleStatementsNotSupported
 	}
 
-	return s.executeStmt(ctx, stmts[0])
+	node := stmts[0]
+	numParams := compiler.BindVariableCount(node)
+	plan, err := compiler.BuildPlan(s.sc, node)
+	if err != nil {
+		return nil, s.sc.Txn().FinishStmt(ctx, err)
+	}
+	cached := &cachedPlan{
+		key:       key,
+		plan:      plan,
+		numParams: numParams,
+		cacheable: cacheable(node),
+	}
+	// The plan may be resolved against a newer catalog if the catalog is changed
+	// while compiling (e.g: the missing properties are created), in which case the
+	// plan is not cached.
+	if cached.cacheable && key.schemaVersion == s.catalog.SchemaVersion() {
+		s.planCache.Put(cached)
+	}
+	return cached, nil
 }
 
-func (s *Session) executeStmt(ctx context.Context, node ast.StmtNode) (ResultSet, error) {
-	// TODO: support transaction
+// validPlan reports whether the plan is compiled against the current graph and
+// the current catalog.
+func (s *Session) validPlan(plan *cachedPlan) bool {
+	return plan.key.graph == s.sc.CurrentGraphName() && plan.key.schemaVersion == s.catalog.SchemaVersion()
+}
 
-	// Reset the current statement context and prepare for executing the next statement.
-	s.sc.Reset()
+func (s *Session) executePlan(ctx context.Context, plan *cachedPlan, params []datum.Datum) (ResultSet, error) {
+	if plan.plan == nil {
+		return emptyResultSet{}, nil
+	}
+	if plan.numParams != len(params) {
+		return nil, errors.Errorf("wrong number of bind variables: expected %d, got %d", plan.numParams, len(params))
+	}
+	s.sc.SetParams(params)
 
-	exec, err := compiler.Compile(s.sc, node)
-	if err != nil {
-		return nil, err
+	execBuilder := executor.NewBuilder(s.sc)
+	exec := execBuilder.Build(plan.plan)
+	if err := execBuilder.Error(); err != nil {
+		return nil, s.sc.Txn().FinishStmt(ctx, err)
 	}
-	err = exec.Open(ctx)
-	if err != nil {
-		return nil, err
+	if err := exec.Open(ctx); err != nil {
+		return nil, s.sc.Txn().FinishStmt(ctx, err)
 	}
 
-	return newQueryResultSet(exec), nil
+	return newQueryResultSet(s.sc, exec), nil
+}
+
+// SetPlanCacheSize changes the maximum number of plans cached by the session.
+// The plan cache is disabled if the size is not positive.
+func (s *Session) SetPlanCacheSize(size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.planCache.SetCapacity(size)
+}
+
+// BeginTxn starts an explicit transaction like the BEGIN statement. All writes
+// will be rejected if the transaction is read-only.
+func (s *Session) BeginTxn(ctx context.Context, readOnly bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sc.Txn().Begin(ctx, readOnly)
+}
+
+// CommitTxn commits the current transaction like the COMMIT statement.
+func (s *Session) CommitTxn(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sc.Txn().Commit(ctx)
+}
+
+// RollbackTxn rollbacks the current transaction like the ROLLBACK statement.
+func (s *Session) RollbackTxn() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sc.Txn().Rollback()
 }
 
 // Close terminates the current session.
@@ -3,11 +3,14 @@
 package session_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/simbiont-runtime/graphengine"
+	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/session"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNew(t *testing.T) {
@@ -37,3 +40,56 @@ func TestSession_OnClosed(t *testing.T) {
 	s.Close()
 	assert.True(closed)
 }
+
+func TestSession_Prepare(t *testing.T) {
+	db, err := graphengine.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := db.NewSession()
+	defer s.Close()
+
+	ctx := context.Background()
+	for _, query := range []string{
+		"CREATE GRAPH g",
+		"USE g",
+		"CREATE LABEL Person",
+		"INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Kathrine', x.age = 20)",
+		"INSERT VERTEX x LABELS (Person) PROPERTIES (x.name = 'Riya', x.age = 30)",
+	} {
+		rs, err := s.Execute(ctx, query)
+		require.NoError(t, err, query)
+		require.NoError(t, rs.Next(ctx), query)
+		require.NoError(t, rs.Close(), query)
+	}
+
+	stmt, err := s.Prepare(ctx, "SELECT x.age FROM MATCH (x:Person) WHERE x.name = ?")
+	require.NoError(t, err)
+	require.Equal(t, 1, stmt.NumParams())
+
+	queryAge := func(name string) []datum.Row {
+		rs, err := stmt.Execute(ctx, datum.NewString(name))
+		require.NoError(t, err)
+		defer rs.Close()
+		var rows []datum.Row
+		for {
+			require.NoError(t, rs.Next(ctx))
+			if !rs.Valid() {
+				return rows
+			}
+			rows = append(rows, rs.Row())
+		}
+	}
+	require.Equal(t, []datum.Row{{datum.NewInt(20)}}, queryAge("Kathrine"))
+	require.Equal(t, []datum.Row{{datum.NewInt(30)}}, queryAge("Riya"))
+
+	// The statement is compiled again after the catalog is changed.
+	rs, err := s.Execute(ctx, "CREATE INDEX idx_name (name)")
+	require.NoError(t, err)
+	require.NoError(t, rs.Next(ctx))
+	require.NoError(t, rs.Close())
+	require.Equal(t, []datum.Row{{datum.NewInt(30)}}, queryAge("Riya"))
+
+	_, err = stmt.Execute(ctx)
+	require.ErrorContains(t, err, "wrong number of bind variables")
+}
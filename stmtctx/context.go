@@ -7,10 +7,15 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/pingcap/errors"
 	"github.com/simbiont-runtime/graphengine/catalog"
+	"github.com/simbiont-runtime/graphengine/datum"
 	"github.com/simbiont-runtime/graphengine/storage/kv"
 )
 
+// DefaultMemQuotaSort is the default memory quota of the sort operator.
+const DefaultMemQuotaSort = 64 << 20
+
 // Context represent the intermediate state of a query execution and will be
 // reset after a query finished.
 type Context struct {
@@ -38,14 +43,31 @@ type Context struct {
 	// TODO: perhaps we can move these to a separate struct.
 	planID       atomic.Int64
 	planColumnID atomic.Int64
+
+	// memQuotaSort is the memory quota (in bytes) of the sort operator. The
+	// rows will be spilled to disk if the quota is exceeded.
+	memQuotaSort atomic.Int64
+
+	// indexVersion is the index version of the catalog when the current
+	// statement started.
+	indexVersion int64
+
+	// params are the values of bind variables of the current statement.
+	params []datum.Datum
+	// outerRows are the rows of the outer queries which the correlated subqueries
+	// are evaluated for, and the row of the innermost outer query is the last.
+	outerRows []datum.Row
 }
 
 // New returns a session statement context instance.
 func New(store kv.Storage, catalog *catalog.Catalog) *Context {
-	return &Context{
+	sc := &Context{
 		store:   store,
 		catalog: catalog,
 	}
+	sc.indexVersion = catalog.IndexVersion()
+	sc.memQuotaSort.Store(DefaultMemQuotaSort)
+	return sc
 }
 
 // Reset resets all variables associated to execute a query.
@@ -62,6 +84,9 @@ func (sc *Context) Reset() {
 	sc.mu.touched = 0
 	sc.mu.warnings = sc.mu.warnings[:0]
 	sc.mu.errorCount = 0
+	sc.params = nil
+	sc.outerRows = nil
+	sc.indexVersion = sc.catalog.IndexVersion()
 }
 
 // Store returns the storage instance.
@@ -98,6 +123,97 @@ func (sc *Context) CurrentGraphName() string {
 	return sc.mu.currentGraph
 }
 
+// AddAffectedRows adds affected rows of the current statement.
+func (sc *Context) AddAffectedRows(rows uint64) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.mu.affectedRows += rows
+}
+
+// AffectedRows returns the affected rows of the current statement.
+func (sc *Context) AffectedRows() uint64 {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	return sc.mu.affectedRows
+}
+
+// AddUpdatedRows adds updated rows of the current statement.
+func (sc *Context) AddUpdatedRows(rows uint64) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.mu.updated += rows
+}
+
+// UpdatedRows returns the updated rows of the current statement.
+func (sc *Context) UpdatedRows() uint64 {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	return sc.mu.updated
+}
+
+// AddDeletedRows adds deleted rows of the current statement.
+func (sc *Context) AddDeletedRows(rows uint64) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.mu.deleted += rows
+}
+
+// DeletedRows returns the deleted rows of the current statement.
+func (sc *Context) DeletedRows() uint64 {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	return sc.mu.deleted
+}
+
+// MemQuotaSort returns the memory quota (in bytes) of the sort operator.
+func (sc *Context) MemQuotaSort() int64 {
+	return sc.memQuotaSort.Load()
+}
+
+// SetMemQuotaSort changes the memory quota (in bytes) of the sort operator.
+func (sc *Context) SetMemQuotaSort(quota int64) {
+	sc.memQuotaSort.Store(quota)
+}
+
+// SetParams sets the values of bind variables of the current statement.
+func (sc *Context) SetParams(params []datum.Datum) {
+	sc.params = params
+}
+
+// Param returns the value of the bind variable at the given position.
+func (sc *Context) Param(order int) (datum.Datum, error) {
+	if order < 0 || order >= len(sc.params) {
+		return nil, errors.Errorf("missing value for bind variable %d", order+1)
+	}
+	return sc.params[order], nil
+}
+
+// PushOuterRow pushes the row of the outer query before evaluating a correlated
+// subquery for it.
+func (sc *Context) PushOuterRow(row datum.Row) {
+	sc.outerRows = append(sc.outerRows, row)
+}
+
+// PopOuterRow pops the row pushed by PushOuterRow.
+func (sc *Context) PopOuterRow() {
+	sc.outerRows = sc.outerRows[:len(sc.outerRows)-1]
+}
+
+// OuterRow returns the row of the outer query at the given depth, and the depth
+// of the immediate outer query is 1.
+func (sc *Context) OuterRow(depth int) (datum.Row, error) {
+	if depth < 1 || depth > len(sc.outerRows) {
+		return nil, errors.Errorf("missing outer row at depth %d", depth)
+	}
+	return sc.outerRows[len(sc.outerRows)-depth], nil
+}
+
 func (sc *Context) AllocPlanID() int {
 	return int(sc.planID.Add(1))
 }
@@ -1,20 +1,52 @@
 package stmtctx
 
-import "github.com/simbiont-runtime/graphengine/storage/kv"
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// ErrReadOnlyTxn is returned if a statement writes in a read-only transaction.
+var ErrReadOnlyTxn = errors.New("cannot execute write statement in a read-only transaction")
+
+// ErrIndexChanged is returned if the indexes are changed by DDL after the
+// transaction started writing, in which case the index keys written by the
+// transaction may be stale.
+var ErrIndexChanged = errors.New("indexes changed by a concurrent DDL statement, please retry the transaction")
 
 type (
 	txnStatus byte
 
+	// LazyTxn is the transaction held by the session across statements. The
+	// underlying kv.Transaction is not started until the first statement which
+	// accesses the storage.
+	//
+	// If the autocommit mode is enabled (the default), every statement outside
+	// an explicit transaction (started by BEGIN) is committed when it finished.
 	LazyTxn struct {
 		sc         *Context
 		status     txnStatus
 		autocommit bool
-		txn        kv.Transaction
+		// explicit reports whether the transaction is started by BEGIN and
+		// will be finished by COMMIT or ROLLBACK.
+		explicit bool
+		readOnly bool
+		txn      kv.Transaction
+		// staging is the handle of the staging buffer which holds the writes of
+		// the current statement, or 0 if the statement hasn't written anything.
+		staging int
+		// indexVersion is the index version of the catalog when the first
+		// statement of the transaction started.
+		indexVersion int64
 	}
 )
 
 const (
 	txnStatusPending txnStatus = iota
+	txnStatusValid
 )
 
 // Txn returns the transaction object.
@@ -23,12 +55,197 @@ func (sc *Context) Txn() *LazyTxn {
 	defer sc.mu.Unlock()
 	if sc.mu.txn == nil {
 		sc.mu.txn = &LazyTxn{
-			sc: sc,
+			sc:         sc,
+			autocommit: true,
 		}
 	}
 	return sc.mu.txn
 }
 
-func (txn *LazyTxn) validOrPending() bool {
-	return false
+// Autocommit reports whether the autocommit mode is enabled.
+func (txn *LazyTxn) Autocommit() bool {
+	return txn.autocommit
+}
+
+// SetAutocommit changes the autocommit mode. The statements are kept in the
+// same transaction until COMMIT or ROLLBACK if the autocommit is disabled.
+func (txn *LazyTxn) SetAutocommit(autocommit bool) {
+	txn.autocommit = autocommit
+}
+
+// InTxn reports whether the session is in a transaction which spans multiple
+// statements, i.e. an explicit transaction or any transaction with autocommit
+// mode disabled.
+func (txn *LazyTxn) InTxn() bool {
+	return txn.explicit || !txn.autocommit && txn.valid()
+}
+
+// ReadOnly reports whether the current transaction is read-only.
+func (txn *LazyTxn) ReadOnly() bool {
+	return txn.readOnly
+}
+
+// Begin starts an explicit transaction. The current transaction will be committed
+// implicitly if there is one.
+func (txn *LazyTxn) Begin(ctx context.Context, readOnly bool) error {
+	if err := txn.Commit(ctx); err != nil {
+		return err
+	}
+	txn.explicit = true
+	txn.readOnly = readOnly
+	return nil
+}
+
+// Activate returns the underlying transaction and starts it if not started yet.
+// The returned transaction rejects all writes if the transaction is read-only.
+// The writes of the current statement are staged until the statement finished.
+func (txn *LazyTxn) Activate() (kv.Transaction, error) {
+	if !txn.valid() {
+		t, err := txn.sc.store.Begin()
+		if err != nil {
+			return nil, err
+		}
+		txn.txn = t
+		txn.status = txnStatusValid
+		txn.indexVersion = txn.sc.indexVersion
+	}
+	if txn.staging == 0 {
+		txn.staging = txn.txn.Staging()
+	}
+	if txn.readOnly {
+		return readOnlyTxn{txn.txn}, nil
+	}
+	return txn.txn, nil
+}
+
+// Commit commits the current transaction. It is a no-op if there is no transaction.
+func (txn *LazyTxn) Commit(ctx context.Context) error {
+	defer txn.reset()
+	if !txn.valid() {
+		return nil
+	}
+
+	// The DDL is blocked until the transaction committed. The transaction is
+	// rejected if the indexes are changed after it started, otherwise the new
+	// index misses the keys of the vertices written by the transaction.
+	catalog := txn.sc.Catalog()
+	catalog.MDRLock()
+	defer catalog.MDRUnlock()
+	if txn.txn.Len() > 0 && catalog.IndexVersion() != txn.indexVersion {
+		_ = txn.txn.Rollback()
+		return ErrIndexChanged
+	}
+
+	err := txn.txn.Commit(ctx)
+	if err != nil {
+		return txn.duplicateKeyError(ctx, err)
+	}
+	return nil
+}
+
+// duplicateKeyError converts the commit error caused by a unique index key
+// inserted by a concurrent transaction to meta.ErrDuplicateKey. Other errors
+// are returned unchanged.
+func (txn *LazyTxn) duplicateKeyError(ctx context.Context, err error) error {
+	var key kv.Key
+	switch e := errors.Cause(err).(type) {
+	case *kv.ErrKeyAlreadyExist:
+		key = e.Key
+	case *kv.ErrConflict:
+		key = e.Key
+	default:
+		return err
+	}
+	graphID, indexID, _, _, perr := codec.ParseUniqueIndexKey(key)
+	if perr != nil {
+		return err
+	}
+	graph := txn.sc.Catalog().GraphByID(graphID)
+	if graph == nil {
+		return err
+	}
+	index := graph.IndexByID(indexID)
+	if index == nil || !index.Meta().Unique {
+		return err
+	}
+	store := txn.sc.store
+	snapshot, serr := store.Snapshot(store.CurrentVersion())
+	if serr != nil {
+		return err
+	}
+	val, gerr := snapshot.Get(ctx, key)
+	if gerr != nil {
+		return err
+	}
+	_, vertexID, derr := codec.DecodeInt(val)
+	if derr != nil {
+		return err
+	}
+	return meta.NewErrDuplicateKey(index.Meta(), vertexID)
+}
+
+// Rollback rollbacks the current transaction. It is a no-op if there is no transaction.
+func (txn *LazyTxn) Rollback() error {
+	defer txn.reset()
+	if !txn.valid() {
+		return nil
+	}
+	return txn.txn.Rollback()
+}
+
+// FinishStmt is called after a statement finished with the error of statement
+// (nil if succeed). The transaction is committed (or rolled back if the statement
+// failed) unless the session is in a transaction spans multiple statements.
+func (txn *LazyTxn) FinishStmt(ctx context.Context, err error) error {
+	if txn.InTxn() {
+		// Discard the writes of the failed statement, so that the partial
+		// changes are not committed with the transaction.
+		if txn.staging != 0 {
+			if err != nil {
+				txn.txn.Cleanup(txn.staging)
+			} else {
+				txn.txn.Release(txn.staging)
+			}
+			txn.staging = 0
+		}
+		return err
+	}
+	if err != nil {
+		_ = txn.Rollback()
+		return err
+	}
+	return txn.Commit(ctx)
+}
+
+func (txn *LazyTxn) reset() {
+	txn.status = txnStatusPending
+	txn.explicit = false
+	txn.readOnly = false
+	txn.txn = nil
+	txn.staging = 0
+}
+
+// valid reports whether the underlying transaction has been started.
+func (txn *LazyTxn) valid() bool {
+	return txn.status == txnStatusValid
+}
+
+// readOnlyTxn wraps a transaction and rejects all writes.
+type readOnlyTxn struct {
+	kv.Transaction
+}
+
+// Set implements the kv.Mutator interface.
+func (readOnlyTxn) Set(_ kv.Key, _ []byte) error {
+	return ErrReadOnlyTxn
+}
+
+// SetWithFlags implements the kv.Transaction interface.
+func (readOnlyTxn) SetWithFlags(_ kv.Key, _ []byte, _ ...kv.FlagsOp) error {
+	return ErrReadOnlyTxn
+}
+
+// Delete implements the kv.Mutator interface.
+func (readOnlyTxn) Delete(_ kv.Key) error {
+	return ErrReadOnlyTxn
 }
@@ -69,6 +69,20 @@ type RetrieverMutator interface {
 type Transaction interface {
 	RetrieverMutator
 
+	// SetWithFlags sets the value for key k as v with the flags of the key, e.g:
+	// the key with SetPresumeKeyNotExists is checked not to exist in the kv db
+	// when the transaction is committed.
+	SetWithFlags(k Key, v []byte, ops ...FlagsOp) error
+
+	// Staging creates a staging buffer for the subsequent writes and returns its
+	// handle. The writes are published to the transaction by Release, or
+	// discarded by Cleanup.
+	Staging() int
+	// Release publishes the writes in the staging buffer to the upper level.
+	Release(h int)
+	// Cleanup discards the writes in the staging buffer if not released.
+	Cleanup(h int)
+
 	StartVer() Version
 	// Snapshot returns the Snapshot binding to this transaction.
 	Snapshot() Snapshot
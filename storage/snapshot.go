@@ -103,6 +103,8 @@ func (s *KVSnapshot) Iter(lowerBound kv.Key, upperBound kv.Key) (kv.Iterator, er
 		inner:    inner,
 		resolver: s.resolver,
 		ver:      s.ver,
+		lower:    start,
+		upper:    end,
 	}
 
 	// Handle startKey is nil, in this case, the real startKey
@@ -147,6 +149,8 @@ func (s *KVSnapshot) IterReverse(lowerBound kv.Key, upperBound kv.Key) (kv.Itera
 		inner:    inner,
 		resolver: s.resolver,
 		ver:      s.ver,
+		lower:    start,
+		upper:    end,
 	}
 
 	// Set the next key to the last valid key between lowerBound and upperBound.
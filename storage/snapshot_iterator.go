@@ -19,6 +19,8 @@ type SnapshotIter struct {
 	vp       kv.VersionProvider
 	ver      kv.Version
 	inner    *pebble.Iterator
+	lower    mvcc.Key
+	upper    mvcc.Key
 	resolver *resolver.Scheduler
 	mu       struct {
 		sync.RWMutex
@@ -111,18 +113,23 @@ func (i *SnapshotIter) Next() error {
 }
 
 func (i *SnapshotIter) resetIter() {
-	lowerBound, upperBound := i.inner.RangeBounds()
+	// The bounds must be kept by ourselves because the pebble.Iterator.RangeBounds
+	// returns the bounds of range keys rather than the iterator options.
+	_ = i.inner.Close()
 	if i.reverse {
+		// Decode the current key again from its oldest version and drop the
+		// partial entry decoded before the lock.
 		iter := i.db.NewIter(&pebble.IterOptions{
-			LowerBound: lowerBound,
-			UpperBound: i.nextKey.PrefixNext(),
+			LowerBound: i.lower,
+			UpperBound: kv.Key(mvcc.Encode(i.nextKey, 0)).Next(),
 		})
 		iter.Last()
 		i.inner = iter
+		i.entry = mvcc.Entry{}
 	} else {
 		iter := i.db.NewIter(&pebble.IterOptions{
 			LowerBound: mvcc.LockKey(i.nextKey),
-			UpperBound: upperBound,
+			UpperBound: i.upper,
 		})
 		iter.First()
 		i.inner = iter
@@ -202,6 +209,7 @@ func (i *SnapshotIter) reverseNext() error {
 		if ver == mvcc.LockVer {
 			var lock mvcc.Lock
 			err = lock.UnmarshalBinary(val)
+			i.entry.Lock = &lock
 		} else {
 			var value mvcc.Value
 			err = value.UnmarshalBinary(val)
@@ -244,13 +252,13 @@ func reverse(values []mvcc.Value) {
 }
 
 func (i *SnapshotIter) finishEntry() error {
-	if i.entry.Lock != nil {
-		return i.entry.Lock.LockErr(i.nextKey)
-	}
+	i.mu.RLock()
+	resolved := i.mu.resolved
+	i.mu.RUnlock()
 
 	reverse(i.entry.Values)
 	i.entry.Key = mvcc.NewKey(i.nextKey)
-	val, err := i.entry.Get(i.ver, nil)
+	val, err := i.entry.Get(i.ver, resolved)
 	if err != nil {
 		return err
 	}
@@ -187,3 +187,87 @@ func TestReverseIterator(t *testing.T) {
 		iter.Close()
 	}
 }
+
+func TestIterator_ResolveLock(t *testing.T) {
+	expected := []struct {
+		reverse bool
+		keys    []string
+	}{
+		{reverse: false, keys: []string{"b", "c", "d"}},
+		{reverse: true, keys: []string{"d", "c", "b"}},
+	}
+	for _, e := range expected {
+		s := openLockedStorage(t)
+		snapshot, err := s.Snapshot(1000)
+		assert.Nil(t, err)
+		var iter kv.Iterator
+		if e.reverse {
+			iter, err = snapshot.IterReverse([]byte("b"), []byte("y"))
+		} else {
+			iter, err = snapshot.Iter([]byte("b"), []byte("y"))
+		}
+		assert.Nil(t, err)
+
+		var keys []string
+		for iter.Valid() {
+			keys = append(keys, string(iter.Key()))
+			assert.Equal(t, string(iter.Key())+"_value", string(iter.Value()))
+			err = iter.Next()
+			assert.Nil(t, err)
+		}
+		iter.Close()
+		assert.Equal(t, e.keys, keys, "reverse: %v", e.reverse)
+	}
+}
+
+// openLockedStorage opens a storage whose key "c" is locked by a transaction
+// with the committed primary key "z". The iterators must resolve the lock and
+// reset the inner iterator, which has to keep the bounds [b, y) and skip the
+// keys "a" and "z".
+func openLockedStorage(t *testing.T) kv.Storage {
+	s, err := Open(t.TempDir())
+	assert.Nil(t, err)
+	assert.NotNil(t, s)
+
+	db := s.(*mvccStorage).db
+	writes := db.NewBatch()
+	wo := &pebble.WriteOptions{}
+	for _, key := range []string{"a", "b", "d", "z"} {
+		v := mvcc.Value{
+			Type:      mvcc.ValueTypePut,
+			StartVer:  100,
+			CommitVer: 110,
+			Value:     []byte(key + "_value"),
+		}
+		val, err := v.MarshalBinary()
+		assert.Nil(t, err)
+		err = writes.Set(mvcc.Encode([]byte(key), 110), val, wo)
+		assert.Nil(t, err)
+	}
+
+	lock := mvcc.Lock{
+		StartVer: 200,
+		Primary:  []byte("z"),
+		Value:    []byte("c_value"),
+		Op:       mvcc.Op_Put,
+		TTL:      100,
+	}
+	val, err := lock.MarshalBinary()
+	assert.Nil(t, err)
+	err = writes.Set(mvcc.LockKey([]byte("c")), val, wo)
+	assert.Nil(t, err)
+	commit := mvcc.Value{
+		Type:      mvcc.ValueTypePut,
+		StartVer:  200,
+		CommitVer: 210,
+		Value:     []byte("z_value2"),
+	}
+	val, err = commit.MarshalBinary()
+	assert.Nil(t, err)
+	err = writes.Set(mvcc.Encode([]byte("z"), 210), val, wo)
+	assert.Nil(t, err)
+
+	err = db.Apply(writes, wo)
+	assert.Nil(t, err)
+	return s
+}
@@ -61,11 +61,32 @@ func (txn *Txn) Set(k kv.Key, v []byte) error {
 	return txn.us.MemBuffer().Set(k, v)
 }
 
+// SetWithFlags implements the Transaction interface.
+func (txn *Txn) SetWithFlags(k kv.Key, v []byte, ops ...kv.FlagsOp) error {
+	txn.setCnt++
+	return txn.us.MemBuffer().SetWithFlags(k, v, ops...)
+}
+
 // Delete implements the Transaction interface. It removes the entry for key k from kv store.
 func (txn *Txn) Delete(k kv.Key) error {
 	return txn.us.MemBuffer().Delete(k)
 }
 
+// Staging implements the Transaction interface.
+func (txn *Txn) Staging() int {
+	return txn.us.MemBuffer().Staging()
+}
+
+// Release implements the Transaction interface.
+func (txn *Txn) Release(h int) {
+	txn.us.MemBuffer().Release(h)
+}
+
+// Cleanup implements the Transaction interface.
+func (txn *Txn) Cleanup(h int) {
+	txn.us.MemBuffer().Cleanup(h)
+}
+
 // StartVer implements the Transaction interface.
 func (txn *Txn) StartVer() kv.Version {
 	return txn.startVer
@@ -75,6 +75,43 @@ func TestTxn_Commit(t *testing.T) {
 	}
 }
 
+func TestTxn_PresumeKeyNotExists(t *testing.T) {
+	assert := assert.New(t)
+
+	storage, err := Open(t.TempDir())
+	assert.Nil(err)
+	defer storage.Close()
+
+	// Two transactions insert the same key concurrently.
+	txn1, err := storage.Begin()
+	assert.Nil(err)
+	txn2, err := storage.Begin()
+	assert.Nil(err)
+	assert.Nil(txn1.SetWithFlags(kv.Key("k"), []byte("v1"), kv.SetPresumeKeyNotExists))
+	assert.Nil(txn2.SetWithFlags(kv.Key("k"), []byte("v2"), kv.SetPresumeKeyNotExists))
+	assert.Nil(txn1.Commit(context.Background()))
+	err = txn2.Commit(context.Background())
+	_, ok := err.(*kv.ErrConflict)
+	assert.True(ok, "%v", err)
+
+	// The key exists before the transaction starts.
+	txn3, err := storage.Begin()
+	assert.Nil(err)
+	assert.Nil(txn3.SetWithFlags(kv.Key("k"), []byte("v3"), kv.SetPresumeKeyNotExists))
+	err = txn3.Commit(context.Background())
+	existErr, ok := err.(*kv.ErrKeyAlreadyExist)
+	assert.True(ok, "%v", err)
+	if ok {
+		assert.Equal([]byte("k"), existErr.Key)
+	}
+
+	snapshot, err := storage.Snapshot(storage.CurrentVersion())
+	assert.Nil(err)
+	val, err := snapshot.Get(context.Background(), kv.Key("k"))
+	assert.Nil(err)
+	assert.Equal("v1", string(val))
+}
+
 func TestTxn_Iter(t *testing.T) {
 	assert := assert.New(t)
 
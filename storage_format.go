@@ -0,0 +1,233 @@
+// ---
+
+package graphengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+)
+
+// currentStorageFormat is the version of the storage format written by this
+// build, which is recorded when the data directory is created. The versions
+// of the storage format are:
+//
+//	0: the storage format is not recorded, and the edge keys have no edge IDs.
+//	1: the edge keys end with the edge IDs.
+//	2: the vertices have the label keys of their labels.
+//	3: the vertices have the counters of their incoming and outgoing edges.
+const currentStorageFormat = 3
+
+// storageFormatUpgrades upgrade the data from the version of the key to the
+// next version.
+var storageFormatUpgrades = map[int64]func(ctx context.Context, txn kv.Transaction) error{
+	0: rewriteLegacyEdges,
+	1: backfillVertexLabels,
+	2: backfillDegrees,
+}
+
+// ErrIncompatibleStorageFormat is returned when the data directory is written in
+// a storage format newer than this build.
+var ErrIncompatibleStorageFormat = errors.New("incompatible data directory")
+
+// checkStorageFormat checks the storage format of the data directory, and upgrades
+// the data to the current version. The current version is recorded if the data
+// directory is newly created.
+func checkStorageFormat(ctx context.Context, store kv.Storage) error {
+	return kv.TxnContext(ctx, store, func(ctx context.Context, txn kv.Transaction) error {
+		m := meta.New(txn)
+		version, err := m.StorageFormat()
+		if err != nil {
+			return err
+		}
+		switch {
+		case version == 0:
+			// Any data is written after an ID is allocated, so the data directory
+			// without the storage format is new if no ID is. Otherwise, the data
+			// directory is created before the storage format was recorded.
+			globalID, err := m.GlobalID()
+			if err != nil {
+				return err
+			}
+			if globalID == 0 {
+				return m.SetStorageFormat(currentStorageFormat)
+			}
+		case version > currentStorageFormat:
+			return fmt.Errorf("%w: storage format version %d is newer than %d", ErrIncompatibleStorageFormat, version, currentStorageFormat)
+		case version == currentStorageFormat:
+			return nil
+		}
+		for ; version < currentStorageFormat; version++ {
+			if err := storageFormatUpgrades[version](ctx, txn); err != nil {
+				return err
+			}
+		}
+		return m.SetStorageFormat(currentStorageFormat)
+	})
+}
+
+// rewriteLegacyEdges rewrites the edge keys without the edge IDs, and allocates an
+// edge ID for each edge. There is at most one edge from a vertex to another one
+// in the legacy format, which has both the incoming and outgoing edge keys.
+func rewriteLegacyEdges(_ context.Context, txn kv.Transaction) error {
+	type legacyEdge struct {
+		srcID, dstID int64
+		val          []byte
+	}
+
+	m := meta.New(txn)
+	graphs, err := m.ListGraphs()
+	if err != nil {
+		return err
+	}
+	for _, graph := range graphs {
+		var (
+			legacyKeys []kv.Key
+			edges      []legacyEdge
+		)
+		err = scanGraph(txn, graph.ID, func(key kv.Key, val []byte) error {
+			if !codec.IsLegacyEdgeKey(key) {
+				return nil
+			}
+			_, srcID, dstID, outgoing, err := codec.ParseLegacyEdgeKey(key)
+			if err != nil {
+				return err
+			}
+			legacyKeys = append(legacyKeys, key.Clone())
+			if outgoing {
+				edges = append(edges, legacyEdge{srcID: srcID, dstID: dstID, val: append([]byte(nil), val...)})
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(legacyKeys) == 0 {
+			continue
+		}
+
+		for _, key := range legacyKeys {
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+		if len(edges) == 0 {
+			continue
+		}
+		base, err := m.AdvanceID(graph.ID, len(edges))
+		if err != nil {
+			return err
+		}
+		for i, edge := range edges {
+			edgeID := base + int64(i) + 1
+			if err := txn.Set(codec.IncomingEdgeKey(graph.ID, edge.srcID, edge.dstID, edgeID), edge.val); err != nil {
+				return err
+			}
+			if err := txn.Set(codec.OutgoingEdgeKey(graph.ID, edge.srcID, edge.dstID, edgeID), edge.val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillVertexLabels writes the label keys of the vertices, which are scanned
+// by the labeled vertex patterns instead of all vertices.
+func backfillVertexLabels(_ context.Context, txn kv.Transaction) error {
+	m := meta.New(txn)
+	graphs, err := m.ListGraphs()
+	if err != nil {
+		return err
+	}
+	for _, graph := range graphs {
+		labels, err := m.ListLabels(graph.ID)
+		if err != nil {
+			return err
+		}
+		// The label keys are written after the scan, so that the transaction is not
+		// modified during the iteration.
+		var labelKeys []kv.Key
+		decoder := codec.NewPropertyDecoder(labels, nil)
+		err = scanGraph(txn, graph.ID, func(key kv.Key, val []byte) error {
+			// The edge and degree keys of the vertex follow the vertex key.
+			if len(key) != codec.VertexKeyLen {
+				return nil
+			}
+			_, vertexID, err := codec.ParseVertexKey(key)
+			if err != nil {
+				return err
+			}
+			labelIDs, _, err := decoder.Decode(val)
+			if err != nil {
+				return err
+			}
+			for labelID := range labelIDs {
+				labelKeys = append(labelKeys, codec.VertexLabelKey(graph.ID, labelID, vertexID))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range labelKeys {
+			if err := txn.Set(key, codec.LabelValue()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// backfillDegrees writes the degree counters of the vertices, which are counted
+// from the outgoing edges.
+func backfillDegrees(_ context.Context, txn kv.Transaction) error {
+	graphs, err := meta.New(txn).ListGraphs()
+	if err != nil {
+		return err
+	}
+	for _, graph := range graphs {
+		degrees := map[string]int64{}
+		err = scanGraph(txn, graph.ID, func(key kv.Key, _ []byte) error {
+			if !codec.IsOutgoingEdgeKey(key) {
+				return nil
+			}
+			_, srcID, dstID, _, err := codec.ParseOutgoingEdgeKey(key)
+			if err != nil {
+				return err
+			}
+			degrees[string(codec.OutgoingDegreeKey(graph.ID, srcID))]++
+			degrees[string(codec.IncomingDegreeKey(graph.ID, dstID))]++
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for key, degree := range degrees {
+			if err := txn.Set(kv.Key(key), codec.EncodeDegree(degree)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanGraph calls fn with the key and value of each vertex and edge of the graph.
+func scanGraph(txn kv.Transaction, graphID int64, fn func(key kv.Key, val []byte) error) error {
+	iter, err := txn.Iter(codec.VertexKey(graphID, 0), codec.VertexKey(graphID, math.MaxInt64))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; err == nil && iter.Valid(); err = iter.Next() {
+		if err = fn(iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,256 @@
+// ---
+
+package graphengine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/simbiont-runtime/graphengine/codec"
+	"github.com/simbiont-runtime/graphengine/datum"
+	"github.com/simbiont-runtime/graphengine/meta"
+	"github.com/simbiont-runtime/graphengine/parser/model"
+	"github.com/simbiont-runtime/graphengine/session"
+	"github.com/simbiont-runtime/graphengine/storage"
+	"github.com/simbiont-runtime/graphengine/storage/kv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_StorageFormat(t *testing.T) {
+	db, err := Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	snapshot, err := db.Store().Snapshot(db.Store().CurrentVersion())
+	require.NoError(t, err)
+	version, err := meta.NewSnapshot(snapshot).StorageFormat()
+	require.NoError(t, err)
+	require.Equal(t, int64(currentStorageFormat), version)
+}
+
+func TestOpen_IncompatibleStorageFormat(t *testing.T) {
+	dirname := t.TempDir()
+	store, err := storage.Open(dirname)
+	require.NoError(t, err)
+	err = kv.TxnContext(context.Background(), store, func(_ context.Context, txn kv.Transaction) error {
+		return meta.New(txn).SetStorageFormat(currentStorageFormat + 1)
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	_, err = Open(dirname, nil)
+	require.ErrorIs(t, err, ErrIncompatibleStorageFormat)
+}
+
+// openLegacy opens the data directory written in the specified version of the
+// storage format, which is not recorded for the version 0.
+func openLegacy(t *testing.T, version int64, write func(txn kv.Transaction) error) *DB {
+	dirname := t.TempDir()
+	store, err := storage.Open(dirname)
+	require.NoError(t, err)
+	err = kv.TxnContext(context.Background(), store, func(_ context.Context, txn kv.Transaction) error {
+		if err := write(txn); err != nil {
+			return err
+		}
+		if version == 0 {
+			return nil
+		}
+		return meta.New(txn).SetStorageFormat(version)
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	db, err := Open(dirname, nil)
+	require.NoError(t, err)
+	return db
+}
+
+// queryRows executes the query and returns all the result rows.
+func queryRows(t *testing.T, sess *session.Session, query string) []datum.Row {
+	ctx := context.Background()
+	rs, err := sess.Execute(ctx, query)
+	require.NoError(t, err)
+	defer rs.Close()
+
+	var rows []datum.Row
+	for {
+		require.NoError(t, rs.Next(ctx))
+		if !rs.Valid() {
+			return rows
+		}
+		rows = append(rows, rs.Row())
+	}
+}
+
+func TestOpen_UpgradeLegacyEdges(t *testing.T) {
+	const graphID, personID = 1, 2
+
+	// The edge keys written by the version 0 have no edge IDs.
+	db := openLegacy(t, 0, func(txn kv.Transaction) error {
+		m := meta.New(txn)
+		if _, err := m.GenGlobalIDs(personID); err != nil {
+			return err
+		}
+		err := m.CreateGraph(&model.GraphInfo{ID: graphID, Name: model.NewCIStr("g")})
+		if err != nil {
+			return err
+		}
+		err = m.CreateLabel(graphID, &model.LabelInfo{ID: personID, Name: model.NewCIStr("Person")})
+		if err != nil {
+			return err
+		}
+		if _, err := m.AdvanceID(graphID, 3); err != nil {
+			return err
+		}
+		var encoder codec.PropertyEncoder
+		val, err := encoder.Encode(nil, []int64{personID}, nil, nil)
+		if err != nil {
+			return err
+		}
+		for vertexID := int64(1); vertexID <= 3; vertexID++ {
+			if err := txn.Set(codec.VertexKey(graphID, vertexID), val); err != nil {
+				return err
+			}
+		}
+		val, err = encoder.Encode(nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		for _, edge := range [][2]int64{{1, 2}, {1, 3}, {3, 1}} {
+			srcID, dstID := edge[0], edge[1]
+			outgoingKey := codec.OutgoingEdgeKey(graphID, srcID, dstID, 0)
+			if err := txn.Set(outgoingKey[:codec.EdgeKeyLen-8], val); err != nil {
+				return err
+			}
+			incomingKey := codec.IncomingEdgeKey(graphID, srcID, dstID, 0)
+			if err := txn.Set(incomingKey[:codec.EdgeKeyLen-8], val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer db.Close()
+
+	snapshot, err := db.Store().Snapshot(db.Store().CurrentVersion())
+	require.NoError(t, err)
+	version, err := meta.NewSnapshot(snapshot).StorageFormat()
+	require.NoError(t, err)
+	require.Equal(t, int64(currentStorageFormat), version)
+
+	format := func(rows []datum.Row) []string {
+		var result []string
+		for _, row := range rows {
+			var fields []string
+			for _, d := range row {
+				fields = append(fields, d.String())
+			}
+			result = append(result, strings.Join(fields, " "))
+		}
+		return result
+	}
+
+	// The edges are allocated the IDs after the vertices, and are read through
+	// both directions.
+	sess := db.NewSession()
+	sess.StmtContext().SetCurrentGraphName("g")
+	rows := queryRows(t, sess, "SELECT ID(a), ID(e), ID(b) FROM MATCH (a:Person) -[e]-> (b) ORDER BY ID(e)")
+	require.Equal(t, []string{"1 4 2", "1 5 3", "3 6 1"}, format(rows))
+	rows = queryRows(t, sess, "SELECT ID(b), ID(e), ID(a) FROM MATCH (b) <-[e]- (a) ORDER BY ID(e)")
+	require.Equal(t, []string{"2 4 1", "3 5 1", "1 6 3"}, format(rows))
+	rows = queryRows(t, sess, "SELECT ID(x), IN_DEGREE(x), OUT_DEGREE(x) FROM MATCH (x) ORDER BY ID(x)")
+	require.Equal(t, []string{"1 1 2", "2 1 0", "3 1 1"}, format(rows))
+
+	// The new edges are not allocated the IDs of the upgraded edges.
+	queryRows(t, sess, "INSERT EDGE e BETWEEN x AND y FROM MATCH (x), MATCH (y) WHERE ID(x) = 2 AND ID(y) = 3")
+	rows = queryRows(t, sess, "SELECT ID(e) FROM MATCH () -[e]-> () ORDER BY ID(e)")
+	require.Equal(t, []string{"4", "5", "6", "7"}, format(rows))
+}
+
+func TestOpen_BackfillVertexLabels(t *testing.T) {
+	const graphID, personID, cityID = 1, 2, 3
+
+	// The vertices written by the version 1 have no label keys.
+	db := openLegacy(t, 1, func(txn kv.Transaction) error {
+		m := meta.New(txn)
+		err := m.CreateGraph(&model.GraphInfo{ID: graphID, Name: model.NewCIStr("g")})
+		if err != nil {
+			return err
+		}
+		for _, label := range []*model.LabelInfo{
+			{ID: personID, Name: model.NewCIStr("Person")},
+			{ID: cityID, Name: model.NewCIStr("City")},
+		} {
+			if err := m.CreateLabel(graphID, label); err != nil {
+				return err
+			}
+		}
+		for vertexID, labelIDs := range map[int64][]int64{
+			1: {personID},
+			2: {cityID},
+			3: {personID, cityID},
+		} {
+			var encoder codec.PropertyEncoder
+			val, err := encoder.Encode(nil, labelIDs, nil, nil)
+			if err != nil {
+				return err
+			}
+			if err := txn.Set(codec.VertexKey(graphID, vertexID), val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer db.Close()
+
+	sess := db.NewSession()
+	sess.StmtContext().SetCurrentGraphName("g")
+	rows := queryRows(t, sess, "SELECT COUNT(*) FROM MATCH (n:Person)")
+	require.Equal(t, "2", rows[0][0].String())
+	rows = queryRows(t, sess, "SELECT COUNT(*) FROM MATCH (n:City)")
+	require.Equal(t, "2", rows[0][0].String())
+	rows = queryRows(t, sess, "SELECT COUNT(*) FROM MATCH (n:Person|City)")
+	require.Equal(t, "3", rows[0][0].String())
+}
+
+func TestOpen_BackfillDegrees(t *testing.T) {
+	const graphID = 1
+
+	// The vertices written by the version 2 have no degree counters.
+	db := openLegacy(t, 2, func(txn kv.Transaction) error {
+		err := meta.New(txn).CreateGraph(&model.GraphInfo{ID: graphID, Name: model.NewCIStr("g")})
+		if err != nil {
+			return err
+		}
+		var encoder codec.PropertyEncoder
+		val, err := encoder.Encode(nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		for vertexID := int64(1); vertexID <= 4; vertexID++ {
+			if err := txn.Set(codec.VertexKey(graphID, vertexID), val); err != nil {
+				return err
+			}
+		}
+		for edgeID, edge := range [][2]int64{{1, 2}, {1, 3}, {2, 3}, {2, 3}} {
+			srcID, dstID := edge[0], edge[1]
+			if err := txn.Set(codec.OutgoingEdgeKey(graphID, srcID, dstID, int64(edgeID)+5), val); err != nil {
+				return err
+			}
+			if err := txn.Set(codec.IncomingEdgeKey(graphID, srcID, dstID, int64(edgeID)+5), val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	defer db.Close()
+
+	sess := db.NewSession()
+	sess.StmtContext().SetCurrentGraphName("g")
+	rows := queryRows(t, sess, "SELECT ID(x), IN_DEGREE(x), OUT_DEGREE(x) FROM MATCH (x) ORDER BY ID(x)")
+	var degrees []string
+	for _, row := range rows {
+		degrees = append(degrees, row[0].String()+" "+row[1].String()+" "+row[2].String())
+	}
+	require.Equal(t, []string{"1 0 2", "2 1 2", "3 3 0", "4 0 0"}, degrees)
+}
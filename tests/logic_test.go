@@ -221,35 +221,35 @@ func (lt *logicTest) run(path string) {
 				if len(fields) >= 4 {
 					query.label = fields[3]
 				}
+			}
+
+			// Parse SQL query.
+			var sqlStr strings.Builder
+			var hasSeparator bool
+			for s.Scan() {
+				line = s.Text()
+				if strings.TrimSpace(line) == "" {
+					break
+				}
+				if line == "----" {
+					if query.expectedErr != "" {
+						t.Fatalf("%s:%d unexpected '----' after a query that expects an error", path, s.line)
+					}
+					hasSeparator = true
+					break
+				}
+				fmt.Fprintf(&sqlStr, "\n%s", line)
+			}
+			query.sql = sqlStr.String()
 
-				// Parse SQL query.
-				var sqlStr strings.Builder
-				var hasSeparator bool
+			// Parse expected results.
+			if hasSeparator {
 				for s.Scan() {
 					line = s.Text()
 					if strings.TrimSpace(line) == "" {
 						break
 					}
-					if line == "----" {
-						if query.expectedErr != "" {
-							t.Fatalf("%s:%d unexpected '----' after a query that expects an error", path, s.line)
-						}
-						hasSeparator = true
-						break
-					}
-					fmt.Fprintf(&sqlStr, "\n%s", line)
-				}
-				query.sql = sqlStr.String()
-
-				// Parse expected results.
-				if hasSeparator {
-					for s.Scan() {
-						line = s.Text()
-						if strings.TrimSpace(line) == "" {
-							break
-						}
-						query.expectedResults = append(query.expectedResults, strings.Fields(line)...)
-					}
+					query.expectedResults = append(query.expectedResults, strings.Fields(line)...)
 				}
 			}
 
@@ -278,12 +278,19 @@ func (lt *logicTest) execQuery(query logicQuery) {
 
 	rows, err := lt.conn.QueryContext(context.Background(), query.sql)
 	if query.expectedErr != "" {
-		require.Error(t, err)
+		// The error may be returned while iterating the rows.
+		if err == nil {
+			for rows.Next() {
+			}
+			err = rows.Err()
+			_ = rows.Close()
+		}
+		require.Error(t, err, query.pos)
 		require.Regexp(t, query.expectedErr, err.Error())
-	} else {
-		require.NoError(t, err)
-		defer rows.Close()
+		return
 	}
+	require.NoError(t, err)
+	defer rows.Close()
 
 	var values []string
 	numCols := len(query.typeStr)
@@ -296,7 +303,7 @@ func (lt *logicTest) execQuery(query logicQuery) {
 		for i := range query.typeStr {
 			switch query.typeStr[i] {
 			case 'T':
-				dest[i] = &sql.NullString{}
+				dest[i] = &textValue{}
 			case 'I':
 				dest[i] = &sql.NullInt64{}
 			case 'R':
@@ -310,7 +317,7 @@ func (lt *logicTest) execQuery(query logicQuery) {
 		for i := range dest {
 			switch query.typeStr[i] {
 			case 'T':
-				val := *dest[i].(*sql.NullString)
+				val := dest[i].(*textValue).NullString
 				s := val.String
 				if !val.Valid {
 					s = "NULL"
@@ -364,6 +371,48 @@ func (lt *logicTest) execQuery(query logicQuery) {
 	}
 }
 
+// textValue scans a value as text. The lists and maps are formatted in the same
+// way as the datums, e.g: [a, b] and {k: v}.
+type textValue struct {
+	sql.NullString
+}
+
+func (v *textValue) Scan(value any) error {
+	switch value.(type) {
+	case []any, map[string]any:
+		v.String, v.Valid = formatValue(value), true
+		return nil
+	default:
+		return v.NullString.Scan(value)
+	}
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case []any:
+		elems := make([]string, 0, len(v))
+		for _, elem := range v {
+			elems = append(elems, formatValue(elem))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		entries := make([]string, 0, len(keys))
+		for _, key := range keys {
+			entries = append(entries, key+": "+formatValue(v[key]))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func hashResults(results []string) string {
 	return fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(results, " "))))
 }
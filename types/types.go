@@ -33,6 +33,8 @@ const (
 	Interval    T = 12
 	Vertex      T = 13
 	Edge        T = 14
+	List        T = 15
+	Map         T = 16
 )
 
 func (t T) String() string {
@@ -67,6 +69,10 @@ func (t T) String() string {
 		return "Vertex"
 	case Edge:
 		return "Edge"
+	case List:
+		return "List"
+	case Map:
+		return "Map"
 	default:
 		return fmt.Sprintf("unknown<%d>", t)
 	}